@@ -0,0 +1,516 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: experiments/v1/experiments.proto
+
+package experimentsv1
+
+import (
+	_ "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/validate/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// An experiment assigns a running share of members, allocation_percent, to
+// one of its variants in proportion to their weights. Status is "draft",
+// "running" or "stopped"; only running experiments assign members.
+type Experiment struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Key               string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Description       string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Status            string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	AllocationPercent int32                  `protobuf:"varint,4,opt,name=allocation_percent,json=allocationPercent,proto3" json:"allocation_percent,omitempty"`
+	Variants          []*Variant             `protobuf:"bytes,5,rep,name=variants,proto3" json:"variants,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Experiment) Reset() {
+	*x = Experiment{}
+	mi := &file_experiments_v1_experiments_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Experiment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Experiment) ProtoMessage() {}
+
+func (x *Experiment) ProtoReflect() protoreflect.Message {
+	mi := &file_experiments_v1_experiments_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Experiment.ProtoReflect.Descriptor instead.
+func (*Experiment) Descriptor() ([]byte, []int) {
+	return file_experiments_v1_experiments_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Experiment) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Experiment) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Experiment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Experiment) GetAllocationPercent() int32 {
+	if x != nil {
+		return x.AllocationPercent
+	}
+	return 0
+}
+
+func (x *Experiment) GetVariants() []*Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *Experiment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// The first variant is the control the others are compared with.
+type Variant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Weight        int32                  `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Variant) Reset() {
+	*x = Variant{}
+	mi := &file_experiments_v1_experiments_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Variant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Variant) ProtoMessage() {}
+
+func (x *Variant) ProtoReflect() protoreflect.Message {
+	mi := &file_experiments_v1_experiments_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Variant.ProtoReflect.Descriptor instead.
+func (*Variant) Descriptor() ([]byte, []int) {
+	return file_experiments_v1_experiments_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Variant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Variant) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type ListExperimentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExperimentsRequest) Reset() {
+	*x = ListExperimentsRequest{}
+	mi := &file_experiments_v1_experiments_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExperimentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExperimentsRequest) ProtoMessage() {}
+
+func (x *ListExperimentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_experiments_v1_experiments_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExperimentsRequest.ProtoReflect.Descriptor instead.
+func (*ListExperimentsRequest) Descriptor() ([]byte, []int) {
+	return file_experiments_v1_experiments_proto_rawDescGZIP(), []int{2}
+}
+
+type ListExperimentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Experiments   []*Experiment          `protobuf:"bytes,1,rep,name=experiments,proto3" json:"experiments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExperimentsResponse) Reset() {
+	*x = ListExperimentsResponse{}
+	mi := &file_experiments_v1_experiments_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExperimentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExperimentsResponse) ProtoMessage() {}
+
+func (x *ListExperimentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_experiments_v1_experiments_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExperimentsResponse.ProtoReflect.Descriptor instead.
+func (*ListExperimentsResponse) Descriptor() ([]byte, []int) {
+	return file_experiments_v1_experiments_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListExperimentsResponse) GetExperiments() []*Experiment {
+	if x != nil {
+		return x.Experiments
+	}
+	return nil
+}
+
+type SetExperimentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Experiment    *Experiment            `protobuf:"bytes,1,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetExperimentRequest) Reset() {
+	*x = SetExperimentRequest{}
+	mi := &file_experiments_v1_experiments_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExperimentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExperimentRequest) ProtoMessage() {}
+
+func (x *SetExperimentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_experiments_v1_experiments_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExperimentRequest.ProtoReflect.Descriptor instead.
+func (*SetExperimentRequest) Descriptor() ([]byte, []int) {
+	return file_experiments_v1_experiments_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetExperimentRequest) GetExperiment() *Experiment {
+	if x != nil {
+		return x.Experiment
+	}
+	return nil
+}
+
+type SetExperimentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Experiment    *Experiment            `protobuf:"bytes,1,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetExperimentResponse) Reset() {
+	*x = SetExperimentResponse{}
+	mi := &file_experiments_v1_experiments_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExperimentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExperimentResponse) ProtoMessage() {}
+
+func (x *SetExperimentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_experiments_v1_experiments_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExperimentResponse.ProtoReflect.Descriptor instead.
+func (*SetExperimentResponse) Descriptor() ([]byte, []int) {
+	return file_experiments_v1_experiments_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetExperimentResponse) GetExperiment() *Experiment {
+	if x != nil {
+		return x.Experiment
+	}
+	return nil
+}
+
+type DeleteExperimentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExperimentRequest) Reset() {
+	*x = DeleteExperimentRequest{}
+	mi := &file_experiments_v1_experiments_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExperimentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExperimentRequest) ProtoMessage() {}
+
+func (x *DeleteExperimentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_experiments_v1_experiments_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExperimentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExperimentRequest) Descriptor() ([]byte, []int) {
+	return file_experiments_v1_experiments_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteExperimentRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type DeleteExperimentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExperimentResponse) Reset() {
+	*x = DeleteExperimentResponse{}
+	mi := &file_experiments_v1_experiments_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExperimentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExperimentResponse) ProtoMessage() {}
+
+func (x *DeleteExperimentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_experiments_v1_experiments_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExperimentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteExperimentResponse) Descriptor() ([]byte, []int) {
+	return file_experiments_v1_experiments_proto_rawDescGZIP(), []int{7}
+}
+
+var File_experiments_v1_experiments_proto protoreflect.FileDescriptor
+
+const file_experiments_v1_experiments_proto_rawDesc = "" +
+	"\n" +
+	" experiments/v1/experiments.proto\x12\x0eexperiments.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1avalidate/v1/validate.proto\"\xa4\x02\n" +
+	"\n" +
+	"Experiment\x12\x1a\n" +
+	"\x03key\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01\x18@R\x03key\x12)\n" +
+	"\vdescription\x18\x02 \x01(\tB\a\xc2\xf3\x18\x03\x18\xf4\x03R\vdescription\x12\x1e\n" +
+	"\x06status\x18\x03 \x01(\tB\x06\xc2\xf3\x18\x02\b\x01R\x06status\x127\n" +
+	"\x12allocation_percent\x18\x04 \x01(\x05B\b\xc2\xf3\x18\x04(\x000dR\x11allocationPercent\x12;\n" +
+	"\bvariants\x18\x05 \x03(\v2\x17.experiments.v1.VariantB\x06\xc2\xf3\x18\x02\x18\n" +
+	"R\bvariants\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"J\n" +
+	"\aVariant\x12\x1c\n" +
+	"\x04name\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01\x18@R\x04name\x12!\n" +
+	"\x06weight\x18\x02 \x01(\x05B\t\xc2\xf3\x18\x05(\x010\xe8\aR\x06weight\"\x18\n" +
+	"\x16ListExperimentsRequest\"W\n" +
+	"\x17ListExperimentsResponse\x12<\n" +
+	"\vexperiments\x18\x01 \x03(\v2\x1a.experiments.v1.ExperimentR\vexperiments\"Z\n" +
+	"\x14SetExperimentRequest\x12B\n" +
+	"\n" +
+	"experiment\x18\x01 \x01(\v2\x1a.experiments.v1.ExperimentB\x06\xc2\xf3\x18\x02\b\x01R\n" +
+	"experiment\"S\n" +
+	"\x15SetExperimentResponse\x12:\n" +
+	"\n" +
+	"experiment\x18\x01 \x01(\v2\x1a.experiments.v1.ExperimentR\n" +
+	"experiment\"3\n" +
+	"\x17DeleteExperimentRequest\x12\x18\n" +
+	"\x03key\x18\x01 \x01(\tB\x06\xc2\xf3\x18\x02\b\x01R\x03key\"\x1a\n" +
+	"\x18DeleteExperimentResponse2\xbc\x02\n" +
+	"\x11ExperimentService\x12b\n" +
+	"\x0fListExperiments\x12&.experiments.v1.ListExperimentsRequest\x1a'.experiments.v1.ListExperimentsResponse\x12\\\n" +
+	"\rSetExperiment\x12$.experiments.v1.SetExperimentRequest\x1a%.experiments.v1.SetExperimentResponse\x12e\n" +
+	"\x10DeleteExperiment\x12'.experiments.v1.DeleteExperimentRequest\x1a(.experiments.v1.DeleteExperimentResponseBTZRgithub.com/mattuttis/inetcontrol/zoekdeware/api/proto/experiments/v1;experimentsv1b\x06proto3"
+
+var (
+	file_experiments_v1_experiments_proto_rawDescOnce sync.Once
+	file_experiments_v1_experiments_proto_rawDescData []byte
+)
+
+func file_experiments_v1_experiments_proto_rawDescGZIP() []byte {
+	file_experiments_v1_experiments_proto_rawDescOnce.Do(func() {
+		file_experiments_v1_experiments_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_experiments_v1_experiments_proto_rawDesc), len(file_experiments_v1_experiments_proto_rawDesc)))
+	})
+	return file_experiments_v1_experiments_proto_rawDescData
+}
+
+var file_experiments_v1_experiments_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_experiments_v1_experiments_proto_goTypes = []any{
+	(*Experiment)(nil),               // 0: experiments.v1.Experiment
+	(*Variant)(nil),                  // 1: experiments.v1.Variant
+	(*ListExperimentsRequest)(nil),   // 2: experiments.v1.ListExperimentsRequest
+	(*ListExperimentsResponse)(nil),  // 3: experiments.v1.ListExperimentsResponse
+	(*SetExperimentRequest)(nil),     // 4: experiments.v1.SetExperimentRequest
+	(*SetExperimentResponse)(nil),    // 5: experiments.v1.SetExperimentResponse
+	(*DeleteExperimentRequest)(nil),  // 6: experiments.v1.DeleteExperimentRequest
+	(*DeleteExperimentResponse)(nil), // 7: experiments.v1.DeleteExperimentResponse
+	(*timestamppb.Timestamp)(nil),    // 8: google.protobuf.Timestamp
+}
+var file_experiments_v1_experiments_proto_depIdxs = []int32{
+	1, // 0: experiments.v1.Experiment.variants:type_name -> experiments.v1.Variant
+	8, // 1: experiments.v1.Experiment.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 2: experiments.v1.ListExperimentsResponse.experiments:type_name -> experiments.v1.Experiment
+	0, // 3: experiments.v1.SetExperimentRequest.experiment:type_name -> experiments.v1.Experiment
+	0, // 4: experiments.v1.SetExperimentResponse.experiment:type_name -> experiments.v1.Experiment
+	2, // 5: experiments.v1.ExperimentService.ListExperiments:input_type -> experiments.v1.ListExperimentsRequest
+	4, // 6: experiments.v1.ExperimentService.SetExperiment:input_type -> experiments.v1.SetExperimentRequest
+	6, // 7: experiments.v1.ExperimentService.DeleteExperiment:input_type -> experiments.v1.DeleteExperimentRequest
+	3, // 8: experiments.v1.ExperimentService.ListExperiments:output_type -> experiments.v1.ListExperimentsResponse
+	5, // 9: experiments.v1.ExperimentService.SetExperiment:output_type -> experiments.v1.SetExperimentResponse
+	7, // 10: experiments.v1.ExperimentService.DeleteExperiment:output_type -> experiments.v1.DeleteExperimentResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_experiments_v1_experiments_proto_init() }
+func file_experiments_v1_experiments_proto_init() {
+	if File_experiments_v1_experiments_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_experiments_v1_experiments_proto_rawDesc), len(file_experiments_v1_experiments_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_experiments_v1_experiments_proto_goTypes,
+		DependencyIndexes: file_experiments_v1_experiments_proto_depIdxs,
+		MessageInfos:      file_experiments_v1_experiments_proto_msgTypes,
+	}.Build()
+	File_experiments_v1_experiments_proto = out.File
+	file_experiments_v1_experiments_proto_goTypes = nil
+	file_experiments_v1_experiments_proto_depIdxs = nil
+}
@@ -0,0 +1,205 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.33.1
+// source: experiments/v1/experiments.proto
+
+package experimentsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ExperimentService_ListExperiments_FullMethodName  = "/experiments.v1.ExperimentService/ListExperiments"
+	ExperimentService_SetExperiment_FullMethodName    = "/experiments.v1.ExperimentService/SetExperiment"
+	ExperimentService_DeleteExperiment_FullMethodName = "/experiments.v1.ExperimentService/DeleteExperiment"
+)
+
+// ExperimentServiceClient is the client API for ExperimentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ExperimentService manages A/B experiments. Services keep a local copy of
+// the experiments, refreshed when they change, and assign members to
+// variants themselves.
+type ExperimentServiceClient interface {
+	ListExperiments(ctx context.Context, in *ListExperimentsRequest, opts ...grpc.CallOption) (*ListExperimentsResponse, error)
+	SetExperiment(ctx context.Context, in *SetExperimentRequest, opts ...grpc.CallOption) (*SetExperimentResponse, error)
+	DeleteExperiment(ctx context.Context, in *DeleteExperimentRequest, opts ...grpc.CallOption) (*DeleteExperimentResponse, error)
+}
+
+type experimentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExperimentServiceClient(cc grpc.ClientConnInterface) ExperimentServiceClient {
+	return &experimentServiceClient{cc}
+}
+
+func (c *experimentServiceClient) ListExperiments(ctx context.Context, in *ListExperimentsRequest, opts ...grpc.CallOption) (*ListExperimentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExperimentsResponse)
+	err := c.cc.Invoke(ctx, ExperimentService_ListExperiments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experimentServiceClient) SetExperiment(ctx context.Context, in *SetExperimentRequest, opts ...grpc.CallOption) (*SetExperimentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetExperimentResponse)
+	err := c.cc.Invoke(ctx, ExperimentService_SetExperiment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experimentServiceClient) DeleteExperiment(ctx context.Context, in *DeleteExperimentRequest, opts ...grpc.CallOption) (*DeleteExperimentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteExperimentResponse)
+	err := c.cc.Invoke(ctx, ExperimentService_DeleteExperiment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExperimentServiceServer is the server API for ExperimentService service.
+// All implementations must embed UnimplementedExperimentServiceServer
+// for forward compatibility.
+//
+// ExperimentService manages A/B experiments. Services keep a local copy of
+// the experiments, refreshed when they change, and assign members to
+// variants themselves.
+type ExperimentServiceServer interface {
+	ListExperiments(context.Context, *ListExperimentsRequest) (*ListExperimentsResponse, error)
+	SetExperiment(context.Context, *SetExperimentRequest) (*SetExperimentResponse, error)
+	DeleteExperiment(context.Context, *DeleteExperimentRequest) (*DeleteExperimentResponse, error)
+	mustEmbedUnimplementedExperimentServiceServer()
+}
+
+// UnimplementedExperimentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedExperimentServiceServer struct{}
+
+func (UnimplementedExperimentServiceServer) ListExperiments(context.Context, *ListExperimentsRequest) (*ListExperimentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExperiments not implemented")
+}
+func (UnimplementedExperimentServiceServer) SetExperiment(context.Context, *SetExperimentRequest) (*SetExperimentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExperiment not implemented")
+}
+func (UnimplementedExperimentServiceServer) DeleteExperiment(context.Context, *DeleteExperimentRequest) (*DeleteExperimentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExperiment not implemented")
+}
+func (UnimplementedExperimentServiceServer) mustEmbedUnimplementedExperimentServiceServer() {}
+func (UnimplementedExperimentServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeExperimentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExperimentServiceServer will
+// result in compilation errors.
+type UnsafeExperimentServiceServer interface {
+	mustEmbedUnimplementedExperimentServiceServer()
+}
+
+func RegisterExperimentServiceServer(s grpc.ServiceRegistrar, srv ExperimentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedExperimentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ExperimentService_ServiceDesc, srv)
+}
+
+func _ExperimentService_ListExperiments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExperimentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentServiceServer).ListExperiments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentService_ListExperiments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentServiceServer).ListExperiments(ctx, req.(*ListExperimentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperimentService_SetExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExperimentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentServiceServer).SetExperiment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentService_SetExperiment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentServiceServer).SetExperiment(ctx, req.(*SetExperimentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperimentService_DeleteExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExperimentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentServiceServer).DeleteExperiment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentService_DeleteExperiment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentServiceServer).DeleteExperiment(ctx, req.(*DeleteExperimentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExperimentService_ServiceDesc is the grpc.ServiceDesc for ExperimentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExperimentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "experiments.v1.ExperimentService",
+	HandlerType: (*ExperimentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListExperiments",
+			Handler:    _ExperimentService_ListExperiments_Handler,
+		},
+		{
+			MethodName: "SetExperiment",
+			Handler:    _ExperimentService_SetExperiment_Handler,
+		},
+		{
+			MethodName: "DeleteExperiment",
+			Handler:    _ExperimentService_DeleteExperiment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "experiments/v1/experiments.proto",
+}
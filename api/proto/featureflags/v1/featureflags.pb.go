@@ -0,0 +1,522 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: featureflags/v1/featureflags.proto
+
+package featureflagsv1
+
+import (
+	_ "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/validate/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A flag is on for a member when it is enabled, the member matches every
+// rule and the member falls within the rollout percentage.
+type Flag struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Key            string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Enabled        bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	RolloutPercent int32                  `protobuf:"varint,4,opt,name=rollout_percent,json=rolloutPercent,proto3" json:"rollout_percent,omitempty"`
+	Rules          []*Rule                `protobuf:"bytes,5,rep,name=rules,proto3" json:"rules,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Flag) Reset() {
+	*x = Flag{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Flag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Flag) ProtoMessage() {}
+
+func (x *Flag) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Flag.ProtoReflect.Descriptor instead.
+func (*Flag) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Flag) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Flag) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Flag) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Flag) GetRolloutPercent() int32 {
+	if x != nil {
+		return x.RolloutPercent
+	}
+	return 0
+}
+
+func (x *Flag) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *Flag) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Rule targets members by an attribute such as "country", "platform" or
+// "app_version". Operators are "in" and "not_in", which compare against
+// values, and "version_gte" and "version_lt", which compare dotted
+// versions against the first value.
+type Rule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attribute     string                 `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	Operator      string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Values        []string               `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule) ProtoMessage() {}
+
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Rule) GetAttribute() string {
+	if x != nil {
+		return x.Attribute
+	}
+	return ""
+}
+
+func (x *Rule) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *Rule) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type ListFlagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFlagsRequest) Reset() {
+	*x = ListFlagsRequest{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFlagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlagsRequest) ProtoMessage() {}
+
+func (x *ListFlagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlagsRequest.ProtoReflect.Descriptor instead.
+func (*ListFlagsRequest) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{2}
+}
+
+type ListFlagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         []*Flag                `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFlagsResponse) Reset() {
+	*x = ListFlagsResponse{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFlagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlagsResponse) ProtoMessage() {}
+
+func (x *ListFlagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlagsResponse.ProtoReflect.Descriptor instead.
+func (*ListFlagsResponse) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListFlagsResponse) GetFlags() []*Flag {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+type SetFlagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flag          *Flag                  `protobuf:"bytes,1,opt,name=flag,proto3" json:"flag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFlagRequest) Reset() {
+	*x = SetFlagRequest{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFlagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFlagRequest) ProtoMessage() {}
+
+func (x *SetFlagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFlagRequest.ProtoReflect.Descriptor instead.
+func (*SetFlagRequest) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetFlagRequest) GetFlag() *Flag {
+	if x != nil {
+		return x.Flag
+	}
+	return nil
+}
+
+type SetFlagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flag          *Flag                  `protobuf:"bytes,1,opt,name=flag,proto3" json:"flag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFlagResponse) Reset() {
+	*x = SetFlagResponse{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFlagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFlagResponse) ProtoMessage() {}
+
+func (x *SetFlagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFlagResponse.ProtoReflect.Descriptor instead.
+func (*SetFlagResponse) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetFlagResponse) GetFlag() *Flag {
+	if x != nil {
+		return x.Flag
+	}
+	return nil
+}
+
+type DeleteFlagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFlagRequest) Reset() {
+	*x = DeleteFlagRequest{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFlagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFlagRequest) ProtoMessage() {}
+
+func (x *DeleteFlagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFlagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFlagRequest) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteFlagRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type DeleteFlagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFlagResponse) Reset() {
+	*x = DeleteFlagResponse{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFlagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFlagResponse) ProtoMessage() {}
+
+func (x *DeleteFlagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFlagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFlagResponse) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{7}
+}
+
+var File_featureflags_v1_featureflags_proto protoreflect.FileDescriptor
+
+const file_featureflags_v1_featureflags_proto_rawDesc = "" +
+	"\n" +
+	"\"featureflags/v1/featureflags.proto\x12\x0ffeatureflags.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1avalidate/v1/validate.proto\"\x8a\x02\n" +
+	"\x04Flag\x12\x1a\n" +
+	"\x03key\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01\x18@R\x03key\x12)\n" +
+	"\vdescription\x18\x02 \x01(\tB\a\xc2\xf3\x18\x03\x18\xf4\x03R\vdescription\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x121\n" +
+	"\x0frollout_percent\x18\x04 \x01(\x05B\b\xc2\xf3\x18\x04(\x000dR\x0erolloutPercent\x123\n" +
+	"\x05rules\x18\x05 \x03(\v2\x15.featureflags.v1.RuleB\x06\xc2\xf3\x18\x02\x18\x14R\x05rules\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"r\n" +
+	"\x04Rule\x12&\n" +
+	"\tattribute\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01\x18@R\tattribute\x12\"\n" +
+	"\boperator\x18\x02 \x01(\tB\x06\xc2\xf3\x18\x02\b\x01R\boperator\x12\x1e\n" +
+	"\x06values\x18\x03 \x03(\tB\x06\xc2\xf3\x18\x02\x18dR\x06values\"\x12\n" +
+	"\x10ListFlagsRequest\"@\n" +
+	"\x11ListFlagsResponse\x12+\n" +
+	"\x05flags\x18\x01 \x03(\v2\x15.featureflags.v1.FlagR\x05flags\"C\n" +
+	"\x0eSetFlagRequest\x121\n" +
+	"\x04flag\x18\x01 \x01(\v2\x15.featureflags.v1.FlagB\x06\xc2\xf3\x18\x02\b\x01R\x04flag\"<\n" +
+	"\x0fSetFlagResponse\x12)\n" +
+	"\x04flag\x18\x01 \x01(\v2\x15.featureflags.v1.FlagR\x04flag\"-\n" +
+	"\x11DeleteFlagRequest\x12\x18\n" +
+	"\x03key\x18\x01 \x01(\tB\x06\xc2\xf3\x18\x02\b\x01R\x03key\"\x14\n" +
+	"\x12DeleteFlagResponse2\x8d\x02\n" +
+	"\x12FeatureFlagService\x12R\n" +
+	"\tListFlags\x12!.featureflags.v1.ListFlagsRequest\x1a\".featureflags.v1.ListFlagsResponse\x12L\n" +
+	"\aSetFlag\x12\x1f.featureflags.v1.SetFlagRequest\x1a .featureflags.v1.SetFlagResponse\x12U\n" +
+	"\n" +
+	"DeleteFlag\x12\".featureflags.v1.DeleteFlagRequest\x1a#.featureflags.v1.DeleteFlagResponseBVZTgithub.com/mattuttis/inetcontrol/zoekdeware/api/proto/featureflags/v1;featureflagsv1b\x06proto3"
+
+var (
+	file_featureflags_v1_featureflags_proto_rawDescOnce sync.Once
+	file_featureflags_v1_featureflags_proto_rawDescData []byte
+)
+
+func file_featureflags_v1_featureflags_proto_rawDescGZIP() []byte {
+	file_featureflags_v1_featureflags_proto_rawDescOnce.Do(func() {
+		file_featureflags_v1_featureflags_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_featureflags_v1_featureflags_proto_rawDesc), len(file_featureflags_v1_featureflags_proto_rawDesc)))
+	})
+	return file_featureflags_v1_featureflags_proto_rawDescData
+}
+
+var file_featureflags_v1_featureflags_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_featureflags_v1_featureflags_proto_goTypes = []any{
+	(*Flag)(nil),                  // 0: featureflags.v1.Flag
+	(*Rule)(nil),                  // 1: featureflags.v1.Rule
+	(*ListFlagsRequest)(nil),      // 2: featureflags.v1.ListFlagsRequest
+	(*ListFlagsResponse)(nil),     // 3: featureflags.v1.ListFlagsResponse
+	(*SetFlagRequest)(nil),        // 4: featureflags.v1.SetFlagRequest
+	(*SetFlagResponse)(nil),       // 5: featureflags.v1.SetFlagResponse
+	(*DeleteFlagRequest)(nil),     // 6: featureflags.v1.DeleteFlagRequest
+	(*DeleteFlagResponse)(nil),    // 7: featureflags.v1.DeleteFlagResponse
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+}
+var file_featureflags_v1_featureflags_proto_depIdxs = []int32{
+	1, // 0: featureflags.v1.Flag.rules:type_name -> featureflags.v1.Rule
+	8, // 1: featureflags.v1.Flag.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 2: featureflags.v1.ListFlagsResponse.flags:type_name -> featureflags.v1.Flag
+	0, // 3: featureflags.v1.SetFlagRequest.flag:type_name -> featureflags.v1.Flag
+	0, // 4: featureflags.v1.SetFlagResponse.flag:type_name -> featureflags.v1.Flag
+	2, // 5: featureflags.v1.FeatureFlagService.ListFlags:input_type -> featureflags.v1.ListFlagsRequest
+	4, // 6: featureflags.v1.FeatureFlagService.SetFlag:input_type -> featureflags.v1.SetFlagRequest
+	6, // 7: featureflags.v1.FeatureFlagService.DeleteFlag:input_type -> featureflags.v1.DeleteFlagRequest
+	3, // 8: featureflags.v1.FeatureFlagService.ListFlags:output_type -> featureflags.v1.ListFlagsResponse
+	5, // 9: featureflags.v1.FeatureFlagService.SetFlag:output_type -> featureflags.v1.SetFlagResponse
+	7, // 10: featureflags.v1.FeatureFlagService.DeleteFlag:output_type -> featureflags.v1.DeleteFlagResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_featureflags_v1_featureflags_proto_init() }
+func file_featureflags_v1_featureflags_proto_init() {
+	if File_featureflags_v1_featureflags_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_featureflags_v1_featureflags_proto_rawDesc), len(file_featureflags_v1_featureflags_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_featureflags_v1_featureflags_proto_goTypes,
+		DependencyIndexes: file_featureflags_v1_featureflags_proto_depIdxs,
+		MessageInfos:      file_featureflags_v1_featureflags_proto_msgTypes,
+	}.Build()
+	File_featureflags_v1_featureflags_proto = out.File
+	file_featureflags_v1_featureflags_proto_goTypes = nil
+	file_featureflags_v1_featureflags_proto_depIdxs = nil
+}
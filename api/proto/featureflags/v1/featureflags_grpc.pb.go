@@ -0,0 +1,203 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.33.1
+// source: featureflags/v1/featureflags.proto
+
+package featureflagsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FeatureFlagService_ListFlags_FullMethodName  = "/featureflags.v1.FeatureFlagService/ListFlags"
+	FeatureFlagService_SetFlag_FullMethodName    = "/featureflags.v1.FeatureFlagService/SetFlag"
+	FeatureFlagService_DeleteFlag_FullMethodName = "/featureflags.v1.FeatureFlagService/DeleteFlag"
+)
+
+// FeatureFlagServiceClient is the client API for FeatureFlagService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// FeatureFlagService manages the flags that gate features per member.
+// Services keep a local copy of the flags, refreshed when they change.
+type FeatureFlagServiceClient interface {
+	ListFlags(ctx context.Context, in *ListFlagsRequest, opts ...grpc.CallOption) (*ListFlagsResponse, error)
+	SetFlag(ctx context.Context, in *SetFlagRequest, opts ...grpc.CallOption) (*SetFlagResponse, error)
+	DeleteFlag(ctx context.Context, in *DeleteFlagRequest, opts ...grpc.CallOption) (*DeleteFlagResponse, error)
+}
+
+type featureFlagServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFeatureFlagServiceClient(cc grpc.ClientConnInterface) FeatureFlagServiceClient {
+	return &featureFlagServiceClient{cc}
+}
+
+func (c *featureFlagServiceClient) ListFlags(ctx context.Context, in *ListFlagsRequest, opts ...grpc.CallOption) (*ListFlagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFlagsResponse)
+	err := c.cc.Invoke(ctx, FeatureFlagService_ListFlags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureFlagServiceClient) SetFlag(ctx context.Context, in *SetFlagRequest, opts ...grpc.CallOption) (*SetFlagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFlagResponse)
+	err := c.cc.Invoke(ctx, FeatureFlagService_SetFlag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureFlagServiceClient) DeleteFlag(ctx context.Context, in *DeleteFlagRequest, opts ...grpc.CallOption) (*DeleteFlagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFlagResponse)
+	err := c.cc.Invoke(ctx, FeatureFlagService_DeleteFlag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FeatureFlagServiceServer is the server API for FeatureFlagService service.
+// All implementations must embed UnimplementedFeatureFlagServiceServer
+// for forward compatibility.
+//
+// FeatureFlagService manages the flags that gate features per member.
+// Services keep a local copy of the flags, refreshed when they change.
+type FeatureFlagServiceServer interface {
+	ListFlags(context.Context, *ListFlagsRequest) (*ListFlagsResponse, error)
+	SetFlag(context.Context, *SetFlagRequest) (*SetFlagResponse, error)
+	DeleteFlag(context.Context, *DeleteFlagRequest) (*DeleteFlagResponse, error)
+	mustEmbedUnimplementedFeatureFlagServiceServer()
+}
+
+// UnimplementedFeatureFlagServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFeatureFlagServiceServer struct{}
+
+func (UnimplementedFeatureFlagServiceServer) ListFlags(context.Context, *ListFlagsRequest) (*ListFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFlags not implemented")
+}
+func (UnimplementedFeatureFlagServiceServer) SetFlag(context.Context, *SetFlagRequest) (*SetFlagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFlag not implemented")
+}
+func (UnimplementedFeatureFlagServiceServer) DeleteFlag(context.Context, *DeleteFlagRequest) (*DeleteFlagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFlag not implemented")
+}
+func (UnimplementedFeatureFlagServiceServer) mustEmbedUnimplementedFeatureFlagServiceServer() {}
+func (UnimplementedFeatureFlagServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeFeatureFlagServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FeatureFlagServiceServer will
+// result in compilation errors.
+type UnsafeFeatureFlagServiceServer interface {
+	mustEmbedUnimplementedFeatureFlagServiceServer()
+}
+
+func RegisterFeatureFlagServiceServer(s grpc.ServiceRegistrar, srv FeatureFlagServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFeatureFlagServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FeatureFlagService_ServiceDesc, srv)
+}
+
+func _FeatureFlagService_ListFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureFlagServiceServer).ListFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureFlagService_ListFlags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureFlagServiceServer).ListFlags(ctx, req.(*ListFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureFlagService_SetFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureFlagServiceServer).SetFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureFlagService_SetFlag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureFlagServiceServer).SetFlag(ctx, req.(*SetFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureFlagService_DeleteFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureFlagServiceServer).DeleteFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureFlagService_DeleteFlag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureFlagServiceServer).DeleteFlag(ctx, req.(*DeleteFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FeatureFlagService_ServiceDesc is the grpc.ServiceDesc for FeatureFlagService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FeatureFlagService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "featureflags.v1.FeatureFlagService",
+	HandlerType: (*FeatureFlagServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListFlags",
+			Handler:    _FeatureFlagService_ListFlags_Handler,
+		},
+		{
+			MethodName: "SetFlag",
+			Handler:    _FeatureFlagService_SetFlag_Handler,
+		},
+		{
+			MethodName: "DeleteFlag",
+			Handler:    _FeatureFlagService_DeleteFlag_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "featureflags/v1/featureflags.proto",
+}
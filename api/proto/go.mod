@@ -3,6 +3,8 @@ module github.com/mattuttis/inetcontrol/zoekdeware/api/proto
 go 1.24
 
 require (
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.22.0
+	google.golang.org/genproto/googleapis/api v0.0.0-20241007155032-5fefd90f89a9
 	google.golang.org/grpc v1.68.0
 	google.golang.org/protobuf v1.35.2
 )
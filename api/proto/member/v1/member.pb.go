@@ -7,8 +7,12 @@
 package memberv1
 
 import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/validate/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,6 +26,119 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type OnboardingStep int32
+
+const (
+	OnboardingStep_ONBOARDING_STEP_UNSPECIFIED  OnboardingStep = 0
+	OnboardingStep_ONBOARDING_STEP_PROFILE      OnboardingStep = 1
+	OnboardingStep_ONBOARDING_STEP_PHOTOS       OnboardingStep = 2
+	OnboardingStep_ONBOARDING_STEP_PROMPTS      OnboardingStep = 3
+	OnboardingStep_ONBOARDING_STEP_PREFERENCES  OnboardingStep = 4
+	OnboardingStep_ONBOARDING_STEP_VERIFY_EMAIL OnboardingStep = 5
+	OnboardingStep_ONBOARDING_STEP_COMPLETE     OnboardingStep = 6
+)
+
+// Enum value maps for OnboardingStep.
+var (
+	OnboardingStep_name = map[int32]string{
+		0: "ONBOARDING_STEP_UNSPECIFIED",
+		1: "ONBOARDING_STEP_PROFILE",
+		2: "ONBOARDING_STEP_PHOTOS",
+		3: "ONBOARDING_STEP_PROMPTS",
+		4: "ONBOARDING_STEP_PREFERENCES",
+		5: "ONBOARDING_STEP_VERIFY_EMAIL",
+		6: "ONBOARDING_STEP_COMPLETE",
+	}
+	OnboardingStep_value = map[string]int32{
+		"ONBOARDING_STEP_UNSPECIFIED":  0,
+		"ONBOARDING_STEP_PROFILE":      1,
+		"ONBOARDING_STEP_PHOTOS":       2,
+		"ONBOARDING_STEP_PROMPTS":      3,
+		"ONBOARDING_STEP_PREFERENCES":  4,
+		"ONBOARDING_STEP_VERIFY_EMAIL": 5,
+		"ONBOARDING_STEP_COMPLETE":     6,
+	}
+)
+
+func (x OnboardingStep) Enum() *OnboardingStep {
+	p := new(OnboardingStep)
+	*p = x
+	return p
+}
+
+func (x OnboardingStep) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OnboardingStep) Descriptor() protoreflect.EnumDescriptor {
+	return file_member_v1_member_proto_enumTypes[0].Descriptor()
+}
+
+func (OnboardingStep) Type() protoreflect.EnumType {
+	return &file_member_v1_member_proto_enumTypes[0]
+}
+
+func (x OnboardingStep) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OnboardingStep.Descriptor instead.
+func (OnboardingStep) EnumDescriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{0}
+}
+
+type Dealbreaker int32
+
+const (
+	Dealbreaker_DEALBREAKER_UNSPECIFIED Dealbreaker = 0
+	Dealbreaker_DEALBREAKER_AGE         Dealbreaker = 1
+	Dealbreaker_DEALBREAKER_GENDER      Dealbreaker = 2
+	Dealbreaker_DEALBREAKER_DISTANCE    Dealbreaker = 3
+)
+
+// Enum value maps for Dealbreaker.
+var (
+	Dealbreaker_name = map[int32]string{
+		0: "DEALBREAKER_UNSPECIFIED",
+		1: "DEALBREAKER_AGE",
+		2: "DEALBREAKER_GENDER",
+		3: "DEALBREAKER_DISTANCE",
+	}
+	Dealbreaker_value = map[string]int32{
+		"DEALBREAKER_UNSPECIFIED": 0,
+		"DEALBREAKER_AGE":         1,
+		"DEALBREAKER_GENDER":      2,
+		"DEALBREAKER_DISTANCE":    3,
+	}
+)
+
+func (x Dealbreaker) Enum() *Dealbreaker {
+	p := new(Dealbreaker)
+	*p = x
+	return p
+}
+
+func (x Dealbreaker) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Dealbreaker) Descriptor() protoreflect.EnumDescriptor {
+	return file_member_v1_member_proto_enumTypes[1].Descriptor()
+}
+
+func (Dealbreaker) Type() protoreflect.EnumType {
+	return &file_member_v1_member_proto_enumTypes[1]
+}
+
+func (x Dealbreaker) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Dealbreaker.Descriptor instead.
+func (Dealbreaker) EnumDescriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{1}
+}
+
 type MemberStatus int32
 
 const (
@@ -58,11 +175,11 @@ func (x MemberStatus) String() string {
 }
 
 func (MemberStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_member_v1_member_proto_enumTypes[0].Descriptor()
+	return file_member_v1_member_proto_enumTypes[2].Descriptor()
 }
 
 func (MemberStatus) Type() protoreflect.EnumType {
-	return &file_member_v1_member_proto_enumTypes[0]
+	return &file_member_v1_member_proto_enumTypes[2]
 }
 
 func (x MemberStatus) Number() protoreflect.EnumNumber {
@@ -71,7 +188,7 @@ func (x MemberStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MemberStatus.Descriptor instead.
 func (MemberStatus) EnumDescriptor() ([]byte, []int) {
-	return file_member_v1_member_proto_rawDescGZIP(), []int{0}
+	return file_member_v1_member_proto_rawDescGZIP(), []int{2}
 }
 
 type Gender int32
@@ -110,11 +227,11 @@ func (x Gender) String() string {
 }
 
 func (Gender) Descriptor() protoreflect.EnumDescriptor {
-	return file_member_v1_member_proto_enumTypes[1].Descriptor()
+	return file_member_v1_member_proto_enumTypes[3].Descriptor()
 }
 
 func (Gender) Type() protoreflect.EnumType {
-	return &file_member_v1_member_proto_enumTypes[1]
+	return &file_member_v1_member_proto_enumTypes[3]
 }
 
 func (x Gender) Number() protoreflect.EnumNumber {
@@ -123,7 +240,65 @@ func (x Gender) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Gender.Descriptor instead.
 func (Gender) EnumDescriptor() ([]byte, []int) {
-	return file_member_v1_member_proto_rawDescGZIP(), []int{1}
+	return file_member_v1_member_proto_rawDescGZIP(), []int{3}
+}
+
+// MemberSort orders search results. Ties are broken by member ID.
+type MemberSort int32
+
+const (
+	// Newest first.
+	MemberSort_MEMBER_SORT_UNSPECIFIED MemberSort = 0
+	MemberSort_MEMBER_SORT_NEWEST      MemberSort = 1
+	MemberSort_MEMBER_SORT_OLDEST      MemberSort = 2
+	MemberSort_MEMBER_SORT_EMAIL       MemberSort = 3
+	// Members without a display name come first.
+	MemberSort_MEMBER_SORT_DISPLAY_NAME MemberSort = 4
+)
+
+// Enum value maps for MemberSort.
+var (
+	MemberSort_name = map[int32]string{
+		0: "MEMBER_SORT_UNSPECIFIED",
+		1: "MEMBER_SORT_NEWEST",
+		2: "MEMBER_SORT_OLDEST",
+		3: "MEMBER_SORT_EMAIL",
+		4: "MEMBER_SORT_DISPLAY_NAME",
+	}
+	MemberSort_value = map[string]int32{
+		"MEMBER_SORT_UNSPECIFIED":  0,
+		"MEMBER_SORT_NEWEST":       1,
+		"MEMBER_SORT_OLDEST":       2,
+		"MEMBER_SORT_EMAIL":        3,
+		"MEMBER_SORT_DISPLAY_NAME": 4,
+	}
+)
+
+func (x MemberSort) Enum() *MemberSort {
+	p := new(MemberSort)
+	*p = x
+	return p
+}
+
+func (x MemberSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemberSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_member_v1_member_proto_enumTypes[4].Descriptor()
+}
+
+func (MemberSort) Type() protoreflect.EnumType {
+	return &file_member_v1_member_proto_enumTypes[4]
+}
+
+func (x MemberSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemberSort.Descriptor instead.
+func (MemberSort) EnumDescriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{4}
 }
 
 type Member struct {
@@ -134,6 +309,8 @@ type Member struct {
 	Status        MemberStatus           `protobuf:"varint,4,opt,name=status,proto3,enum=member.v1.MemberStatus" json:"status,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	EmailVerified bool                   `protobuf:"varint,7,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	Onboarding    *Onboarding            `protobuf:"bytes,8,opt,name=onboarding,proto3" json:"onboarding,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -210,21 +387,103 @@ func (x *Member) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Member) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *Member) GetOnboarding() *Onboarding {
+	if x != nil {
+		return x.Onboarding
+	}
+	return nil
+}
+
+// Onboarding is the member's progress towards becoming discoverable. Apps
+// show the screen for next_step; members are activated once it is
+// ONBOARDING_STEP_COMPLETE.
+type Onboarding struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NextStep       OnboardingStep         `protobuf:"varint,1,opt,name=next_step,json=nextStep,proto3,enum=member.v1.OnboardingStep" json:"next_step,omitempty"`
+	CompletedSteps []OnboardingStep       `protobuf:"varint,2,rep,packed,name=completed_steps,json=completedSteps,proto3,enum=member.v1.OnboardingStep" json:"completed_steps,omitempty"`
+	// Profile completeness score from 0 to 100.
+	Completeness  int32 `protobuf:"varint,3,opt,name=completeness,proto3" json:"completeness,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Onboarding) Reset() {
+	*x = Onboarding{}
+	mi := &file_member_v1_member_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Onboarding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Onboarding) ProtoMessage() {}
+
+func (x *Onboarding) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Onboarding.ProtoReflect.Descriptor instead.
+func (*Onboarding) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Onboarding) GetNextStep() OnboardingStep {
+	if x != nil {
+		return x.NextStep
+	}
+	return OnboardingStep_ONBOARDING_STEP_UNSPECIFIED
+}
+
+func (x *Onboarding) GetCompletedSteps() []OnboardingStep {
+	if x != nil {
+		return x.CompletedSteps
+	}
+	return nil
+}
+
+func (x *Onboarding) GetCompleteness() int32 {
+	if x != nil {
+		return x.Completeness
+	}
+	return 0
+}
+
 type Profile struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DisplayName   string                 `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	Bio           string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
-	BirthDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
-	Gender        Gender                 `protobuf:"varint,4,opt,name=gender,proto3,enum=member.v1.Gender" json:"gender,omitempty"`
-	Interests     []string               `protobuf:"bytes,5,rep,name=interests,proto3" json:"interests,omitempty"`
-	PhotoUrls     []string               `protobuf:"bytes,6,rep,name=photo_urls,json=photoUrls,proto3" json:"photo_urls,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	DisplayName string                 `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Bio         string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
+	BirthDate   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
+	Gender      Gender                 `protobuf:"varint,4,opt,name=gender,proto3,enum=member.v1.Gender" json:"gender,omitempty"`
+	// IDs from the interests catalogue, see ListInterests.
+	Interests       []string `protobuf:"bytes,5,rep,name=interests,proto3" json:"interests,omitempty"`
+	PhotoUrls       []string `protobuf:"bytes,6,rep,name=photo_urls,json=photoUrls,proto3" json:"photo_urls,omitempty"`
+	PrimaryPhotoUrl string   `protobuf:"bytes,7,opt,name=primary_photo_url,json=primaryPhotoUrl,proto3" json:"primary_photo_url,omitempty"`
+	// Answered prompts in display order.
+	Prompts       []*ProfilePrompt `protobuf:"bytes,8,rep,name=prompts,proto3" json:"prompts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Profile) Reset() {
 	*x = Profile{}
-	mi := &file_member_v1_member_proto_msgTypes[1]
+	mi := &file_member_v1_member_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -236,7 +495,7 @@ func (x *Profile) String() string {
 func (*Profile) ProtoMessage() {}
 
 func (x *Profile) ProtoReflect() protoreflect.Message {
-	mi := &file_member_v1_member_proto_msgTypes[1]
+	mi := &file_member_v1_member_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -249,7 +508,7 @@ func (x *Profile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Profile.ProtoReflect.Descriptor instead.
 func (*Profile) Descriptor() ([]byte, []int) {
-	return file_member_v1_member_proto_rawDescGZIP(), []int{1}
+	return file_member_v1_member_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Profile) GetDisplayName() string {
@@ -294,29 +553,46 @@ func (x *Profile) GetPhotoUrls() []string {
 	return nil
 }
 
-type RegisterMemberRequest struct {
+func (x *Profile) GetPrimaryPhotoUrl() string {
+	if x != nil {
+		return x.PrimaryPhotoUrl
+	}
+	return ""
+}
+
+func (x *Profile) GetPrompts() []*ProfilePrompt {
+	if x != nil {
+		return x.Prompts
+	}
+	return nil
+}
+
+// ProfilePrompt is an answered prompt. The question is in English; clients
+// show it in the member's language using ListPrompts.
+type ProfilePrompt struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	PromptId      string                 `protobuf:"bytes,1,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
+	Question      string                 `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	Answer        string                 `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterMemberRequest) Reset() {
-	*x = RegisterMemberRequest{}
-	mi := &file_member_v1_member_proto_msgTypes[2]
+func (x *ProfilePrompt) Reset() {
+	*x = ProfilePrompt{}
+	mi := &file_member_v1_member_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterMemberRequest) String() string {
+func (x *ProfilePrompt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterMemberRequest) ProtoMessage() {}
+func (*ProfilePrompt) ProtoMessage() {}
 
-func (x *RegisterMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_member_v1_member_proto_msgTypes[2]
+func (x *ProfilePrompt) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -327,47 +603,56 @@ func (x *RegisterMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterMemberRequest.ProtoReflect.Descriptor instead.
-func (*RegisterMemberRequest) Descriptor() ([]byte, []int) {
-	return file_member_v1_member_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ProfilePrompt.ProtoReflect.Descriptor instead.
+func (*ProfilePrompt) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *RegisterMemberRequest) GetEmail() string {
+func (x *ProfilePrompt) GetPromptId() string {
 	if x != nil {
-		return x.Email
+		return x.PromptId
 	}
 	return ""
 }
 
-func (x *RegisterMemberRequest) GetPassword() string {
+func (x *ProfilePrompt) GetQuestion() string {
 	if x != nil {
-		return x.Password
+		return x.Question
 	}
 	return ""
 }
 
-type RegisterMemberResponse struct {
+func (x *ProfilePrompt) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+// Prompt is an entry in the prompt catalogue, in the requested locale.
+type Prompt struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Question      string                 `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterMemberResponse) Reset() {
-	*x = RegisterMemberResponse{}
-	mi := &file_member_v1_member_proto_msgTypes[3]
+func (x *Prompt) Reset() {
+	*x = Prompt{}
+	mi := &file_member_v1_member_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterMemberResponse) String() string {
+func (x *Prompt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterMemberResponse) ProtoMessage() {}
+func (*Prompt) ProtoMessage() {}
 
-func (x *RegisterMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_member_v1_member_proto_msgTypes[3]
+func (x *Prompt) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -378,41 +663,52 @@ func (x *RegisterMemberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterMemberResponse.ProtoReflect.Descriptor instead.
-func (*RegisterMemberResponse) Descriptor() ([]byte, []int) {
-	return file_member_v1_member_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Prompt.ProtoReflect.Descriptor instead.
+func (*Prompt) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *RegisterMemberResponse) GetMember() *Member {
+func (x *Prompt) GetId() string {
 	if x != nil {
-		return x.Member
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type AuthenticateMemberRequest struct {
+func (x *Prompt) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+// Interest is an entry in the interests catalogue, named in the requested
+// locale.
+type Interest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,3,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuthenticateMemberRequest) Reset() {
-	*x = AuthenticateMemberRequest{}
-	mi := &file_member_v1_member_proto_msgTypes[4]
+func (x *Interest) Reset() {
+	*x = Interest{}
+	mi := &file_member_v1_member_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthenticateMemberRequest) String() string {
+func (x *Interest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthenticateMemberRequest) ProtoMessage() {}
+func (*Interest) ProtoMessage() {}
 
-func (x *AuthenticateMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_member_v1_member_proto_msgTypes[4]
+func (x *Interest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -423,47 +719,67 @@ func (x *AuthenticateMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthenticateMemberRequest.ProtoReflect.Descriptor instead.
-func (*AuthenticateMemberRequest) Descriptor() ([]byte, []int) {
-	return file_member_v1_member_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Interest.ProtoReflect.Descriptor instead.
+func (*Interest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *AuthenticateMemberRequest) GetEmail() string {
+func (x *Interest) GetId() string {
 	if x != nil {
-		return x.Email
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AuthenticateMemberRequest) GetPassword() string {
+func (x *Interest) GetCategory() string {
 	if x != nil {
-		return x.Password
+		return x.Category
 	}
 	return ""
 }
 
-type AuthenticateMemberResponse struct {
+func (x *Interest) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *Interest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Preferences describe who a member wants to see in discovery.
+type Preferences struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	MinAge        int32                  `protobuf:"varint,1,opt,name=min_age,json=minAge,proto3" json:"min_age,omitempty"`
+	MaxAge        int32                  `protobuf:"varint,2,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	Genders       []Gender               `protobuf:"varint,3,rep,packed,name=genders,proto3,enum=member.v1.Gender" json:"genders,omitempty"`
+	MaxDistanceKm int32                  `protobuf:"varint,4,opt,name=max_distance_km,json=maxDistanceKm,proto3" json:"max_distance_km,omitempty"`
+	// Preferences listed here are hard filters; the others only rank candidates.
+	Dealbreakers  []Dealbreaker `protobuf:"varint,5,rep,packed,name=dealbreakers,proto3,enum=member.v1.Dealbreaker" json:"dealbreakers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuthenticateMemberResponse) Reset() {
-	*x = AuthenticateMemberResponse{}
-	mi := &file_member_v1_member_proto_msgTypes[5]
+func (x *Preferences) Reset() {
+	*x = Preferences{}
+	mi := &file_member_v1_member_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthenticateMemberResponse) String() string {
+func (x *Preferences) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthenticateMemberResponse) ProtoMessage() {}
+func (*Preferences) ProtoMessage() {}
 
-func (x *AuthenticateMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_member_v1_member_proto_msgTypes[5]
+func (x *Preferences) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -474,40 +790,3258 @@ func (x *AuthenticateMemberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthenticateMemberResponse.ProtoReflect.Descriptor instead.
-func (*AuthenticateMemberResponse) Descriptor() ([]byte, []int) {
-	return file_member_v1_member_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Preferences.ProtoReflect.Descriptor instead.
+func (*Preferences) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *AuthenticateMemberResponse) GetMember() *Member {
+func (x *Preferences) GetMinAge() int32 {
 	if x != nil {
-		return x.Member
+		return x.MinAge
+	}
+	return 0
+}
+
+func (x *Preferences) GetMaxAge() int32 {
+	if x != nil {
+		return x.MaxAge
+	}
+	return 0
+}
+
+func (x *Preferences) GetGenders() []Gender {
+	if x != nil {
+		return x.Genders
+	}
+	return nil
+}
+
+func (x *Preferences) GetMaxDistanceKm() int32 {
+	if x != nil {
+		return x.MaxDistanceKm
+	}
+	return 0
+}
+
+func (x *Preferences) GetDealbreakers() []Dealbreaker {
+	if x != nil {
+		return x.Dealbreakers
+	}
+	return nil
+}
+
+type RegisterMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterMemberRequest) Reset() {
+	*x = RegisterMemberRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterMemberRequest) ProtoMessage() {}
+
+func (x *RegisterMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterMemberRequest.ProtoReflect.Descriptor instead.
+func (*RegisterMemberRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RegisterMemberRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RegisterMemberRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type RegisterMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterMemberResponse) Reset() {
+	*x = RegisterMemberResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterMemberResponse) ProtoMessage() {}
+
+func (x *RegisterMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterMemberResponse.ProtoReflect.Descriptor instead.
+func (*RegisterMemberResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RegisterMemberResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type AuthenticateMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthenticateMemberRequest) Reset() {
+	*x = AuthenticateMemberRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthenticateMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticateMemberRequest) ProtoMessage() {}
+
+func (x *AuthenticateMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticateMemberRequest.ProtoReflect.Descriptor instead.
+func (*AuthenticateMemberRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AuthenticateMemberRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AuthenticateMemberRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type AuthenticateMemberResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Member *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	// Staff roles of the member, empty for members.
+	Roles         []string `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthenticateMemberResponse) Reset() {
+	*x = AuthenticateMemberResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthenticateMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticateMemberResponse) ProtoMessage() {}
+
+func (x *AuthenticateMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticateMemberResponse.ProtoReflect.Descriptor instead.
+func (*AuthenticateMemberResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AuthenticateMemberResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *AuthenticateMemberResponse) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type GetMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemberRequest) Reset() {
+	*x = GetMemberRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemberRequest) ProtoMessage() {}
+
+func (x *GetMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemberRequest.ProtoReflect.Descriptor instead.
+func (*GetMemberRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetMemberRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type GetMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemberResponse) Reset() {
+	*x = GetMemberResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemberResponse) ProtoMessage() {}
+
+func (x *GetMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemberResponse.ProtoReflect.Descriptor instead.
+func (*GetMemberResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetMemberResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type UpdateProfileRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MemberId string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Profile  *Profile               `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	// Fields of profile to change, from display_name, bio, birth_date and
+	// gender. Empty replaces all four.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateProfileRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *UpdateProfileRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateProfileResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type ListInterestsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Language tag such as "nl" or "en-GB"; unsupported languages fall back to English.
+	Locale        string `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInterestsRequest) Reset() {
+	*x = ListInterestsRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInterestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInterestsRequest) ProtoMessage() {}
+
+func (x *ListInterestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInterestsRequest.ProtoReflect.Descriptor instead.
+func (*ListInterestsRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListInterestsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type ListInterestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interests     []*Interest            `protobuf:"bytes,1,rep,name=interests,proto3" json:"interests,omitempty"`
+	MaxInterests  int32                  `protobuf:"varint,2,opt,name=max_interests,json=maxInterests,proto3" json:"max_interests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInterestsResponse) Reset() {
+	*x = ListInterestsResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInterestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInterestsResponse) ProtoMessage() {}
+
+func (x *ListInterestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInterestsResponse.ProtoReflect.Descriptor instead.
+func (*ListInterestsResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListInterestsResponse) GetInterests() []*Interest {
+	if x != nil {
+		return x.Interests
+	}
+	return nil
+}
+
+func (x *ListInterestsResponse) GetMaxInterests() int32 {
+	if x != nil {
+		return x.MaxInterests
+	}
+	return 0
+}
+
+type SetInterestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	InterestIds   []string               `protobuf:"bytes,2,rep,name=interest_ids,json=interestIds,proto3" json:"interest_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetInterestsRequest) Reset() {
+	*x = SetInterestsRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetInterestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInterestsRequest) ProtoMessage() {}
+
+func (x *SetInterestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInterestsRequest.ProtoReflect.Descriptor instead.
+func (*SetInterestsRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetInterestsRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *SetInterestsRequest) GetInterestIds() []string {
+	if x != nil {
+		return x.InterestIds
+	}
+	return nil
+}
+
+type SetInterestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetInterestsResponse) Reset() {
+	*x = SetInterestsResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetInterestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInterestsResponse) ProtoMessage() {}
+
+func (x *SetInterestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInterestsResponse.ProtoReflect.Descriptor instead.
+func (*SetInterestsResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetInterestsResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type ListPromptsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Language tag such as "nl" or "en-GB"; unsupported languages fall back to English.
+	Locale        string `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromptsRequest) Reset() {
+	*x = ListPromptsRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromptsRequest) ProtoMessage() {}
+
+func (x *ListPromptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromptsRequest.ProtoReflect.Descriptor instead.
+func (*ListPromptsRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListPromptsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type ListPromptsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompts       []*Prompt              `protobuf:"bytes,1,rep,name=prompts,proto3" json:"prompts,omitempty"`
+	MaxPrompts    int32                  `protobuf:"varint,2,opt,name=max_prompts,json=maxPrompts,proto3" json:"max_prompts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromptsResponse) Reset() {
+	*x = ListPromptsResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromptsResponse) ProtoMessage() {}
+
+func (x *ListPromptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromptsResponse.ProtoReflect.Descriptor instead.
+func (*ListPromptsResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListPromptsResponse) GetPrompts() []*Prompt {
+	if x != nil {
+		return x.Prompts
+	}
+	return nil
+}
+
+func (x *ListPromptsResponse) GetMaxPrompts() int32 {
+	if x != nil {
+		return x.MaxPrompts
+	}
+	return 0
+}
+
+type AnswerPromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	PromptId      string                 `protobuf:"bytes,2,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
+	Answer        string                 `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnswerPromptRequest) Reset() {
+	*x = AnswerPromptRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswerPromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerPromptRequest) ProtoMessage() {}
+
+func (x *AnswerPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerPromptRequest.ProtoReflect.Descriptor instead.
+func (*AnswerPromptRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AnswerPromptRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *AnswerPromptRequest) GetPromptId() string {
+	if x != nil {
+		return x.PromptId
+	}
+	return ""
+}
+
+func (x *AnswerPromptRequest) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+type AnswerPromptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnswerPromptResponse) Reset() {
+	*x = AnswerPromptResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswerPromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerPromptResponse) ProtoMessage() {}
+
+func (x *AnswerPromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerPromptResponse.ProtoReflect.Descriptor instead.
+func (*AnswerPromptResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AnswerPromptResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type RemovePromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	PromptId      string                 `protobuf:"bytes,2,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemovePromptRequest) Reset() {
+	*x = RemovePromptRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePromptRequest) ProtoMessage() {}
+
+func (x *RemovePromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePromptRequest.ProtoReflect.Descriptor instead.
+func (*RemovePromptRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RemovePromptRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *RemovePromptRequest) GetPromptId() string {
+	if x != nil {
+		return x.PromptId
+	}
+	return ""
+}
+
+type RemovePromptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemovePromptResponse) Reset() {
+	*x = RemovePromptResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePromptResponse) ProtoMessage() {}
+
+func (x *RemovePromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePromptResponse.ProtoReflect.Descriptor instead.
+func (*RemovePromptResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RemovePromptResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type ReorderPromptsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	PromptIds     []string               `protobuf:"bytes,2,rep,name=prompt_ids,json=promptIds,proto3" json:"prompt_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderPromptsRequest) Reset() {
+	*x = ReorderPromptsRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderPromptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderPromptsRequest) ProtoMessage() {}
+
+func (x *ReorderPromptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderPromptsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderPromptsRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReorderPromptsRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *ReorderPromptsRequest) GetPromptIds() []string {
+	if x != nil {
+		return x.PromptIds
+	}
+	return nil
+}
+
+type ReorderPromptsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderPromptsResponse) Reset() {
+	*x = ReorderPromptsResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderPromptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderPromptsResponse) ProtoMessage() {}
+
+func (x *ReorderPromptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderPromptsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderPromptsResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReorderPromptsResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type GetPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPreferencesRequest) Reset() {
+	*x = GetPreferencesRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreferencesRequest) ProtoMessage() {}
+
+func (x *GetPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetPreferencesRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type GetPreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preferences   *Preferences           `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPreferencesResponse) Reset() {
+	*x = GetPreferencesResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreferencesResponse) ProtoMessage() {}
+
+func (x *GetPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetPreferencesResponse) GetPreferences() *Preferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdatePreferencesRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	MemberId    string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Preferences *Preferences           `protobuf:"bytes,2,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	// Fields of preferences to change. Empty replaces them all.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePreferencesRequest) Reset() {
+	*x = UpdatePreferencesRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePreferencesRequest) ProtoMessage() {}
+
+func (x *UpdatePreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdatePreferencesRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *UpdatePreferencesRequest) GetPreferences() *Preferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *UpdatePreferencesRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdatePreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preferences   *Preferences           `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePreferencesResponse) Reset() {
+	*x = UpdatePreferencesResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePreferencesResponse) ProtoMessage() {}
+
+func (x *UpdatePreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdatePreferencesResponse) GetPreferences() *Preferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type SendEmailVerificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendEmailVerificationRequest) Reset() {
+	*x = SendEmailVerificationRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEmailVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEmailVerificationRequest) ProtoMessage() {}
+
+func (x *SendEmailVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendEmailVerificationRequest.ProtoReflect.Descriptor instead.
+func (*SendEmailVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SendEmailVerificationRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type SendEmailVerificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendEmailVerificationResponse) Reset() {
+	*x = SendEmailVerificationResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEmailVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEmailVerificationResponse) ProtoMessage() {}
+
+func (x *SendEmailVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendEmailVerificationResponse.ProtoReflect.Descriptor instead.
+func (*SendEmailVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{32}
+}
+
+type VerifyEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Token from the verification link.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailRequest) Reset() {
+	*x = VerifyEmailRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailRequest) ProtoMessage() {}
+
+func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *VerifyEmailRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type VerifyEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailResponse) Reset() {
+	*x = VerifyEmailResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailResponse) ProtoMessage() {}
+
+func (x *VerifyEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailResponse.ProtoReflect.Descriptor instead.
+func (*VerifyEmailResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *VerifyEmailResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type ActivateMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateMemberRequest) Reset() {
+	*x = ActivateMemberRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateMemberRequest) ProtoMessage() {}
+
+func (x *ActivateMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateMemberRequest.ProtoReflect.Descriptor instead.
+func (*ActivateMemberRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ActivateMemberRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type ActivateMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateMemberResponse) Reset() {
+	*x = ActivateMemberResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateMemberResponse) ProtoMessage() {}
+
+func (x *ActivateMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateMemberResponse.ProtoReflect.Descriptor instead.
+func (*ActivateMemberResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ActivateMemberResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type AddPhotoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	PhotoId       string                 `protobuf:"bytes,2,opt,name=photo_id,json=photoId,proto3" json:"photo_id,omitempty"`
+	PhotoUrl      string                 `protobuf:"bytes,3,opt,name=photo_url,json=photoUrl,proto3" json:"photo_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPhotoRequest) Reset() {
+	*x = AddPhotoRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPhotoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPhotoRequest) ProtoMessage() {}
+
+func (x *AddPhotoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPhotoRequest.ProtoReflect.Descriptor instead.
+func (*AddPhotoRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AddPhotoRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *AddPhotoRequest) GetPhotoId() string {
+	if x != nil {
+		return x.PhotoId
+	}
+	return ""
+}
+
+func (x *AddPhotoRequest) GetPhotoUrl() string {
+	if x != nil {
+		return x.PhotoUrl
+	}
+	return ""
+}
+
+type AddPhotoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPhotoResponse) Reset() {
+	*x = AddPhotoResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPhotoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPhotoResponse) ProtoMessage() {}
+
+func (x *AddPhotoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPhotoResponse.ProtoReflect.Descriptor instead.
+func (*AddPhotoResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AddPhotoResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type RemovePhotoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	PhotoId       string                 `protobuf:"bytes,2,opt,name=photo_id,json=photoId,proto3" json:"photo_id,omitempty"`
+	PhotoUrl      string                 `protobuf:"bytes,3,opt,name=photo_url,json=photoUrl,proto3" json:"photo_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemovePhotoRequest) Reset() {
+	*x = RemovePhotoRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePhotoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePhotoRequest) ProtoMessage() {}
+
+func (x *RemovePhotoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePhotoRequest.ProtoReflect.Descriptor instead.
+func (*RemovePhotoRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RemovePhotoRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *RemovePhotoRequest) GetPhotoId() string {
+	if x != nil {
+		return x.PhotoId
+	}
+	return ""
+}
+
+func (x *RemovePhotoRequest) GetPhotoUrl() string {
+	if x != nil {
+		return x.PhotoUrl
+	}
+	return ""
+}
+
+type RemovePhotoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemovePhotoResponse) Reset() {
+	*x = RemovePhotoResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePhotoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePhotoResponse) ProtoMessage() {}
+
+func (x *RemovePhotoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePhotoResponse.ProtoReflect.Descriptor instead.
+func (*RemovePhotoResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RemovePhotoResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type ReorderPhotosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	PhotoUrls     []string               `protobuf:"bytes,2,rep,name=photo_urls,json=photoUrls,proto3" json:"photo_urls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderPhotosRequest) Reset() {
+	*x = ReorderPhotosRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderPhotosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderPhotosRequest) ProtoMessage() {}
+
+func (x *ReorderPhotosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderPhotosRequest.ProtoReflect.Descriptor instead.
+func (*ReorderPhotosRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReorderPhotosRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *ReorderPhotosRequest) GetPhotoUrls() []string {
+	if x != nil {
+		return x.PhotoUrls
+	}
+	return nil
+}
+
+type ReorderPhotosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderPhotosResponse) Reset() {
+	*x = ReorderPhotosResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderPhotosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderPhotosResponse) ProtoMessage() {}
+
+func (x *ReorderPhotosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderPhotosResponse.ProtoReflect.Descriptor instead.
+func (*ReorderPhotosResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReorderPhotosResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type SetPrimaryPhotoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	PhotoUrl      string                 `protobuf:"bytes,2,opt,name=photo_url,json=photoUrl,proto3" json:"photo_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPrimaryPhotoRequest) Reset() {
+	*x = SetPrimaryPhotoRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrimaryPhotoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrimaryPhotoRequest) ProtoMessage() {}
+
+func (x *SetPrimaryPhotoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrimaryPhotoRequest.ProtoReflect.Descriptor instead.
+func (*SetPrimaryPhotoRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SetPrimaryPhotoRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *SetPrimaryPhotoRequest) GetPhotoUrl() string {
+	if x != nil {
+		return x.PhotoUrl
+	}
+	return ""
+}
+
+type SetPrimaryPhotoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPrimaryPhotoResponse) Reset() {
+	*x = SetPrimaryPhotoResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrimaryPhotoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrimaryPhotoResponse) ProtoMessage() {}
+
+func (x *SetPrimaryPhotoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrimaryPhotoResponse.ProtoReflect.Descriptor instead.
+func (*SetPrimaryPhotoResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SetPrimaryPhotoResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type SuspendMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendMemberRequest) Reset() {
+	*x = SuspendMemberRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendMemberRequest) ProtoMessage() {}
+
+func (x *SuspendMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendMemberRequest.ProtoReflect.Descriptor instead.
+func (*SuspendMemberRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SuspendMemberRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *SuspendMemberRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SuspendMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendMemberResponse) Reset() {
+	*x = SuspendMemberResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendMemberResponse) ProtoMessage() {}
+
+func (x *SuspendMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendMemberResponse.ProtoReflect.Descriptor instead.
+func (*SuspendMemberResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SuspendMemberResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type BlockMemberRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MemberId        string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	BlockedMemberId string                 `protobuf:"bytes,2,opt,name=blocked_member_id,json=blockedMemberId,proto3" json:"blocked_member_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BlockMemberRequest) Reset() {
+	*x = BlockMemberRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockMemberRequest) ProtoMessage() {}
+
+func (x *BlockMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockMemberRequest.ProtoReflect.Descriptor instead.
+func (*BlockMemberRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *BlockMemberRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *BlockMemberRequest) GetBlockedMemberId() string {
+	if x != nil {
+		return x.BlockedMemberId
+	}
+	return ""
+}
+
+type BlockMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockMemberResponse) Reset() {
+	*x = BlockMemberResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockMemberResponse) ProtoMessage() {}
+
+func (x *BlockMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockMemberResponse.ProtoReflect.Descriptor instead.
+func (*BlockMemberResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{48}
+}
+
+type UnblockMemberRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MemberId        string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	BlockedMemberId string                 `protobuf:"bytes,2,opt,name=blocked_member_id,json=blockedMemberId,proto3" json:"blocked_member_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UnblockMemberRequest) Reset() {
+	*x = UnblockMemberRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockMemberRequest) ProtoMessage() {}
+
+func (x *UnblockMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockMemberRequest.ProtoReflect.Descriptor instead.
+func (*UnblockMemberRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UnblockMemberRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *UnblockMemberRequest) GetBlockedMemberId() string {
+	if x != nil {
+		return x.BlockedMemberId
+	}
+	return ""
+}
+
+type UnblockMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockMemberResponse) Reset() {
+	*x = UnblockMemberResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockMemberResponse) ProtoMessage() {}
+
+func (x *UnblockMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockMemberResponse.ProtoReflect.Descriptor instead.
+func (*UnblockMemberResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{50}
+}
+
+type ListBlockedMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedMembersRequest) Reset() {
+	*x = ListBlockedMembersRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedMembersRequest) ProtoMessage() {}
+
+func (x *ListBlockedMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListBlockedMembersRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListBlockedMembersRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type ListBlockedMembersResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	BlockedMemberIds []string               `protobuf:"bytes,1,rep,name=blocked_member_ids,json=blockedMemberIds,proto3" json:"blocked_member_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListBlockedMembersResponse) Reset() {
+	*x = ListBlockedMembersResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedMembersResponse) ProtoMessage() {}
+
+func (x *ListBlockedMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListBlockedMembersResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListBlockedMembersResponse) GetBlockedMemberIds() []string {
+	if x != nil {
+		return x.BlockedMemberIds
+	}
+	return nil
+}
+
+// CheckBlockedRequest asks whether either member has blocked the other.
+type CheckBlockedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	OtherMemberId string                 `protobuf:"bytes,2,opt,name=other_member_id,json=otherMemberId,proto3" json:"other_member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckBlockedRequest) Reset() {
+	*x = CheckBlockedRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckBlockedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckBlockedRequest) ProtoMessage() {}
+
+func (x *CheckBlockedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckBlockedRequest.ProtoReflect.Descriptor instead.
+func (*CheckBlockedRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CheckBlockedRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *CheckBlockedRequest) GetOtherMemberId() string {
+	if x != nil {
+		return x.OtherMemberId
+	}
+	return ""
+}
+
+type CheckBlockedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Blocked       bool                   `protobuf:"varint,1,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckBlockedResponse) Reset() {
+	*x = CheckBlockedResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckBlockedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckBlockedResponse) ProtoMessage() {}
+
+func (x *CheckBlockedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckBlockedResponse.ProtoReflect.Descriptor instead.
+func (*CheckBlockedResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CheckBlockedResponse) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+type ListSessionRevocationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionRevocationsRequest) Reset() {
+	*x = ListSessionRevocationsRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionRevocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionRevocationsRequest) ProtoMessage() {}
+
+func (x *ListSessionRevocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionRevocationsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionRevocationsRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListSessionRevocationsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+type ListSessionRevocationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revocations   []*SessionRevocation   `protobuf:"bytes,1,rep,name=revocations,proto3" json:"revocations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionRevocationsResponse) Reset() {
+	*x = ListSessionRevocationsResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionRevocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionRevocationsResponse) ProtoMessage() {}
+
+func (x *ListSessionRevocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionRevocationsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionRevocationsResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListSessionRevocationsResponse) GetRevocations() []*SessionRevocation {
+	if x != nil {
+		return x.Revocations
+	}
+	return nil
+}
+
+// SessionRevocation says that the member's tokens issued before revoked_at
+// are no longer valid.
+type SessionRevocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionRevocation) Reset() {
+	*x = SessionRevocation{}
+	mi := &file_member_v1_member_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionRevocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionRevocation) ProtoMessage() {}
+
+func (x *SessionRevocation) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionRevocation.ProtoReflect.Descriptor instead.
+func (*SessionRevocation) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SessionRevocation) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *SessionRevocation) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+type GetMemberHistoryRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MemberId string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	// Defaults to 50, at most 200.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemberHistoryRequest) Reset() {
+	*x = GetMemberHistoryRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemberHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemberHistoryRequest) ProtoMessage() {}
+
+func (x *GetMemberHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemberHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetMemberHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetMemberHistoryRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *GetMemberHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetMemberHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetMemberHistoryResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Entries []*HistoryEntry        `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemberHistoryResponse) Reset() {
+	*x = GetMemberHistoryResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemberHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemberHistoryResponse) ProtoMessage() {}
+
+func (x *GetMemberHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemberHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetMemberHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetMemberHistoryResponse) GetEntries() []*HistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetMemberHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type HistoryEntry struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Version    int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	EventType  string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Human-readable description, e.g. "Profile updated".
+	Summary       string           `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	Details       []*HistoryDetail `protobuf:"bytes,5,rep,name=details,proto3" json:"details,omitempty"`
+	Metadata      *EventMetadata   `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryEntry) Reset() {
+	*x = HistoryEntry{}
+	mi := &file_member_v1_member_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryEntry) ProtoMessage() {}
+
+func (x *HistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryEntry.ProtoReflect.Descriptor instead.
+func (*HistoryEntry) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *HistoryEntry) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *HistoryEntry) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *HistoryEntry) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetDetails() []*HistoryDetail {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *HistoryEntry) GetMetadata() *EventMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type HistoryDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryDetail) Reset() {
+	*x = HistoryDetail{}
+	mi := &file_member_v1_member_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryDetail) ProtoMessage() {}
+
+func (x *HistoryDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryDetail.ProtoReflect.Descriptor instead.
+func (*HistoryDetail) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *HistoryDetail) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HistoryDetail) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// EventMetadata records what caused an event. Events recorded before
+// metadata was kept have none.
+type EventMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CorrelationId string                 `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	// ID of the message that triggered the change. Empty for changes made
+	// directly for a client request.
+	CausationId string `protobuf:"bytes,2,opt,name=causation_id,json=causationId,proto3" json:"causation_id,omitempty"`
+	// Member or staff member who made the change.
+	UserId        string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventMetadata) Reset() {
+	*x = EventMetadata{}
+	mi := &file_member_v1_member_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventMetadata) ProtoMessage() {}
+
+func (x *EventMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventMetadata.ProtoReflect.Descriptor instead.
+func (*EventMetadata) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *EventMetadata) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *EventMetadata) GetCausationId() string {
+	if x != nil {
+		return x.CausationId
+	}
+	return ""
+}
+
+func (x *EventMetadata) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetMemberStateAtRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemberStateAtRequest) Reset() {
+	*x = GetMemberStateAtRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemberStateAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemberStateAtRequest) ProtoMessage() {}
+
+func (x *GetMemberStateAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemberStateAtRequest.ProtoReflect.Descriptor instead.
+func (*GetMemberStateAtRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetMemberStateAtRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *GetMemberStateAtRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type GetMemberStateAtResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Member *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	// Version of the last event applied.
+	Version       int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemberStateAtResponse) Reset() {
+	*x = GetMemberStateAtResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemberStateAtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemberStateAtResponse) ProtoMessage() {}
+
+func (x *GetMemberStateAtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemberStateAtResponse.ProtoReflect.Descriptor instead.
+func (*GetMemberStateAtResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetMemberStateAtResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *GetMemberStateAtResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// SearchMembersRequest filters the members read model. Filters combine;
+// unset ones do not narrow the search, so an empty request lists every
+// member.
+type SearchMembersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A member ID, or part of an email address or display name. Partial
+	// matches ignore case.
+	Query    string         `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Statuses []MemberStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=member.v1.MemberStatus" json:"statuses,omitempty"`
+	// Registered at or after this time.
+	CreatedAfter *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// Registered before this time.
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	Genders       []Gender               `protobuf:"varint,5,rep,packed,name=genders,proto3,enum=member.v1.Gender" json:"genders,omitempty"`
+	// Age bounds in years, inclusive; 0 leaves the bound open. Members
+	// without a birth date only match when both are 0.
+	MinAge int32      `protobuf:"varint,6,opt,name=min_age,json=minAge,proto3" json:"min_age,omitempty"`
+	MaxAge int32      `protobuf:"varint,7,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	Sort   MemberSort `protobuf:"varint,8,opt,name=sort,proto3,enum=member.v1.MemberSort" json:"sort,omitempty"`
+	// Defaults to 50, at most 200.
+	PageSize int32 `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// From the previous response; only valid with the same sort.
+	PageToken     string `protobuf:"bytes,10,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMembersRequest) Reset() {
+	*x = SearchMembersRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMembersRequest) ProtoMessage() {}
+
+func (x *SearchMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMembersRequest.ProtoReflect.Descriptor instead.
+func (*SearchMembersRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SearchMembersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchMembersRequest) GetStatuses() []MemberStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *SearchMembersRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *SearchMembersRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *SearchMembersRequest) GetGenders() []Gender {
+	if x != nil {
+		return x.Genders
+	}
+	return nil
+}
+
+func (x *SearchMembersRequest) GetMinAge() int32 {
+	if x != nil {
+		return x.MinAge
+	}
+	return 0
+}
+
+func (x *SearchMembersRequest) GetMaxAge() int32 {
+	if x != nil {
+		return x.MaxAge
+	}
+	return 0
+}
+
+func (x *SearchMembersRequest) GetSort() MemberSort {
+	if x != nil {
+		return x.Sort
+	}
+	return MemberSort_MEMBER_SORT_UNSPECIFIED
+}
+
+func (x *SearchMembersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchMembersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchMembersResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Members []*MemberDetails       `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMembersResponse) Reset() {
+	*x = SearchMembersResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMembersResponse) ProtoMessage() {}
+
+func (x *SearchMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMembersResponse.ProtoReflect.Descriptor instead.
+func (*SearchMembersResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SearchMembersResponse) GetMembers() []*MemberDetails {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *SearchMembersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// MemberDetails is a member as staff see them.
+type MemberDetails struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Member *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Roles  []string               `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	// When the member was last signed out everywhere; unset if never.
+	SessionsRevokedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=sessions_revoked_at,json=sessionsRevokedAt,proto3" json:"sessions_revoked_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MemberDetails) Reset() {
+	*x = MemberDetails{}
+	mi := &file_member_v1_member_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberDetails) ProtoMessage() {}
+
+func (x *MemberDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberDetails.ProtoReflect.Descriptor instead.
+func (*MemberDetails) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *MemberDetails) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *MemberDetails) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *MemberDetails) GetSessionsRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SessionsRevokedAt
+	}
+	return nil
+}
+
+type ReinstateMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReinstateMemberRequest) Reset() {
+	*x = ReinstateMemberRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReinstateMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReinstateMemberRequest) ProtoMessage() {}
+
+func (x *ReinstateMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReinstateMemberRequest.ProtoReflect.Descriptor instead.
+func (*ReinstateMemberRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ReinstateMemberRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *ReinstateMemberRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReinstateMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReinstateMemberResponse) Reset() {
+	*x = ReinstateMemberResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReinstateMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReinstateMemberResponse) ProtoMessage() {}
+
+func (x *ReinstateMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReinstateMemberResponse.ProtoReflect.Descriptor instead.
+func (*ReinstateMemberResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ReinstateMemberResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
 	}
 	return nil
 }
 
-type GetMemberRequest struct {
+type RevokeSessionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMemberRequest) Reset() {
-	*x = GetMemberRequest{}
-	mi := &file_member_v1_member_proto_msgTypes[6]
+func (x *RevokeSessionsRequest) Reset() {
+	*x = RevokeSessionsRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMemberRequest) String() string {
+func (x *RevokeSessionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMemberRequest) ProtoMessage() {}
+func (*RevokeSessionsRequest) ProtoMessage() {}
 
-func (x *GetMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_member_v1_member_proto_msgTypes[6]
+func (x *RevokeSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -518,40 +4052,47 @@ func (x *GetMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMemberRequest.ProtoReflect.Descriptor instead.
-func (*GetMemberRequest) Descriptor() ([]byte, []int) {
-	return file_member_v1_member_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use RevokeSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *GetMemberRequest) GetMemberId() string {
+func (x *RevokeSessionsRequest) GetMemberId() string {
 	if x != nil {
 		return x.MemberId
 	}
 	return ""
 }
 
-type GetMemberResponse struct {
+func (x *RevokeSessionsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RevokeSessionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMemberResponse) Reset() {
-	*x = GetMemberResponse{}
-	mi := &file_member_v1_member_proto_msgTypes[7]
+func (x *RevokeSessionsResponse) Reset() {
+	*x = RevokeSessionsResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMemberResponse) String() string {
+func (x *RevokeSessionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMemberResponse) ProtoMessage() {}
+func (*RevokeSessionsResponse) ProtoMessage() {}
 
-func (x *GetMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_member_v1_member_proto_msgTypes[7]
+func (x *RevokeSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -562,41 +4103,42 @@ func (x *GetMemberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMemberResponse.ProtoReflect.Descriptor instead.
-func (*GetMemberResponse) Descriptor() ([]byte, []int) {
-	return file_member_v1_member_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use RevokeSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *GetMemberResponse) GetMember() *Member {
+func (x *RevokeSessionsResponse) GetRevokedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Member
+		return x.RevokedAt
 	}
 	return nil
 }
 
-type UpdateProfileRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
-	Profile       *Profile               `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+type SetMemberRolesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MemberId string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	// Replaces the current roles; empty removes them all.
+	Roles         []string `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProfileRequest) Reset() {
-	*x = UpdateProfileRequest{}
-	mi := &file_member_v1_member_proto_msgTypes[8]
+func (x *SetMemberRolesRequest) Reset() {
+	*x = SetMemberRolesRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProfileRequest) String() string {
+func (x *SetMemberRolesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProfileRequest) ProtoMessage() {}
+func (*SetMemberRolesRequest) ProtoMessage() {}
 
-func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_member_v1_member_proto_msgTypes[8]
+func (x *SetMemberRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -607,47 +4149,47 @@ func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
-	return file_member_v1_member_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SetMemberRolesRequest.ProtoReflect.Descriptor instead.
+func (*SetMemberRolesRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *UpdateProfileRequest) GetMemberId() string {
+func (x *SetMemberRolesRequest) GetMemberId() string {
 	if x != nil {
 		return x.MemberId
 	}
 	return ""
 }
 
-func (x *UpdateProfileRequest) GetProfile() *Profile {
+func (x *SetMemberRolesRequest) GetRoles() []string {
 	if x != nil {
-		return x.Profile
+		return x.Roles
 	}
 	return nil
 }
 
-type UpdateProfileResponse struct {
+type SetMemberRolesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Roles         []string               `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProfileResponse) Reset() {
-	*x = UpdateProfileResponse{}
-	mi := &file_member_v1_member_proto_msgTypes[9]
+func (x *SetMemberRolesResponse) Reset() {
+	*x = SetMemberRolesResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProfileResponse) String() string {
+func (x *SetMemberRolesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProfileResponse) ProtoMessage() {}
+func (*SetMemberRolesResponse) ProtoMessage() {}
 
-func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_member_v1_member_proto_msgTypes[9]
+func (x *SetMemberRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -658,40 +4200,46 @@ func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
-	return file_member_v1_member_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use SetMemberRolesResponse.ProtoReflect.Descriptor instead.
+func (*SetMemberRolesResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *UpdateProfileResponse) GetMember() *Member {
+func (x *SetMemberRolesResponse) GetRoles() []string {
 	if x != nil {
-		return x.Member
+		return x.Roles
 	}
 	return nil
 }
 
-type ActivateMemberRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+type ListAuditLogRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only calls about this member.
+	MemberId string `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	// Only calls made by this staff member.
+	ActorId string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// Defaults to 50, at most 200.
+	PageSize      int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivateMemberRequest) Reset() {
-	*x = ActivateMemberRequest{}
-	mi := &file_member_v1_member_proto_msgTypes[10]
+func (x *ListAuditLogRequest) Reset() {
+	*x = ListAuditLogRequest{}
+	mi := &file_member_v1_member_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivateMemberRequest) String() string {
+func (x *ListAuditLogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateMemberRequest) ProtoMessage() {}
+func (*ListAuditLogRequest) ProtoMessage() {}
 
-func (x *ActivateMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_member_v1_member_proto_msgTypes[10]
+func (x *ListAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,40 +4250,63 @@ func (x *ActivateMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateMemberRequest.ProtoReflect.Descriptor instead.
-func (*ActivateMemberRequest) Descriptor() ([]byte, []int) {
-	return file_member_v1_member_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *ActivateMemberRequest) GetMemberId() string {
+func (x *ListAuditLogRequest) GetMemberId() string {
 	if x != nil {
 		return x.MemberId
 	}
 	return ""
 }
 
-type ActivateMemberResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+func (x *ListAuditLogRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditLogRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListAuditLogResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Entries []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivateMemberResponse) Reset() {
-	*x = ActivateMemberResponse{}
-	mi := &file_member_v1_member_proto_msgTypes[11]
+func (x *ListAuditLogResponse) Reset() {
+	*x = ListAuditLogResponse{}
+	mi := &file_member_v1_member_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivateMemberResponse) String() string {
+func (x *ListAuditLogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateMemberResponse) ProtoMessage() {}
+func (*ListAuditLogResponse) ProtoMessage() {}
 
-func (x *ActivateMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_member_v1_member_proto_msgTypes[11]
+func (x *ListAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -746,23 +4317,147 @@ func (x *ActivateMemberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateMemberResponse.ProtoReflect.Descriptor instead.
-func (*ActivateMemberResponse) Descriptor() ([]byte, []int) {
-	return file_member_v1_member_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *ActivateMemberResponse) GetMember() *Member {
+func (x *ListAuditLogResponse) GetEntries() []*AuditEntry {
 	if x != nil {
-		return x.Member
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListAuditLogResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// AuditEntry records one call to an admin method. Entries cannot be
+// changed or removed.
+type AuditEntry struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Staff member the call was made for; empty for operators calling
+	// directly.
+	ActorId string `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// Service that made the call, such as "gateway" or "admin".
+	Caller string `protobuf:"bytes,4,opt,name=caller,proto3" json:"caller,omitempty"`
+	// Full method name, e.g. "/member.v1.MemberAdminService/SuspendMember".
+	Method string `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	// Member the call was about, if any.
+	MemberId string `protobuf:"bytes,6,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	// The request as JSON.
+	Request string `protobuf:"bytes,7,opt,name=request,proto3" json:"request,omitempty"`
+	// gRPC status code of the call, e.g. "OK" or "NotFound".
+	Code          string `protobuf:"bytes,8,opt,name=code,proto3" json:"code,omitempty"`
+	RequestId     string `protobuf:"bytes,9,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_member_v1_member_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_member_v1_member_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_member_v1_member_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *AuditEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
 	}
 	return nil
 }
 
+func (x *AuditEntry) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
 var File_member_v1_member_proto protoreflect.FileDescriptor
 
 const file_member_v1_member_proto_rawDesc = "" +
 	"\n" +
-	"\x16member/v1/member.proto\x12\tmember.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x83\x02\n" +
+	"\x16member/v1/member.proto\x12\tmember.v1\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1avalidate/v1/validate.proto\"\xe1\x02\n" +
 	"\x06Member\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12,\n" +
@@ -771,7 +4466,16 @@ const file_member_v1_member_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe1\x01\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12%\n" +
+	"\x0eemail_verified\x18\a \x01(\bR\remailVerified\x125\n" +
+	"\n" +
+	"onboarding\x18\b \x01(\v2\x15.member.v1.OnboardingR\n" +
+	"onboarding\"\xac\x01\n" +
+	"\n" +
+	"Onboarding\x126\n" +
+	"\tnext_step\x18\x01 \x01(\x0e2\x19.member.v1.OnboardingStepR\bnextStep\x12B\n" +
+	"\x0fcompleted_steps\x18\x02 \x03(\x0e2\x19.member.v1.OnboardingStepR\x0ecompletedSteps\x12\"\n" +
+	"\fcompleteness\x18\x03 \x01(\x05R\fcompleteness\"\xc1\x02\n" +
 	"\aProfile\x12!\n" +
 	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\x12\x10\n" +
 	"\x03bio\x18\x02 \x01(\tR\x03bio\x129\n" +
@@ -780,30 +4484,258 @@ const file_member_v1_member_proto_rawDesc = "" +
 	"\x06gender\x18\x04 \x01(\x0e2\x11.member.v1.GenderR\x06gender\x12\x1c\n" +
 	"\tinterests\x18\x05 \x03(\tR\tinterests\x12\x1d\n" +
 	"\n" +
-	"photo_urls\x18\x06 \x03(\tR\tphotoUrls\"I\n" +
-	"\x15RegisterMemberRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"C\n" +
+	"photo_urls\x18\x06 \x03(\tR\tphotoUrls\x12*\n" +
+	"\x11primary_photo_url\x18\a \x01(\tR\x0fprimaryPhotoUrl\x122\n" +
+	"\aprompts\x18\b \x03(\v2\x18.member.v1.ProfilePromptR\aprompts\"`\n" +
+	"\rProfilePrompt\x12\x1b\n" +
+	"\tprompt_id\x18\x01 \x01(\tR\bpromptId\x12\x1a\n" +
+	"\bquestion\x18\x02 \x01(\tR\bquestion\x12\x16\n" +
+	"\x06answer\x18\x03 \x01(\tR\x06answer\"4\n" +
+	"\x06Prompt\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bquestion\x18\x02 \x01(\tR\bquestion\"o\n" +
+	"\bInterest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12#\n" +
+	"\rcategory_name\x18\x03 \x01(\tR\fcategoryName\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\"\xd0\x01\n" +
+	"\vPreferences\x12\x17\n" +
+	"\amin_age\x18\x01 \x01(\x05R\x06minAge\x12\x17\n" +
+	"\amax_age\x18\x02 \x01(\x05R\x06maxAge\x12+\n" +
+	"\agenders\x18\x03 \x03(\x0e2\x11.member.v1.GenderR\agenders\x12&\n" +
+	"\x0fmax_distance_km\x18\x04 \x01(\x05R\rmaxDistanceKm\x12:\n" +
+	"\fdealbreakers\x18\x05 \x03(\x0e2\x16.member.v1.DealbreakerR\fdealbreakers\"^\n" +
+	"\x15RegisterMemberRequest\x12\x1f\n" +
+	"\x05email\x18\x01 \x01(\tB\t\xc2\xf3\x18\x05\b\x01\x18\xfe\x01R\x05email\x12$\n" +
+	"\bpassword\x18\x02 \x01(\tB\b\xc2\xf3\x18\x04\b\x01\x18HR\bpassword\"C\n" +
 	"\x16RegisterMemberResponse\x12)\n" +
-	"\x06member\x18\x01 \x01(\v2\x11.member.v1.MemberR\x06member\"M\n" +
-	"\x19AuthenticateMemberRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"G\n" +
+	"\x06member\x18\x01 \x01(\v2\x11.member.v1.MemberR\x06member\"b\n" +
+	"\x19AuthenticateMemberRequest\x12\x1f\n" +
+	"\x05email\x18\x01 \x01(\tB\t\xc2\xf3\x18\x05\b\x01\x18\xfe\x01R\x05email\x12$\n" +
+	"\bpassword\x18\x02 \x01(\tB\b\xc2\xf3\x18\x04\b\x01\x18HR\bpassword\"]\n" +
 	"\x1aAuthenticateMemberResponse\x12)\n" +
-	"\x06member\x18\x01 \x01(\v2\x11.member.v1.MemberR\x06member\"/\n" +
-	"\x10GetMemberRequest\x12\x1b\n" +
-	"\tmember_id\x18\x01 \x01(\tR\bmemberId\">\n" +
+	"\x06member\x18\x01 \x01(\v2\x11.member.v1.MemberR\x06member\x12\x14\n" +
+	"\x05roles\x18\x02 \x03(\tR\x05roles\"9\n" +
+	"\x10GetMemberRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\">\n" +
 	"\x11GetMemberResponse\x12)\n" +
-	"\x06member\x18\x01 \x01(\v2\x11.member.v1.MemberR\x06member\"a\n" +
-	"\x14UpdateProfileRequest\x12\x1b\n" +
-	"\tmember_id\x18\x01 \x01(\tR\bmemberId\x12,\n" +
-	"\aprofile\x18\x02 \x01(\v2\x12.member.v1.ProfileR\aprofile\"B\n" +
+	"\x06member\x18\x01 \x01(\v2\x11.member.v1.MemberR\x06member\"\xb0\x01\n" +
+	"\x14UpdateProfileRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\x124\n" +
+	"\aprofile\x18\x02 \x01(\v2\x12.member.v1.ProfileB\x06\xc2\xf3\x18\x02\b\x01R\aprofile\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"B\n" +
 	"\x15UpdateProfileResponse\x12)\n" +
-	"\x06member\x18\x01 \x01(\v2\x11.member.v1.MemberR\x06member\"4\n" +
-	"\x15ActivateMemberRequest\x12\x1b\n" +
-	"\tmember_id\x18\x01 \x01(\tR\bmemberId\"C\n" +
+	"\x06member\x18\x01 \x01(\v2\x11.member.v1.MemberR\x06member\".\n" +
+	"\x14ListInterestsRequest\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\"o\n" +
+	"\x15ListInterestsResponse\x121\n" +
+	"\tinterests\x18\x01 \x03(\v2\x13.member.v1.InterestR\tinterests\x12#\n" +
+	"\rmax_interests\x18\x02 \x01(\x05R\fmaxInterests\"_\n" +
+	"\x13SetInterestsRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\x12!\n" +
+	"\finterest_ids\x18\x02 \x03(\tR\vinterestIds\"A\n" +
+	"\x14SetInterestsResponse\x12)\n" +
+	"\x06member\x18\x01 \x01(\v2\x11.member.v1.MemberR\x06member\",\n" +
+	"\x12ListPromptsRequest\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\"c\n" +
+	"\x13ListPromptsResponse\x12+\n" +
+	"\aprompts\x18\x01 \x03(\v2\x11.member.v1.PromptR\aprompts\x12\x1f\n" +
+	"\vmax_prompts\x18\x02 \x01(\x05R\n" +
+	"maxPrompts\"y\n" +
+	"\x13AnswerPromptRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\x12#\n" +
+	"\tprompt_id\x18\x02 \x01(\tB\x06\xc2\xf3\x18\x02\b\x01R\bpromptId\x12\x16\n" +
+	"\x06answer\x18\x03 \x01(\tR\x06answer\"A\n" +
+	"\x14AnswerPromptResponse\x12)\n" +
+	"\x06member\x18\x01 \x01(\v2\x11.member.v1.MemberR\x06member\"a\n" +
+	"\x13RemovePromptRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\x12#\n" +
+	"\tprompt_id\x18\x02 \x01(\tB\x06\xc2\xf3\x18\x02\b\x01R\bpromptId\"A\n" +
+	"\x14RemovePromptResponse\x12)\n" +
+	"\x06member\x18\x01 \x01(\v2\x11.member.v1.MemberR\x06member\"]\n" +
+	"\x15ReorderPromptsRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\x12\x1d\n" +
+	"\n" +
+	"prompt_ids\x18\x02 \x03(\tR\tpromptIds\"C\n" +
+	"\x16ReorderPromptsResponse\x12)\n" +
+	"\x06member\x18\x01 \x01(\v2\x11.member.v1.MemberR\x06member\">\n" +
+	"\x15GetPreferencesRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\"R\n" +
+	"\x16GetPreferencesResponse\x128\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x16.member.v1.PreferencesR\vpreferences\"\xc0\x01\n" +
+	"\x18UpdatePreferencesRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\x12@\n" +
+	"\vpreferences\x18\x02 \x01(\v2\x16.member.v1.PreferencesB\x06\xc2\xf3\x18\x02\b\x01R\vpreferences\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"U\n" +
+	"\x19UpdatePreferencesResponse\x128\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x16.member.v1.PreferencesR\vpreferences\"E\n" +
+	"\x1cSendEmailVerificationRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\"\x1f\n" +
+	"\x1dSendEmailVerificationResponse\"5\n" +
+	"\x12VerifyEmailRequest\x12\x1f\n" +
+	"\x05token\x18\x01 \x01(\tB\t\xc2\xf3\x18\x05\b\x01\x18\x80\bR\x05token\"@\n" +
+	"\x13VerifyEmailResponse\x12)\n" +
+	"\x06member\x18\x01 \x01(\v2\x11.member.v1.MemberR\x06member\">\n" +
+	"\x15ActivateMemberRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\"C\n" +
 	"\x16ActivateMemberResponse\x12)\n" +
-	"\x06member\x18\x01 \x01(\v2\x11.member.v1.MemberR\x06member*\x7f\n" +
+	"\x06member\x18\x01 \x01(\v2\x11.member.v1.MemberR\x06member\"x\n" +
+	"\x0fAddPhotoRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\x12\x19\n" +
+	"\bphoto_id\x18\x02 \x01(\tR\aphotoId\x12#\n" +
+	"\tphoto_url\x18\x03 \x01(\tB\x06\xc2\xf3\x18\x02\b\x01R\bphotoUrl\"=\n" +
+	"\x10AddPhotoResponse\x12)\n" +
+	"\x06member\x18\x01 \x01(\v2\x11.member.v1.MemberR\x06member\"{\n" +
+	"\x12RemovePhotoRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\x12\x19\n" +
+	"\bphoto_id\x18\x02 \x01(\tR\aphotoId\x12#\n" +
+	"\tphoto_url\x18\x03 \x01(\tB\x06\xc2\xf3\x18\x02\b\x01R\bphotoUrl\"@\n" +
+	"\x13RemovePhotoResponse\x12)\n" +
+	"\x06member\x18\x01 \x01(\v2\x11.member.v1.MemberR\x06member\"d\n" +
+	"\x14ReorderPhotosRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\x12%\n" +
+	"\n" +
+	"photo_urls\x18\x02 \x03(\tB\x06\xc2\xf3\x18\x02\b\x01R\tphotoUrls\"B\n" +
+	"\x15ReorderPhotosResponse\x12)\n" +
+	"\x06member\x18\x01 \x01(\v2\x11.member.v1.MemberR\x06member\"d\n" +
+	"\x16SetPrimaryPhotoRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\x12#\n" +
+	"\tphoto_url\x18\x02 \x01(\tB\x06\xc2\xf3\x18\x02\b\x01R\bphotoUrl\"D\n" +
+	"\x17SetPrimaryPhotoResponse\x12)\n" +
+	"\x06member\x18\x01 \x01(\v2\x11.member.v1.MemberR\x06member\"`\n" +
+	"\x14SuspendMemberRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\x12!\n" +
+	"\x06reason\x18\x02 \x01(\tB\t\xc2\xf3\x18\x05\b\x01\x18\xe8\aR\x06reason\"B\n" +
+	"\x15SuspendMemberResponse\x12)\n" +
+	"\x06member\x18\x01 \x01(\v2\x11.member.v1.MemberR\x06member\"q\n" +
+	"\x12BlockMemberRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\x124\n" +
+	"\x11blocked_member_id\x18\x02 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\x0fblockedMemberId\"\x15\n" +
+	"\x13BlockMemberResponse\"s\n" +
+	"\x14UnblockMemberRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\x124\n" +
+	"\x11blocked_member_id\x18\x02 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\x0fblockedMemberId\"\x17\n" +
+	"\x15UnblockMemberResponse\"B\n" +
+	"\x19ListBlockedMembersRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\"J\n" +
+	"\x1aListBlockedMembersResponse\x12,\n" +
+	"\x12blocked_member_ids\x18\x01 \x03(\tR\x10blockedMemberIds\"n\n" +
+	"\x13CheckBlockedRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\x120\n" +
+	"\x0fother_member_id\x18\x02 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\rotherMemberId\"0\n" +
+	"\x14CheckBlockedResponse\x12\x18\n" +
+	"\ablocked\x18\x01 \x01(\bR\ablocked\"Y\n" +
+	"\x1dListSessionRevocationsRequest\x128\n" +
+	"\x05since\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xc2\xf3\x18\x02\b\x01R\x05since\"`\n" +
+	"\x1eListSessionRevocationsResponse\x12>\n" +
+	"\vrevocations\x18\x01 \x03(\v2\x1c.member.v1.SessionRevocationR\vrevocations\"k\n" +
+	"\x11SessionRevocation\x12\x1b\n" +
+	"\tmember_id\x18\x01 \x01(\tR\bmemberId\x129\n" +
+	"\n" +
+	"revoked_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\"\x84\x01\n" +
+	"\x17GetMemberHistoryRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\x12#\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x06\xc2\xf3\x18\x02(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"u\n" +
+	"\x18GetMemberHistoryResponse\x121\n" +
+	"\aentries\x18\x01 \x03(\v2\x17.member.v1.HistoryEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x88\x02\n" +
+	"\fHistoryEntry\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12;\n" +
+	"\voccurred_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x18\n" +
+	"\asummary\x18\x04 \x01(\tR\asummary\x122\n" +
+	"\adetails\x18\x05 \x03(\v2\x18.member.v1.HistoryDetailR\adetails\x124\n" +
+	"\bmetadata\x18\x06 \x01(\v2\x18.member.v1.EventMetadataR\bmetadata\"9\n" +
+	"\rHistoryDetail\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"r\n" +
+	"\rEventMetadata\x12%\n" +
+	"\x0ecorrelation_id\x18\x01 \x01(\tR\rcorrelationId\x12!\n" +
+	"\fcausation_id\x18\x02 \x01(\tR\vcausationId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"t\n" +
+	"\x17GetMemberStateAtRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\x122\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xc2\xf3\x18\x02\b\x01R\x02at\"_\n" +
+	"\x18GetMemberStateAtResponse\x12)\n" +
+	"\x06member\x18\x01 \x01(\v2\x11.member.v1.MemberR\x06member\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"\xeb\x03\n" +
+	"\x14SearchMembersRequest\x12\x1d\n" +
+	"\x05query\x18\x01 \x01(\tB\a\xc2\xf3\x18\x03\x18\xff\x01R\x05query\x12;\n" +
+	"\bstatuses\x18\x02 \x03(\x0e2\x17.member.v1.MemberStatusB\x06\xc2\xf3\x18\x02\x18\x03R\bstatuses\x12?\n" +
+	"\rcreated_after\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x123\n" +
+	"\agenders\x18\x05 \x03(\x0e2\x11.member.v1.GenderB\x06\xc2\xf3\x18\x02\x18\x03R\agenders\x12\"\n" +
+	"\amin_age\x18\x06 \x01(\x05B\t\xc2\xf3\x18\x05(\x000\x96\x01R\x06minAge\x12\"\n" +
+	"\amax_age\x18\a \x01(\x05B\t\xc2\xf3\x18\x05(\x000\x96\x01R\x06maxAge\x12)\n" +
+	"\x04sort\x18\b \x01(\x0e2\x15.member.v1.MemberSortR\x04sort\x12#\n" +
+	"\tpage_size\x18\t \x01(\x05B\x06\xc2\xf3\x18\x02(\x00R\bpageSize\x12&\n" +
+	"\n" +
+	"page_token\x18\n" +
+	" \x01(\tB\a\xc2\xf3\x18\x03\x18\x80\bR\tpageToken\"s\n" +
+	"\x15SearchMembersResponse\x122\n" +
+	"\amembers\x18\x01 \x03(\v2\x18.member.v1.MemberDetailsR\amembers\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x9c\x01\n" +
+	"\rMemberDetails\x12)\n" +
+	"\x06member\x18\x01 \x01(\v2\x11.member.v1.MemberR\x06member\x12\x14\n" +
+	"\x05roles\x18\x02 \x03(\tR\x05roles\x12J\n" +
+	"\x13sessions_revoked_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x11sessionsRevokedAt\"b\n" +
+	"\x16ReinstateMemberRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\x12!\n" +
+	"\x06reason\x18\x02 \x01(\tB\t\xc2\xf3\x18\x05\b\x01\x18\xe8\aR\x06reason\"D\n" +
+	"\x17ReinstateMemberResponse\x12)\n" +
+	"\x06member\x18\x01 \x01(\v2\x11.member.v1.MemberR\x06member\"a\n" +
+	"\x15RevokeSessionsRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\x12!\n" +
+	"\x06reason\x18\x02 \x01(\tB\t\xc2\xf3\x18\x05\b\x01\x18\xe8\aR\x06reason\"S\n" +
+	"\x16RevokeSessionsResponse\x129\n" +
+	"\n" +
+	"revoked_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\"\\\n" +
+	"\x15SetMemberRolesRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\b\x01 \x01R\bmemberId\x12\x1c\n" +
+	"\x05roles\x18\x02 \x03(\tB\x06\xc2\xf3\x18\x02\x18\bR\x05roles\".\n" +
+	"\x16SetMemberRolesResponse\x12\x14\n" +
+	"\x05roles\x18\x01 \x03(\tR\x05roles\"\xa1\x01\n" +
+	"\x13ListAuditLogRequest\x12#\n" +
+	"\tmember_id\x18\x01 \x01(\tB\x06\xc2\xf3\x18\x02 \x01R\bmemberId\x12!\n" +
+	"\bactor_id\x18\x02 \x01(\tB\x06\xc2\xf3\x18\x02 \x01R\aactorId\x12#\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\x06\xc2\xf3\x18\x02(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"o\n" +
+	"\x14ListAuditLogResponse\x12/\n" +
+	"\aentries\x18\x01 \x03(\v2\x15.member.v1.AuditEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8e\x02\n" +
+	"\n" +
+	"AuditEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12;\n" +
+	"\voccurred_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06caller\x18\x04 \x01(\tR\x06caller\x12\x16\n" +
+	"\x06method\x18\x05 \x01(\tR\x06method\x12\x1b\n" +
+	"\tmember_id\x18\x06 \x01(\tR\bmemberId\x12\x18\n" +
+	"\arequest\x18\a \x01(\tR\arequest\x12\x12\n" +
+	"\x04code\x18\b \x01(\tR\x04code\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\t \x01(\tR\trequestId*\xe8\x01\n" +
+	"\x0eOnboardingStep\x12\x1f\n" +
+	"\x1bONBOARDING_STEP_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17ONBOARDING_STEP_PROFILE\x10\x01\x12\x1a\n" +
+	"\x16ONBOARDING_STEP_PHOTOS\x10\x02\x12\x1b\n" +
+	"\x17ONBOARDING_STEP_PROMPTS\x10\x03\x12\x1f\n" +
+	"\x1bONBOARDING_STEP_PREFERENCES\x10\x04\x12 \n" +
+	"\x1cONBOARDING_STEP_VERIFY_EMAIL\x10\x05\x12\x1c\n" +
+	"\x18ONBOARDING_STEP_COMPLETE\x10\x06*q\n" +
+	"\vDealbreaker\x12\x1b\n" +
+	"\x17DEALBREAKER_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fDEALBREAKER_AGE\x10\x01\x12\x16\n" +
+	"\x12DEALBREAKER_GENDER\x10\x02\x12\x18\n" +
+	"\x14DEALBREAKER_DISTANCE\x10\x03*\x7f\n" +
 	"\fMemberStatus\x12\x1d\n" +
 	"\x19MEMBER_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15MEMBER_STATUS_PENDING\x10\x01\x12\x18\n" +
@@ -813,13 +4745,58 @@ const file_member_v1_member_proto_rawDesc = "" +
 	"\x12GENDER_UNSPECIFIED\x10\x00\x12\x0f\n" +
 	"\vGENDER_MALE\x10\x01\x12\x11\n" +
 	"\rGENDER_FEMALE\x10\x02\x12\x10\n" +
-	"\fGENDER_OTHER\x10\x032\xbc\x03\n" +
+	"\fGENDER_OTHER\x10\x03*\x8e\x01\n" +
+	"\n" +
+	"MemberSort\x12\x1b\n" +
+	"\x17MEMBER_SORT_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12MEMBER_SORT_NEWEST\x10\x01\x12\x16\n" +
+	"\x12MEMBER_SORT_OLDEST\x10\x02\x12\x15\n" +
+	"\x11MEMBER_SORT_EMAIL\x10\x03\x12\x1c\n" +
+	"\x18MEMBER_SORT_DISPLAY_NAME\x10\x042\xe6\x16\n" +
 	"\rMemberService\x12U\n" +
 	"\x0eRegisterMember\x12 .member.v1.RegisterMemberRequest\x1a!.member.v1.RegisterMemberResponse\x12a\n" +
-	"\x12AuthenticateMember\x12$.member.v1.AuthenticateMemberRequest\x1a%.member.v1.AuthenticateMemberResponse\x12F\n" +
-	"\tGetMember\x12\x1b.member.v1.GetMemberRequest\x1a\x1c.member.v1.GetMemberResponse\x12R\n" +
-	"\rUpdateProfile\x12\x1f.member.v1.UpdateProfileRequest\x1a .member.v1.UpdateProfileResponse\x12U\n" +
-	"\x0eActivateMember\x12 .member.v1.ActivateMemberRequest\x1a!.member.v1.ActivateMemberResponseBJZHgithub.com/mattuttis/inetcontrol/zoekdeware/api/proto/member/v1;memberv1b\x06proto3"
+	"\x12AuthenticateMember\x12$.member.v1.AuthenticateMemberRequest\x1a%.member.v1.AuthenticateMemberResponse\x12g\n" +
+	"\tGetMember\x12\x1b.member.v1.GetMemberRequest\x1a\x1c.member.v1.GetMemberResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v2/members/{member_id}\x12\x84\x01\n" +
+	"\rUpdateProfile\x12\x1f.member.v1.UpdateProfileRequest\x1a .member.v1.UpdateProfileResponse\"0\x82\xd3\xe4\x93\x02*:\aprofile2\x1f/v2/members/{member_id}/profile\x12i\n" +
+	"\rListInterests\x12\x1f.member.v1.ListInterestsRequest\x1a .member.v1.ListInterestsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v2/interests\x12}\n" +
+	"\fSetInterests\x12\x1e.member.v1.SetInterestsRequest\x1a\x1f.member.v1.SetInterestsResponse\",\x82\xd3\xe4\x93\x02&:\x01*\x1a!/v2/members/{member_id}/interests\x12a\n" +
+	"\vListPrompts\x12\x1d.member.v1.ListPromptsRequest\x1a\x1e.member.v1.ListPromptsResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v2/prompts\x12\x87\x01\n" +
+	"\fAnswerPrompt\x12\x1e.member.v1.AnswerPromptRequest\x1a\x1f.member.v1.AnswerPromptResponse\"6\x82\xd3\xe4\x93\x020:\x01*\x1a+/v2/members/{member_id}/prompts/{prompt_id}\x12\x84\x01\n" +
+	"\fRemovePrompt\x12\x1e.member.v1.RemovePromptRequest\x1a\x1f.member.v1.RemovePromptResponse\"3\x82\xd3\xe4\x93\x02-*+/v2/members/{member_id}/prompts/{prompt_id}\x12\x89\x01\n" +
+	"\x0eReorderPrompts\x12 .member.v1.ReorderPromptsRequest\x1a!.member.v1.ReorderPromptsResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/v2/members/{member_id}/prompts:reorder\x12\x82\x01\n" +
+	"\x0eGetPreferences\x12 .member.v1.GetPreferencesRequest\x1a!.member.v1.GetPreferencesResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v2/members/{member_id}/preferences\x12\x98\x01\n" +
+	"\x11UpdatePreferences\x12#.member.v1.UpdatePreferencesRequest\x1a$.member.v1.UpdatePreferencesResponse\"8\x82\xd3\xe4\x93\x022:\vpreferences2#/v2/members/{member_id}/preferences\x12\xa5\x01\n" +
+	"\x15SendEmailVerification\x12'.member.v1.SendEmailVerificationRequest\x1a(.member.v1.SendEmailVerificationResponse\"9\x82\xd3\xe4\x93\x023:\x01*\"./v2/members/{member_id}/email:sendVerification\x12L\n" +
+	"\vVerifyEmail\x12\x1d.member.v1.VerifyEmailRequest\x1a\x1e.member.v1.VerifyEmailResponse\x12U\n" +
+	"\x0eActivateMember\x12 .member.v1.ActivateMemberRequest\x1a!.member.v1.ActivateMemberResponse\x12C\n" +
+	"\bAddPhoto\x12\x1a.member.v1.AddPhotoRequest\x1a\x1b.member.v1.AddPhotoResponse\x12L\n" +
+	"\vRemovePhoto\x12\x1d.member.v1.RemovePhotoRequest\x1a\x1e.member.v1.RemovePhotoResponse\x12\x85\x01\n" +
+	"\rReorderPhotos\x12\x1f.member.v1.ReorderPhotosRequest\x1a .member.v1.ReorderPhotosResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/v2/members/{member_id}/photos:reorder\x12\x8e\x01\n" +
+	"\x0fSetPrimaryPhoto\x12!.member.v1.SetPrimaryPhotoRequest\x1a\".member.v1.SetPrimaryPhotoResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v2/members/{member_id}/photos:setPrimary\x12R\n" +
+	"\rSuspendMember\x12\x1f.member.v1.SuspendMemberRequest\x1a .member.v1.SuspendMemberResponse\x12w\n" +
+	"\vBlockMember\x12\x1d.member.v1.BlockMemberRequest\x1a\x1e.member.v1.BlockMemberResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v2/members/{member_id}/blocks\x12\x8e\x01\n" +
+	"\rUnblockMember\x12\x1f.member.v1.UnblockMemberRequest\x1a .member.v1.UnblockMemberResponse\":\x82\xd3\xe4\x93\x024*2/v2/members/{member_id}/blocks/{blocked_member_id}\x12\x89\x01\n" +
+	"\x12ListBlockedMembers\x12$.member.v1.ListBlockedMembersRequest\x1a%.member.v1.ListBlockedMembersResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v2/members/{member_id}/blocks\x12O\n" +
+	"\fCheckBlocked\x12\x1e.member.v1.CheckBlockedRequest\x1a\x1f.member.v1.CheckBlockedResponse\x12m\n" +
+	"\x16ListSessionRevocations\x12(.member.v1.ListSessionRevocationsRequest\x1a).member.v1.ListSessionRevocationsResponse2\xcf\x05\n" +
+	"\x12MemberAdminService\x12[\n" +
+	"\x10GetMemberHistory\x12\".member.v1.GetMemberHistoryRequest\x1a#.member.v1.GetMemberHistoryResponse\x12[\n" +
+	"\x10GetMemberStateAt\x12\".member.v1.GetMemberStateAtRequest\x1a#.member.v1.GetMemberStateAtResponse\x12R\n" +
+	"\rSearchMembers\x12\x1f.member.v1.SearchMembersRequest\x1a .member.v1.SearchMembersResponse\x12R\n" +
+	"\rSuspendMember\x12\x1f.member.v1.SuspendMemberRequest\x1a .member.v1.SuspendMemberResponse\x12X\n" +
+	"\x0fReinstateMember\x12!.member.v1.ReinstateMemberRequest\x1a\".member.v1.ReinstateMemberResponse\x12U\n" +
+	"\x0eRevokeSessions\x12 .member.v1.RevokeSessionsRequest\x1a!.member.v1.RevokeSessionsResponse\x12U\n" +
+	"\x0eSetMemberRoles\x12 .member.v1.SetMemberRolesRequest\x1a!.member.v1.SetMemberRolesResponse\x12O\n" +
+	"\fListAuditLog\x12\x1e.member.v1.ListAuditLogRequest\x1a\x1f.member.v1.ListAuditLogResponseB\xbb\x03\x92A\xed\x02\x12\x7f\n" +
+	"\x15Zoekdeware Member API\x12cMember endpoints transcoded from member.proto by the gateway. Generated; edit member.proto instead.2\x012\"\x04/api*\x02\x02\x012\x10application/json:\x10application/jsonRX\n" +
+	"\adefault\x12M\n" +
+	"KAn application/problem+json document, described by Problem in gateway.yaml.ZP\n" +
+	"N\n" +
+	"\n" +
+	"bearerAuth\x12@\b\x02\x12+Bearer access token from /api/v1/auth/login\x1a\rAuthorization \x02b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00ZHgithub.com/mattuttis/inetcontrol/zoekdeware/api/proto/member/v1;memberv1b\x06proto3"
 
 var (
 	file_member_v1_member_proto_rawDescOnce sync.Once
@@ -833,53 +4810,222 @@ func file_member_v1_member_proto_rawDescGZIP() []byte {
 	return file_member_v1_member_proto_rawDescData
 }
 
-var file_member_v1_member_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_member_v1_member_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_member_v1_member_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_member_v1_member_proto_msgTypes = make([]protoimpl.MessageInfo, 77)
 var file_member_v1_member_proto_goTypes = []any{
-	(MemberStatus)(0),                  // 0: member.v1.MemberStatus
-	(Gender)(0),                        // 1: member.v1.Gender
-	(*Member)(nil),                     // 2: member.v1.Member
-	(*Profile)(nil),                    // 3: member.v1.Profile
-	(*RegisterMemberRequest)(nil),      // 4: member.v1.RegisterMemberRequest
-	(*RegisterMemberResponse)(nil),     // 5: member.v1.RegisterMemberResponse
-	(*AuthenticateMemberRequest)(nil),  // 6: member.v1.AuthenticateMemberRequest
-	(*AuthenticateMemberResponse)(nil), // 7: member.v1.AuthenticateMemberResponse
-	(*GetMemberRequest)(nil),           // 8: member.v1.GetMemberRequest
-	(*GetMemberResponse)(nil),          // 9: member.v1.GetMemberResponse
-	(*UpdateProfileRequest)(nil),       // 10: member.v1.UpdateProfileRequest
-	(*UpdateProfileResponse)(nil),      // 11: member.v1.UpdateProfileResponse
-	(*ActivateMemberRequest)(nil),      // 12: member.v1.ActivateMemberRequest
-	(*ActivateMemberResponse)(nil),     // 13: member.v1.ActivateMemberResponse
-	(*timestamppb.Timestamp)(nil),      // 14: google.protobuf.Timestamp
+	(OnboardingStep)(0),                    // 0: member.v1.OnboardingStep
+	(Dealbreaker)(0),                       // 1: member.v1.Dealbreaker
+	(MemberStatus)(0),                      // 2: member.v1.MemberStatus
+	(Gender)(0),                            // 3: member.v1.Gender
+	(MemberSort)(0),                        // 4: member.v1.MemberSort
+	(*Member)(nil),                         // 5: member.v1.Member
+	(*Onboarding)(nil),                     // 6: member.v1.Onboarding
+	(*Profile)(nil),                        // 7: member.v1.Profile
+	(*ProfilePrompt)(nil),                  // 8: member.v1.ProfilePrompt
+	(*Prompt)(nil),                         // 9: member.v1.Prompt
+	(*Interest)(nil),                       // 10: member.v1.Interest
+	(*Preferences)(nil),                    // 11: member.v1.Preferences
+	(*RegisterMemberRequest)(nil),          // 12: member.v1.RegisterMemberRequest
+	(*RegisterMemberResponse)(nil),         // 13: member.v1.RegisterMemberResponse
+	(*AuthenticateMemberRequest)(nil),      // 14: member.v1.AuthenticateMemberRequest
+	(*AuthenticateMemberResponse)(nil),     // 15: member.v1.AuthenticateMemberResponse
+	(*GetMemberRequest)(nil),               // 16: member.v1.GetMemberRequest
+	(*GetMemberResponse)(nil),              // 17: member.v1.GetMemberResponse
+	(*UpdateProfileRequest)(nil),           // 18: member.v1.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),          // 19: member.v1.UpdateProfileResponse
+	(*ListInterestsRequest)(nil),           // 20: member.v1.ListInterestsRequest
+	(*ListInterestsResponse)(nil),          // 21: member.v1.ListInterestsResponse
+	(*SetInterestsRequest)(nil),            // 22: member.v1.SetInterestsRequest
+	(*SetInterestsResponse)(nil),           // 23: member.v1.SetInterestsResponse
+	(*ListPromptsRequest)(nil),             // 24: member.v1.ListPromptsRequest
+	(*ListPromptsResponse)(nil),            // 25: member.v1.ListPromptsResponse
+	(*AnswerPromptRequest)(nil),            // 26: member.v1.AnswerPromptRequest
+	(*AnswerPromptResponse)(nil),           // 27: member.v1.AnswerPromptResponse
+	(*RemovePromptRequest)(nil),            // 28: member.v1.RemovePromptRequest
+	(*RemovePromptResponse)(nil),           // 29: member.v1.RemovePromptResponse
+	(*ReorderPromptsRequest)(nil),          // 30: member.v1.ReorderPromptsRequest
+	(*ReorderPromptsResponse)(nil),         // 31: member.v1.ReorderPromptsResponse
+	(*GetPreferencesRequest)(nil),          // 32: member.v1.GetPreferencesRequest
+	(*GetPreferencesResponse)(nil),         // 33: member.v1.GetPreferencesResponse
+	(*UpdatePreferencesRequest)(nil),       // 34: member.v1.UpdatePreferencesRequest
+	(*UpdatePreferencesResponse)(nil),      // 35: member.v1.UpdatePreferencesResponse
+	(*SendEmailVerificationRequest)(nil),   // 36: member.v1.SendEmailVerificationRequest
+	(*SendEmailVerificationResponse)(nil),  // 37: member.v1.SendEmailVerificationResponse
+	(*VerifyEmailRequest)(nil),             // 38: member.v1.VerifyEmailRequest
+	(*VerifyEmailResponse)(nil),            // 39: member.v1.VerifyEmailResponse
+	(*ActivateMemberRequest)(nil),          // 40: member.v1.ActivateMemberRequest
+	(*ActivateMemberResponse)(nil),         // 41: member.v1.ActivateMemberResponse
+	(*AddPhotoRequest)(nil),                // 42: member.v1.AddPhotoRequest
+	(*AddPhotoResponse)(nil),               // 43: member.v1.AddPhotoResponse
+	(*RemovePhotoRequest)(nil),             // 44: member.v1.RemovePhotoRequest
+	(*RemovePhotoResponse)(nil),            // 45: member.v1.RemovePhotoResponse
+	(*ReorderPhotosRequest)(nil),           // 46: member.v1.ReorderPhotosRequest
+	(*ReorderPhotosResponse)(nil),          // 47: member.v1.ReorderPhotosResponse
+	(*SetPrimaryPhotoRequest)(nil),         // 48: member.v1.SetPrimaryPhotoRequest
+	(*SetPrimaryPhotoResponse)(nil),        // 49: member.v1.SetPrimaryPhotoResponse
+	(*SuspendMemberRequest)(nil),           // 50: member.v1.SuspendMemberRequest
+	(*SuspendMemberResponse)(nil),          // 51: member.v1.SuspendMemberResponse
+	(*BlockMemberRequest)(nil),             // 52: member.v1.BlockMemberRequest
+	(*BlockMemberResponse)(nil),            // 53: member.v1.BlockMemberResponse
+	(*UnblockMemberRequest)(nil),           // 54: member.v1.UnblockMemberRequest
+	(*UnblockMemberResponse)(nil),          // 55: member.v1.UnblockMemberResponse
+	(*ListBlockedMembersRequest)(nil),      // 56: member.v1.ListBlockedMembersRequest
+	(*ListBlockedMembersResponse)(nil),     // 57: member.v1.ListBlockedMembersResponse
+	(*CheckBlockedRequest)(nil),            // 58: member.v1.CheckBlockedRequest
+	(*CheckBlockedResponse)(nil),           // 59: member.v1.CheckBlockedResponse
+	(*ListSessionRevocationsRequest)(nil),  // 60: member.v1.ListSessionRevocationsRequest
+	(*ListSessionRevocationsResponse)(nil), // 61: member.v1.ListSessionRevocationsResponse
+	(*SessionRevocation)(nil),              // 62: member.v1.SessionRevocation
+	(*GetMemberHistoryRequest)(nil),        // 63: member.v1.GetMemberHistoryRequest
+	(*GetMemberHistoryResponse)(nil),       // 64: member.v1.GetMemberHistoryResponse
+	(*HistoryEntry)(nil),                   // 65: member.v1.HistoryEntry
+	(*HistoryDetail)(nil),                  // 66: member.v1.HistoryDetail
+	(*EventMetadata)(nil),                  // 67: member.v1.EventMetadata
+	(*GetMemberStateAtRequest)(nil),        // 68: member.v1.GetMemberStateAtRequest
+	(*GetMemberStateAtResponse)(nil),       // 69: member.v1.GetMemberStateAtResponse
+	(*SearchMembersRequest)(nil),           // 70: member.v1.SearchMembersRequest
+	(*SearchMembersResponse)(nil),          // 71: member.v1.SearchMembersResponse
+	(*MemberDetails)(nil),                  // 72: member.v1.MemberDetails
+	(*ReinstateMemberRequest)(nil),         // 73: member.v1.ReinstateMemberRequest
+	(*ReinstateMemberResponse)(nil),        // 74: member.v1.ReinstateMemberResponse
+	(*RevokeSessionsRequest)(nil),          // 75: member.v1.RevokeSessionsRequest
+	(*RevokeSessionsResponse)(nil),         // 76: member.v1.RevokeSessionsResponse
+	(*SetMemberRolesRequest)(nil),          // 77: member.v1.SetMemberRolesRequest
+	(*SetMemberRolesResponse)(nil),         // 78: member.v1.SetMemberRolesResponse
+	(*ListAuditLogRequest)(nil),            // 79: member.v1.ListAuditLogRequest
+	(*ListAuditLogResponse)(nil),           // 80: member.v1.ListAuditLogResponse
+	(*AuditEntry)(nil),                     // 81: member.v1.AuditEntry
+	(*timestamppb.Timestamp)(nil),          // 82: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),          // 83: google.protobuf.FieldMask
 }
 var file_member_v1_member_proto_depIdxs = []int32{
-	3,  // 0: member.v1.Member.profile:type_name -> member.v1.Profile
-	0,  // 1: member.v1.Member.status:type_name -> member.v1.MemberStatus
-	14, // 2: member.v1.Member.created_at:type_name -> google.protobuf.Timestamp
-	14, // 3: member.v1.Member.updated_at:type_name -> google.protobuf.Timestamp
-	14, // 4: member.v1.Profile.birth_date:type_name -> google.protobuf.Timestamp
-	1,  // 5: member.v1.Profile.gender:type_name -> member.v1.Gender
-	2,  // 6: member.v1.RegisterMemberResponse.member:type_name -> member.v1.Member
-	2,  // 7: member.v1.AuthenticateMemberResponse.member:type_name -> member.v1.Member
-	2,  // 8: member.v1.GetMemberResponse.member:type_name -> member.v1.Member
-	3,  // 9: member.v1.UpdateProfileRequest.profile:type_name -> member.v1.Profile
-	2,  // 10: member.v1.UpdateProfileResponse.member:type_name -> member.v1.Member
-	2,  // 11: member.v1.ActivateMemberResponse.member:type_name -> member.v1.Member
-	4,  // 12: member.v1.MemberService.RegisterMember:input_type -> member.v1.RegisterMemberRequest
-	6,  // 13: member.v1.MemberService.AuthenticateMember:input_type -> member.v1.AuthenticateMemberRequest
-	8,  // 14: member.v1.MemberService.GetMember:input_type -> member.v1.GetMemberRequest
-	10, // 15: member.v1.MemberService.UpdateProfile:input_type -> member.v1.UpdateProfileRequest
-	12, // 16: member.v1.MemberService.ActivateMember:input_type -> member.v1.ActivateMemberRequest
-	5,  // 17: member.v1.MemberService.RegisterMember:output_type -> member.v1.RegisterMemberResponse
-	7,  // 18: member.v1.MemberService.AuthenticateMember:output_type -> member.v1.AuthenticateMemberResponse
-	9,  // 19: member.v1.MemberService.GetMember:output_type -> member.v1.GetMemberResponse
-	11, // 20: member.v1.MemberService.UpdateProfile:output_type -> member.v1.UpdateProfileResponse
-	13, // 21: member.v1.MemberService.ActivateMember:output_type -> member.v1.ActivateMemberResponse
-	17, // [17:22] is the sub-list for method output_type
-	12, // [12:17] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	7,  // 0: member.v1.Member.profile:type_name -> member.v1.Profile
+	2,  // 1: member.v1.Member.status:type_name -> member.v1.MemberStatus
+	82, // 2: member.v1.Member.created_at:type_name -> google.protobuf.Timestamp
+	82, // 3: member.v1.Member.updated_at:type_name -> google.protobuf.Timestamp
+	6,  // 4: member.v1.Member.onboarding:type_name -> member.v1.Onboarding
+	0,  // 5: member.v1.Onboarding.next_step:type_name -> member.v1.OnboardingStep
+	0,  // 6: member.v1.Onboarding.completed_steps:type_name -> member.v1.OnboardingStep
+	82, // 7: member.v1.Profile.birth_date:type_name -> google.protobuf.Timestamp
+	3,  // 8: member.v1.Profile.gender:type_name -> member.v1.Gender
+	8,  // 9: member.v1.Profile.prompts:type_name -> member.v1.ProfilePrompt
+	3,  // 10: member.v1.Preferences.genders:type_name -> member.v1.Gender
+	1,  // 11: member.v1.Preferences.dealbreakers:type_name -> member.v1.Dealbreaker
+	5,  // 12: member.v1.RegisterMemberResponse.member:type_name -> member.v1.Member
+	5,  // 13: member.v1.AuthenticateMemberResponse.member:type_name -> member.v1.Member
+	5,  // 14: member.v1.GetMemberResponse.member:type_name -> member.v1.Member
+	7,  // 15: member.v1.UpdateProfileRequest.profile:type_name -> member.v1.Profile
+	83, // 16: member.v1.UpdateProfileRequest.update_mask:type_name -> google.protobuf.FieldMask
+	5,  // 17: member.v1.UpdateProfileResponse.member:type_name -> member.v1.Member
+	10, // 18: member.v1.ListInterestsResponse.interests:type_name -> member.v1.Interest
+	5,  // 19: member.v1.SetInterestsResponse.member:type_name -> member.v1.Member
+	9,  // 20: member.v1.ListPromptsResponse.prompts:type_name -> member.v1.Prompt
+	5,  // 21: member.v1.AnswerPromptResponse.member:type_name -> member.v1.Member
+	5,  // 22: member.v1.RemovePromptResponse.member:type_name -> member.v1.Member
+	5,  // 23: member.v1.ReorderPromptsResponse.member:type_name -> member.v1.Member
+	11, // 24: member.v1.GetPreferencesResponse.preferences:type_name -> member.v1.Preferences
+	11, // 25: member.v1.UpdatePreferencesRequest.preferences:type_name -> member.v1.Preferences
+	83, // 26: member.v1.UpdatePreferencesRequest.update_mask:type_name -> google.protobuf.FieldMask
+	11, // 27: member.v1.UpdatePreferencesResponse.preferences:type_name -> member.v1.Preferences
+	5,  // 28: member.v1.VerifyEmailResponse.member:type_name -> member.v1.Member
+	5,  // 29: member.v1.ActivateMemberResponse.member:type_name -> member.v1.Member
+	5,  // 30: member.v1.AddPhotoResponse.member:type_name -> member.v1.Member
+	5,  // 31: member.v1.RemovePhotoResponse.member:type_name -> member.v1.Member
+	5,  // 32: member.v1.ReorderPhotosResponse.member:type_name -> member.v1.Member
+	5,  // 33: member.v1.SetPrimaryPhotoResponse.member:type_name -> member.v1.Member
+	5,  // 34: member.v1.SuspendMemberResponse.member:type_name -> member.v1.Member
+	82, // 35: member.v1.ListSessionRevocationsRequest.since:type_name -> google.protobuf.Timestamp
+	62, // 36: member.v1.ListSessionRevocationsResponse.revocations:type_name -> member.v1.SessionRevocation
+	82, // 37: member.v1.SessionRevocation.revoked_at:type_name -> google.protobuf.Timestamp
+	65, // 38: member.v1.GetMemberHistoryResponse.entries:type_name -> member.v1.HistoryEntry
+	82, // 39: member.v1.HistoryEntry.occurred_at:type_name -> google.protobuf.Timestamp
+	66, // 40: member.v1.HistoryEntry.details:type_name -> member.v1.HistoryDetail
+	67, // 41: member.v1.HistoryEntry.metadata:type_name -> member.v1.EventMetadata
+	82, // 42: member.v1.GetMemberStateAtRequest.at:type_name -> google.protobuf.Timestamp
+	5,  // 43: member.v1.GetMemberStateAtResponse.member:type_name -> member.v1.Member
+	2,  // 44: member.v1.SearchMembersRequest.statuses:type_name -> member.v1.MemberStatus
+	82, // 45: member.v1.SearchMembersRequest.created_after:type_name -> google.protobuf.Timestamp
+	82, // 46: member.v1.SearchMembersRequest.created_before:type_name -> google.protobuf.Timestamp
+	3,  // 47: member.v1.SearchMembersRequest.genders:type_name -> member.v1.Gender
+	4,  // 48: member.v1.SearchMembersRequest.sort:type_name -> member.v1.MemberSort
+	72, // 49: member.v1.SearchMembersResponse.members:type_name -> member.v1.MemberDetails
+	5,  // 50: member.v1.MemberDetails.member:type_name -> member.v1.Member
+	82, // 51: member.v1.MemberDetails.sessions_revoked_at:type_name -> google.protobuf.Timestamp
+	5,  // 52: member.v1.ReinstateMemberResponse.member:type_name -> member.v1.Member
+	82, // 53: member.v1.RevokeSessionsResponse.revoked_at:type_name -> google.protobuf.Timestamp
+	81, // 54: member.v1.ListAuditLogResponse.entries:type_name -> member.v1.AuditEntry
+	82, // 55: member.v1.AuditEntry.occurred_at:type_name -> google.protobuf.Timestamp
+	12, // 56: member.v1.MemberService.RegisterMember:input_type -> member.v1.RegisterMemberRequest
+	14, // 57: member.v1.MemberService.AuthenticateMember:input_type -> member.v1.AuthenticateMemberRequest
+	16, // 58: member.v1.MemberService.GetMember:input_type -> member.v1.GetMemberRequest
+	18, // 59: member.v1.MemberService.UpdateProfile:input_type -> member.v1.UpdateProfileRequest
+	20, // 60: member.v1.MemberService.ListInterests:input_type -> member.v1.ListInterestsRequest
+	22, // 61: member.v1.MemberService.SetInterests:input_type -> member.v1.SetInterestsRequest
+	24, // 62: member.v1.MemberService.ListPrompts:input_type -> member.v1.ListPromptsRequest
+	26, // 63: member.v1.MemberService.AnswerPrompt:input_type -> member.v1.AnswerPromptRequest
+	28, // 64: member.v1.MemberService.RemovePrompt:input_type -> member.v1.RemovePromptRequest
+	30, // 65: member.v1.MemberService.ReorderPrompts:input_type -> member.v1.ReorderPromptsRequest
+	32, // 66: member.v1.MemberService.GetPreferences:input_type -> member.v1.GetPreferencesRequest
+	34, // 67: member.v1.MemberService.UpdatePreferences:input_type -> member.v1.UpdatePreferencesRequest
+	36, // 68: member.v1.MemberService.SendEmailVerification:input_type -> member.v1.SendEmailVerificationRequest
+	38, // 69: member.v1.MemberService.VerifyEmail:input_type -> member.v1.VerifyEmailRequest
+	40, // 70: member.v1.MemberService.ActivateMember:input_type -> member.v1.ActivateMemberRequest
+	42, // 71: member.v1.MemberService.AddPhoto:input_type -> member.v1.AddPhotoRequest
+	44, // 72: member.v1.MemberService.RemovePhoto:input_type -> member.v1.RemovePhotoRequest
+	46, // 73: member.v1.MemberService.ReorderPhotos:input_type -> member.v1.ReorderPhotosRequest
+	48, // 74: member.v1.MemberService.SetPrimaryPhoto:input_type -> member.v1.SetPrimaryPhotoRequest
+	50, // 75: member.v1.MemberService.SuspendMember:input_type -> member.v1.SuspendMemberRequest
+	52, // 76: member.v1.MemberService.BlockMember:input_type -> member.v1.BlockMemberRequest
+	54, // 77: member.v1.MemberService.UnblockMember:input_type -> member.v1.UnblockMemberRequest
+	56, // 78: member.v1.MemberService.ListBlockedMembers:input_type -> member.v1.ListBlockedMembersRequest
+	58, // 79: member.v1.MemberService.CheckBlocked:input_type -> member.v1.CheckBlockedRequest
+	60, // 80: member.v1.MemberService.ListSessionRevocations:input_type -> member.v1.ListSessionRevocationsRequest
+	63, // 81: member.v1.MemberAdminService.GetMemberHistory:input_type -> member.v1.GetMemberHistoryRequest
+	68, // 82: member.v1.MemberAdminService.GetMemberStateAt:input_type -> member.v1.GetMemberStateAtRequest
+	70, // 83: member.v1.MemberAdminService.SearchMembers:input_type -> member.v1.SearchMembersRequest
+	50, // 84: member.v1.MemberAdminService.SuspendMember:input_type -> member.v1.SuspendMemberRequest
+	73, // 85: member.v1.MemberAdminService.ReinstateMember:input_type -> member.v1.ReinstateMemberRequest
+	75, // 86: member.v1.MemberAdminService.RevokeSessions:input_type -> member.v1.RevokeSessionsRequest
+	77, // 87: member.v1.MemberAdminService.SetMemberRoles:input_type -> member.v1.SetMemberRolesRequest
+	79, // 88: member.v1.MemberAdminService.ListAuditLog:input_type -> member.v1.ListAuditLogRequest
+	13, // 89: member.v1.MemberService.RegisterMember:output_type -> member.v1.RegisterMemberResponse
+	15, // 90: member.v1.MemberService.AuthenticateMember:output_type -> member.v1.AuthenticateMemberResponse
+	17, // 91: member.v1.MemberService.GetMember:output_type -> member.v1.GetMemberResponse
+	19, // 92: member.v1.MemberService.UpdateProfile:output_type -> member.v1.UpdateProfileResponse
+	21, // 93: member.v1.MemberService.ListInterests:output_type -> member.v1.ListInterestsResponse
+	23, // 94: member.v1.MemberService.SetInterests:output_type -> member.v1.SetInterestsResponse
+	25, // 95: member.v1.MemberService.ListPrompts:output_type -> member.v1.ListPromptsResponse
+	27, // 96: member.v1.MemberService.AnswerPrompt:output_type -> member.v1.AnswerPromptResponse
+	29, // 97: member.v1.MemberService.RemovePrompt:output_type -> member.v1.RemovePromptResponse
+	31, // 98: member.v1.MemberService.ReorderPrompts:output_type -> member.v1.ReorderPromptsResponse
+	33, // 99: member.v1.MemberService.GetPreferences:output_type -> member.v1.GetPreferencesResponse
+	35, // 100: member.v1.MemberService.UpdatePreferences:output_type -> member.v1.UpdatePreferencesResponse
+	37, // 101: member.v1.MemberService.SendEmailVerification:output_type -> member.v1.SendEmailVerificationResponse
+	39, // 102: member.v1.MemberService.VerifyEmail:output_type -> member.v1.VerifyEmailResponse
+	41, // 103: member.v1.MemberService.ActivateMember:output_type -> member.v1.ActivateMemberResponse
+	43, // 104: member.v1.MemberService.AddPhoto:output_type -> member.v1.AddPhotoResponse
+	45, // 105: member.v1.MemberService.RemovePhoto:output_type -> member.v1.RemovePhotoResponse
+	47, // 106: member.v1.MemberService.ReorderPhotos:output_type -> member.v1.ReorderPhotosResponse
+	49, // 107: member.v1.MemberService.SetPrimaryPhoto:output_type -> member.v1.SetPrimaryPhotoResponse
+	51, // 108: member.v1.MemberService.SuspendMember:output_type -> member.v1.SuspendMemberResponse
+	53, // 109: member.v1.MemberService.BlockMember:output_type -> member.v1.BlockMemberResponse
+	55, // 110: member.v1.MemberService.UnblockMember:output_type -> member.v1.UnblockMemberResponse
+	57, // 111: member.v1.MemberService.ListBlockedMembers:output_type -> member.v1.ListBlockedMembersResponse
+	59, // 112: member.v1.MemberService.CheckBlocked:output_type -> member.v1.CheckBlockedResponse
+	61, // 113: member.v1.MemberService.ListSessionRevocations:output_type -> member.v1.ListSessionRevocationsResponse
+	64, // 114: member.v1.MemberAdminService.GetMemberHistory:output_type -> member.v1.GetMemberHistoryResponse
+	69, // 115: member.v1.MemberAdminService.GetMemberStateAt:output_type -> member.v1.GetMemberStateAtResponse
+	71, // 116: member.v1.MemberAdminService.SearchMembers:output_type -> member.v1.SearchMembersResponse
+	51, // 117: member.v1.MemberAdminService.SuspendMember:output_type -> member.v1.SuspendMemberResponse
+	74, // 118: member.v1.MemberAdminService.ReinstateMember:output_type -> member.v1.ReinstateMemberResponse
+	76, // 119: member.v1.MemberAdminService.RevokeSessions:output_type -> member.v1.RevokeSessionsResponse
+	78, // 120: member.v1.MemberAdminService.SetMemberRoles:output_type -> member.v1.SetMemberRolesResponse
+	80, // 121: member.v1.MemberAdminService.ListAuditLog:output_type -> member.v1.ListAuditLogResponse
+	89, // [89:122] is the sub-list for method output_type
+	56, // [56:89] is the sub-list for method input_type
+	56, // [56:56] is the sub-list for extension type_name
+	56, // [56:56] is the sub-list for extension extendee
+	0,  // [0:56] is the sub-list for field type_name
 }
 
 func init() { file_member_v1_member_proto_init() }
@@ -892,10 +5038,10 @@ func file_member_v1_member_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_member_v1_member_proto_rawDesc), len(file_member_v1_member_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   12,
+			NumEnums:      5,
+			NumMessages:   77,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_member_v1_member_proto_goTypes,
 		DependencyIndexes: file_member_v1_member_proto_depIdxs,
@@ -19,22 +19,79 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MemberService_RegisterMember_FullMethodName     = "/member.v1.MemberService/RegisterMember"
-	MemberService_AuthenticateMember_FullMethodName = "/member.v1.MemberService/AuthenticateMember"
-	MemberService_GetMember_FullMethodName          = "/member.v1.MemberService/GetMember"
-	MemberService_UpdateProfile_FullMethodName      = "/member.v1.MemberService/UpdateProfile"
-	MemberService_ActivateMember_FullMethodName     = "/member.v1.MemberService/ActivateMember"
+	MemberService_RegisterMember_FullMethodName         = "/member.v1.MemberService/RegisterMember"
+	MemberService_AuthenticateMember_FullMethodName     = "/member.v1.MemberService/AuthenticateMember"
+	MemberService_GetMember_FullMethodName              = "/member.v1.MemberService/GetMember"
+	MemberService_UpdateProfile_FullMethodName          = "/member.v1.MemberService/UpdateProfile"
+	MemberService_ListInterests_FullMethodName          = "/member.v1.MemberService/ListInterests"
+	MemberService_SetInterests_FullMethodName           = "/member.v1.MemberService/SetInterests"
+	MemberService_ListPrompts_FullMethodName            = "/member.v1.MemberService/ListPrompts"
+	MemberService_AnswerPrompt_FullMethodName           = "/member.v1.MemberService/AnswerPrompt"
+	MemberService_RemovePrompt_FullMethodName           = "/member.v1.MemberService/RemovePrompt"
+	MemberService_ReorderPrompts_FullMethodName         = "/member.v1.MemberService/ReorderPrompts"
+	MemberService_GetPreferences_FullMethodName         = "/member.v1.MemberService/GetPreferences"
+	MemberService_UpdatePreferences_FullMethodName      = "/member.v1.MemberService/UpdatePreferences"
+	MemberService_SendEmailVerification_FullMethodName  = "/member.v1.MemberService/SendEmailVerification"
+	MemberService_VerifyEmail_FullMethodName            = "/member.v1.MemberService/VerifyEmail"
+	MemberService_ActivateMember_FullMethodName         = "/member.v1.MemberService/ActivateMember"
+	MemberService_AddPhoto_FullMethodName               = "/member.v1.MemberService/AddPhoto"
+	MemberService_RemovePhoto_FullMethodName            = "/member.v1.MemberService/RemovePhoto"
+	MemberService_ReorderPhotos_FullMethodName          = "/member.v1.MemberService/ReorderPhotos"
+	MemberService_SetPrimaryPhoto_FullMethodName        = "/member.v1.MemberService/SetPrimaryPhoto"
+	MemberService_SuspendMember_FullMethodName          = "/member.v1.MemberService/SuspendMember"
+	MemberService_BlockMember_FullMethodName            = "/member.v1.MemberService/BlockMember"
+	MemberService_UnblockMember_FullMethodName          = "/member.v1.MemberService/UnblockMember"
+	MemberService_ListBlockedMembers_FullMethodName     = "/member.v1.MemberService/ListBlockedMembers"
+	MemberService_CheckBlocked_FullMethodName           = "/member.v1.MemberService/CheckBlocked"
+	MemberService_ListSessionRevocations_FullMethodName = "/member.v1.MemberService/ListSessionRevocations"
 )
 
 // MemberServiceClient is the client API for MemberService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MemberService RPCs with an HTTP rule are also served by the gateway as
+// REST under /api, transcoded to JSON with the proto field names. The
+// gateway sets member_id from the signed-in member where the path says
+// "me", and the member service rejects requests for anyone else. RPCs
+// without a rule are only reachable over gRPC.
 type MemberServiceClient interface {
 	RegisterMember(ctx context.Context, in *RegisterMemberRequest, opts ...grpc.CallOption) (*RegisterMemberResponse, error)
 	AuthenticateMember(ctx context.Context, in *AuthenticateMemberRequest, opts ...grpc.CallOption) (*AuthenticateMemberResponse, error)
 	GetMember(ctx context.Context, in *GetMemberRequest, opts ...grpc.CallOption) (*GetMemberResponse, error)
+	// UpdateProfile changes the fields named in update_mask. Over REST the
+	// mask defaults to the fields present in the body.
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+	ListInterests(ctx context.Context, in *ListInterestsRequest, opts ...grpc.CallOption) (*ListInterestsResponse, error)
+	SetInterests(ctx context.Context, in *SetInterestsRequest, opts ...grpc.CallOption) (*SetInterestsResponse, error)
+	ListPrompts(ctx context.Context, in *ListPromptsRequest, opts ...grpc.CallOption) (*ListPromptsResponse, error)
+	AnswerPrompt(ctx context.Context, in *AnswerPromptRequest, opts ...grpc.CallOption) (*AnswerPromptResponse, error)
+	RemovePrompt(ctx context.Context, in *RemovePromptRequest, opts ...grpc.CallOption) (*RemovePromptResponse, error)
+	ReorderPrompts(ctx context.Context, in *ReorderPromptsRequest, opts ...grpc.CallOption) (*ReorderPromptsResponse, error)
+	GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*GetPreferencesResponse, error)
+	// UpdatePreferences changes the fields named in update_mask. Over REST
+	// the mask defaults to the fields present in the body.
+	UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*UpdatePreferencesResponse, error)
+	SendEmailVerification(ctx context.Context, in *SendEmailVerificationRequest, opts ...grpc.CallOption) (*SendEmailVerificationResponse, error)
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error)
+	// ActivateMember activates a pending member whose onboarding is complete.
+	// It fails with FAILED_PRECONDITION while onboarding is incomplete.
+	// Members are activated automatically when they finish onboarding, and
+	// suspended members are brought back with
+	// MemberAdminService.ReinstateMember.
 	ActivateMember(ctx context.Context, in *ActivateMemberRequest, opts ...grpc.CallOption) (*ActivateMemberResponse, error)
+	AddPhoto(ctx context.Context, in *AddPhotoRequest, opts ...grpc.CallOption) (*AddPhotoResponse, error)
+	RemovePhoto(ctx context.Context, in *RemovePhotoRequest, opts ...grpc.CallOption) (*RemovePhotoResponse, error)
+	ReorderPhotos(ctx context.Context, in *ReorderPhotosRequest, opts ...grpc.CallOption) (*ReorderPhotosResponse, error)
+	SetPrimaryPhoto(ctx context.Context, in *SetPrimaryPhotoRequest, opts ...grpc.CallOption) (*SetPrimaryPhotoResponse, error)
+	SuspendMember(ctx context.Context, in *SuspendMemberRequest, opts ...grpc.CallOption) (*SuspendMemberResponse, error)
+	BlockMember(ctx context.Context, in *BlockMemberRequest, opts ...grpc.CallOption) (*BlockMemberResponse, error)
+	UnblockMember(ctx context.Context, in *UnblockMemberRequest, opts ...grpc.CallOption) (*UnblockMemberResponse, error)
+	ListBlockedMembers(ctx context.Context, in *ListBlockedMembersRequest, opts ...grpc.CallOption) (*ListBlockedMembersResponse, error)
+	CheckBlocked(ctx context.Context, in *CheckBlockedRequest, opts ...grpc.CallOption) (*CheckBlockedResponse, error)
+	// ListSessionRevocations lists the members signed out everywhere since
+	// a point in time, for the gateway to reject their older tokens.
+	ListSessionRevocations(ctx context.Context, in *ListSessionRevocationsRequest, opts ...grpc.CallOption) (*ListSessionRevocationsResponse, error)
 }
 
 type memberServiceClient struct {
@@ -85,6 +142,106 @@ func (c *memberServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfi
 	return out, nil
 }
 
+func (c *memberServiceClient) ListInterests(ctx context.Context, in *ListInterestsRequest, opts ...grpc.CallOption) (*ListInterestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInterestsResponse)
+	err := c.cc.Invoke(ctx, MemberService_ListInterests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) SetInterests(ctx context.Context, in *SetInterestsRequest, opts ...grpc.CallOption) (*SetInterestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetInterestsResponse)
+	err := c.cc.Invoke(ctx, MemberService_SetInterests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) ListPrompts(ctx context.Context, in *ListPromptsRequest, opts ...grpc.CallOption) (*ListPromptsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromptsResponse)
+	err := c.cc.Invoke(ctx, MemberService_ListPrompts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) AnswerPrompt(ctx context.Context, in *AnswerPromptRequest, opts ...grpc.CallOption) (*AnswerPromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnswerPromptResponse)
+	err := c.cc.Invoke(ctx, MemberService_AnswerPrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) RemovePrompt(ctx context.Context, in *RemovePromptRequest, opts ...grpc.CallOption) (*RemovePromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemovePromptResponse)
+	err := c.cc.Invoke(ctx, MemberService_RemovePrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) ReorderPrompts(ctx context.Context, in *ReorderPromptsRequest, opts ...grpc.CallOption) (*ReorderPromptsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderPromptsResponse)
+	err := c.cc.Invoke(ctx, MemberService_ReorderPrompts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*GetPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPreferencesResponse)
+	err := c.cc.Invoke(ctx, MemberService_GetPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*UpdatePreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePreferencesResponse)
+	err := c.cc.Invoke(ctx, MemberService_UpdatePreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) SendEmailVerification(ctx context.Context, in *SendEmailVerificationRequest, opts ...grpc.CallOption) (*SendEmailVerificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendEmailVerificationResponse)
+	err := c.cc.Invoke(ctx, MemberService_SendEmailVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyEmailResponse)
+	err := c.cc.Invoke(ctx, MemberService_VerifyEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memberServiceClient) ActivateMember(ctx context.Context, in *ActivateMemberRequest, opts ...grpc.CallOption) (*ActivateMemberResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ActivateMemberResponse)
@@ -95,15 +252,152 @@ func (c *memberServiceClient) ActivateMember(ctx context.Context, in *ActivateMe
 	return out, nil
 }
 
+func (c *memberServiceClient) AddPhoto(ctx context.Context, in *AddPhotoRequest, opts ...grpc.CallOption) (*AddPhotoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddPhotoResponse)
+	err := c.cc.Invoke(ctx, MemberService_AddPhoto_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) RemovePhoto(ctx context.Context, in *RemovePhotoRequest, opts ...grpc.CallOption) (*RemovePhotoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemovePhotoResponse)
+	err := c.cc.Invoke(ctx, MemberService_RemovePhoto_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) ReorderPhotos(ctx context.Context, in *ReorderPhotosRequest, opts ...grpc.CallOption) (*ReorderPhotosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderPhotosResponse)
+	err := c.cc.Invoke(ctx, MemberService_ReorderPhotos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) SetPrimaryPhoto(ctx context.Context, in *SetPrimaryPhotoRequest, opts ...grpc.CallOption) (*SetPrimaryPhotoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPrimaryPhotoResponse)
+	err := c.cc.Invoke(ctx, MemberService_SetPrimaryPhoto_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) SuspendMember(ctx context.Context, in *SuspendMemberRequest, opts ...grpc.CallOption) (*SuspendMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspendMemberResponse)
+	err := c.cc.Invoke(ctx, MemberService_SuspendMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) BlockMember(ctx context.Context, in *BlockMemberRequest, opts ...grpc.CallOption) (*BlockMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockMemberResponse)
+	err := c.cc.Invoke(ctx, MemberService_BlockMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) UnblockMember(ctx context.Context, in *UnblockMemberRequest, opts ...grpc.CallOption) (*UnblockMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockMemberResponse)
+	err := c.cc.Invoke(ctx, MemberService_UnblockMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) ListBlockedMembers(ctx context.Context, in *ListBlockedMembersRequest, opts ...grpc.CallOption) (*ListBlockedMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBlockedMembersResponse)
+	err := c.cc.Invoke(ctx, MemberService_ListBlockedMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) CheckBlocked(ctx context.Context, in *CheckBlockedRequest, opts ...grpc.CallOption) (*CheckBlockedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckBlockedResponse)
+	err := c.cc.Invoke(ctx, MemberService_CheckBlocked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) ListSessionRevocations(ctx context.Context, in *ListSessionRevocationsRequest, opts ...grpc.CallOption) (*ListSessionRevocationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionRevocationsResponse)
+	err := c.cc.Invoke(ctx, MemberService_ListSessionRevocations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MemberServiceServer is the server API for MemberService service.
 // All implementations must embed UnimplementedMemberServiceServer
 // for forward compatibility.
+//
+// MemberService RPCs with an HTTP rule are also served by the gateway as
+// REST under /api, transcoded to JSON with the proto field names. The
+// gateway sets member_id from the signed-in member where the path says
+// "me", and the member service rejects requests for anyone else. RPCs
+// without a rule are only reachable over gRPC.
 type MemberServiceServer interface {
 	RegisterMember(context.Context, *RegisterMemberRequest) (*RegisterMemberResponse, error)
 	AuthenticateMember(context.Context, *AuthenticateMemberRequest) (*AuthenticateMemberResponse, error)
 	GetMember(context.Context, *GetMemberRequest) (*GetMemberResponse, error)
+	// UpdateProfile changes the fields named in update_mask. Over REST the
+	// mask defaults to the fields present in the body.
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	ListInterests(context.Context, *ListInterestsRequest) (*ListInterestsResponse, error)
+	SetInterests(context.Context, *SetInterestsRequest) (*SetInterestsResponse, error)
+	ListPrompts(context.Context, *ListPromptsRequest) (*ListPromptsResponse, error)
+	AnswerPrompt(context.Context, *AnswerPromptRequest) (*AnswerPromptResponse, error)
+	RemovePrompt(context.Context, *RemovePromptRequest) (*RemovePromptResponse, error)
+	ReorderPrompts(context.Context, *ReorderPromptsRequest) (*ReorderPromptsResponse, error)
+	GetPreferences(context.Context, *GetPreferencesRequest) (*GetPreferencesResponse, error)
+	// UpdatePreferences changes the fields named in update_mask. Over REST
+	// the mask defaults to the fields present in the body.
+	UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*UpdatePreferencesResponse, error)
+	SendEmailVerification(context.Context, *SendEmailVerificationRequest) (*SendEmailVerificationResponse, error)
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error)
+	// ActivateMember activates a pending member whose onboarding is complete.
+	// It fails with FAILED_PRECONDITION while onboarding is incomplete.
+	// Members are activated automatically when they finish onboarding, and
+	// suspended members are brought back with
+	// MemberAdminService.ReinstateMember.
 	ActivateMember(context.Context, *ActivateMemberRequest) (*ActivateMemberResponse, error)
+	AddPhoto(context.Context, *AddPhotoRequest) (*AddPhotoResponse, error)
+	RemovePhoto(context.Context, *RemovePhotoRequest) (*RemovePhotoResponse, error)
+	ReorderPhotos(context.Context, *ReorderPhotosRequest) (*ReorderPhotosResponse, error)
+	SetPrimaryPhoto(context.Context, *SetPrimaryPhotoRequest) (*SetPrimaryPhotoResponse, error)
+	SuspendMember(context.Context, *SuspendMemberRequest) (*SuspendMemberResponse, error)
+	BlockMember(context.Context, *BlockMemberRequest) (*BlockMemberResponse, error)
+	UnblockMember(context.Context, *UnblockMemberRequest) (*UnblockMemberResponse, error)
+	ListBlockedMembers(context.Context, *ListBlockedMembersRequest) (*ListBlockedMembersResponse, error)
+	CheckBlocked(context.Context, *CheckBlockedRequest) (*CheckBlockedResponse, error)
+	// ListSessionRevocations lists the members signed out everywhere since
+	// a point in time, for the gateway to reject their older tokens.
+	ListSessionRevocations(context.Context, *ListSessionRevocationsRequest) (*ListSessionRevocationsResponse, error)
 	mustEmbedUnimplementedMemberServiceServer()
 }
 
@@ -126,9 +420,69 @@ func (UnimplementedMemberServiceServer) GetMember(context.Context, *GetMemberReq
 func (UnimplementedMemberServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
 }
+func (UnimplementedMemberServiceServer) ListInterests(context.Context, *ListInterestsRequest) (*ListInterestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInterests not implemented")
+}
+func (UnimplementedMemberServiceServer) SetInterests(context.Context, *SetInterestsRequest) (*SetInterestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInterests not implemented")
+}
+func (UnimplementedMemberServiceServer) ListPrompts(context.Context, *ListPromptsRequest) (*ListPromptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPrompts not implemented")
+}
+func (UnimplementedMemberServiceServer) AnswerPrompt(context.Context, *AnswerPromptRequest) (*AnswerPromptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnswerPrompt not implemented")
+}
+func (UnimplementedMemberServiceServer) RemovePrompt(context.Context, *RemovePromptRequest) (*RemovePromptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePrompt not implemented")
+}
+func (UnimplementedMemberServiceServer) ReorderPrompts(context.Context, *ReorderPromptsRequest) (*ReorderPromptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderPrompts not implemented")
+}
+func (UnimplementedMemberServiceServer) GetPreferences(context.Context, *GetPreferencesRequest) (*GetPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreferences not implemented")
+}
+func (UnimplementedMemberServiceServer) UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*UpdatePreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePreferences not implemented")
+}
+func (UnimplementedMemberServiceServer) SendEmailVerification(context.Context, *SendEmailVerificationRequest) (*SendEmailVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEmailVerification not implemented")
+}
+func (UnimplementedMemberServiceServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
 func (UnimplementedMemberServiceServer) ActivateMember(context.Context, *ActivateMemberRequest) (*ActivateMemberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ActivateMember not implemented")
 }
+func (UnimplementedMemberServiceServer) AddPhoto(context.Context, *AddPhotoRequest) (*AddPhotoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPhoto not implemented")
+}
+func (UnimplementedMemberServiceServer) RemovePhoto(context.Context, *RemovePhotoRequest) (*RemovePhotoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePhoto not implemented")
+}
+func (UnimplementedMemberServiceServer) ReorderPhotos(context.Context, *ReorderPhotosRequest) (*ReorderPhotosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderPhotos not implemented")
+}
+func (UnimplementedMemberServiceServer) SetPrimaryPhoto(context.Context, *SetPrimaryPhotoRequest) (*SetPrimaryPhotoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrimaryPhoto not implemented")
+}
+func (UnimplementedMemberServiceServer) SuspendMember(context.Context, *SuspendMemberRequest) (*SuspendMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendMember not implemented")
+}
+func (UnimplementedMemberServiceServer) BlockMember(context.Context, *BlockMemberRequest) (*BlockMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockMember not implemented")
+}
+func (UnimplementedMemberServiceServer) UnblockMember(context.Context, *UnblockMemberRequest) (*UnblockMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockMember not implemented")
+}
+func (UnimplementedMemberServiceServer) ListBlockedMembers(context.Context, *ListBlockedMembersRequest) (*ListBlockedMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlockedMembers not implemented")
+}
+func (UnimplementedMemberServiceServer) CheckBlocked(context.Context, *CheckBlockedRequest) (*CheckBlockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckBlocked not implemented")
+}
+func (UnimplementedMemberServiceServer) ListSessionRevocations(context.Context, *ListSessionRevocationsRequest) (*ListSessionRevocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessionRevocations not implemented")
+}
 func (UnimplementedMemberServiceServer) mustEmbedUnimplementedMemberServiceServer() {}
 func (UnimplementedMemberServiceServer) testEmbeddedByValue()                       {}
 
@@ -222,50 +576,896 @@ func _MemberService_UpdateProfile_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MemberService_ActivateMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ActivateMemberRequest)
+func _MemberService_ListInterests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInterestsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MemberServiceServer).ActivateMember(ctx, in)
+		return srv.(MemberServiceServer).ListInterests(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MemberService_ActivateMember_FullMethodName,
+		FullMethod: MemberService_ListInterests_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MemberServiceServer).ActivateMember(ctx, req.(*ActivateMemberRequest))
+		return srv.(MemberServiceServer).ListInterests(ctx, req.(*ListInterestsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// MemberService_ServiceDesc is the grpc.ServiceDesc for MemberService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var MemberService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "member.v1.MemberService",
-	HandlerType: (*MemberServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterMember",
-			Handler:    _MemberService_RegisterMember_Handler,
-		},
-		{
-			MethodName: "AuthenticateMember",
-			Handler:    _MemberService_AuthenticateMember_Handler,
-		},
-		{
-			MethodName: "GetMember",
-			Handler:    _MemberService_GetMember_Handler,
-		},
-		{
-			MethodName: "UpdateProfile",
-			Handler:    _MemberService_UpdateProfile_Handler,
-		},
-		{
-			MethodName: "ActivateMember",
-			Handler:    _MemberService_ActivateMember_Handler,
+func _MemberService_SetInterests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInterestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).SetInterests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_SetInterests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).SetInterests(ctx, req.(*SetInterestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_ListPrompts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).ListPrompts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_ListPrompts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).ListPrompts(ctx, req.(*ListPromptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_AnswerPrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnswerPromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).AnswerPrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_AnswerPrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).AnswerPrompt(ctx, req.(*AnswerPromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_RemovePrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).RemovePrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_RemovePrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).RemovePrompt(ctx, req.(*RemovePromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_ReorderPrompts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderPromptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).ReorderPrompts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_ReorderPrompts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).ReorderPrompts(ctx, req.(*ReorderPromptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_GetPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).GetPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_GetPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).GetPreferences(ctx, req.(*GetPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_UpdatePreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).UpdatePreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_UpdatePreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).UpdatePreferences(ctx, req.(*UpdatePreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_SendEmailVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendEmailVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).SendEmailVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_SendEmailVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).SendEmailVerification(ctx, req.(*SendEmailVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_ActivateMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).ActivateMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_ActivateMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).ActivateMember(ctx, req.(*ActivateMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_AddPhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPhotoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).AddPhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_AddPhoto_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).AddPhoto(ctx, req.(*AddPhotoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_RemovePhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePhotoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).RemovePhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_RemovePhoto_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).RemovePhoto(ctx, req.(*RemovePhotoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_ReorderPhotos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderPhotosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).ReorderPhotos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_ReorderPhotos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).ReorderPhotos(ctx, req.(*ReorderPhotosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_SetPrimaryPhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPrimaryPhotoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).SetPrimaryPhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_SetPrimaryPhoto_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).SetPrimaryPhoto(ctx, req.(*SetPrimaryPhotoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_SuspendMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).SuspendMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_SuspendMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).SuspendMember(ctx, req.(*SuspendMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_BlockMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).BlockMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_BlockMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).BlockMember(ctx, req.(*BlockMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_UnblockMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).UnblockMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_UnblockMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).UnblockMember(ctx, req.(*UnblockMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_ListBlockedMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockedMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).ListBlockedMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_ListBlockedMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).ListBlockedMembers(ctx, req.(*ListBlockedMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_CheckBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckBlockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).CheckBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_CheckBlocked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).CheckBlocked(ctx, req.(*CheckBlockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_ListSessionRevocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionRevocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).ListSessionRevocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_ListSessionRevocations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).ListSessionRevocations(ctx, req.(*ListSessionRevocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MemberService_ServiceDesc is the grpc.ServiceDesc for MemberService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MemberService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "member.v1.MemberService",
+	HandlerType: (*MemberServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterMember",
+			Handler:    _MemberService_RegisterMember_Handler,
+		},
+		{
+			MethodName: "AuthenticateMember",
+			Handler:    _MemberService_AuthenticateMember_Handler,
+		},
+		{
+			MethodName: "GetMember",
+			Handler:    _MemberService_GetMember_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _MemberService_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "ListInterests",
+			Handler:    _MemberService_ListInterests_Handler,
+		},
+		{
+			MethodName: "SetInterests",
+			Handler:    _MemberService_SetInterests_Handler,
+		},
+		{
+			MethodName: "ListPrompts",
+			Handler:    _MemberService_ListPrompts_Handler,
+		},
+		{
+			MethodName: "AnswerPrompt",
+			Handler:    _MemberService_AnswerPrompt_Handler,
+		},
+		{
+			MethodName: "RemovePrompt",
+			Handler:    _MemberService_RemovePrompt_Handler,
+		},
+		{
+			MethodName: "ReorderPrompts",
+			Handler:    _MemberService_ReorderPrompts_Handler,
+		},
+		{
+			MethodName: "GetPreferences",
+			Handler:    _MemberService_GetPreferences_Handler,
+		},
+		{
+			MethodName: "UpdatePreferences",
+			Handler:    _MemberService_UpdatePreferences_Handler,
+		},
+		{
+			MethodName: "SendEmailVerification",
+			Handler:    _MemberService_SendEmailVerification_Handler,
+		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _MemberService_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "ActivateMember",
+			Handler:    _MemberService_ActivateMember_Handler,
+		},
+		{
+			MethodName: "AddPhoto",
+			Handler:    _MemberService_AddPhoto_Handler,
+		},
+		{
+			MethodName: "RemovePhoto",
+			Handler:    _MemberService_RemovePhoto_Handler,
+		},
+		{
+			MethodName: "ReorderPhotos",
+			Handler:    _MemberService_ReorderPhotos_Handler,
+		},
+		{
+			MethodName: "SetPrimaryPhoto",
+			Handler:    _MemberService_SetPrimaryPhoto_Handler,
+		},
+		{
+			MethodName: "SuspendMember",
+			Handler:    _MemberService_SuspendMember_Handler,
+		},
+		{
+			MethodName: "BlockMember",
+			Handler:    _MemberService_BlockMember_Handler,
+		},
+		{
+			MethodName: "UnblockMember",
+			Handler:    _MemberService_UnblockMember_Handler,
+		},
+		{
+			MethodName: "ListBlockedMembers",
+			Handler:    _MemberService_ListBlockedMembers_Handler,
+		},
+		{
+			MethodName: "CheckBlocked",
+			Handler:    _MemberService_CheckBlocked_Handler,
+		},
+		{
+			MethodName: "ListSessionRevocations",
+			Handler:    _MemberService_ListSessionRevocations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "member/v1/member.proto",
+}
+
+const (
+	MemberAdminService_GetMemberHistory_FullMethodName = "/member.v1.MemberAdminService/GetMemberHistory"
+	MemberAdminService_GetMemberStateAt_FullMethodName = "/member.v1.MemberAdminService/GetMemberStateAt"
+	MemberAdminService_SearchMembers_FullMethodName    = "/member.v1.MemberAdminService/SearchMembers"
+	MemberAdminService_SuspendMember_FullMethodName    = "/member.v1.MemberAdminService/SuspendMember"
+	MemberAdminService_ReinstateMember_FullMethodName  = "/member.v1.MemberAdminService/ReinstateMember"
+	MemberAdminService_RevokeSessions_FullMethodName   = "/member.v1.MemberAdminService/RevokeSessions"
+	MemberAdminService_SetMemberRoles_FullMethodName   = "/member.v1.MemberAdminService/SetMemberRoles"
+	MemberAdminService_ListAuditLog_FullMethodName     = "/member.v1.MemberAdminService/ListAuditLog"
+)
+
+// MemberAdminServiceClient is the client API for MemberAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MemberAdminService is for staff and internal tooling; members cannot
+// reach it. Staff call it through the gateway's /api/v1/admin routes and
+// need a role for each method, see SetMemberRoles. Every call is recorded
+// in the audit log.
+type MemberAdminServiceClient interface {
+	// GetMemberHistory returns a member's events as a timeline, oldest first,
+	// with personal details redacted.
+	GetMemberHistory(ctx context.Context, in *GetMemberHistoryRequest, opts ...grpc.CallOption) (*GetMemberHistoryResponse, error)
+	// GetMemberStateAt returns the member as they were at a point in time.
+	GetMemberStateAt(ctx context.Context, in *GetMemberStateAtRequest, opts ...grpc.CallOption) (*GetMemberStateAtResponse, error)
+	// SearchMembers lists members matching filters, a page at a time. It
+	// reads the members read model, so the members it returns carry the
+	// profile and status but not photos, prompts or preferences.
+	SearchMembers(ctx context.Context, in *SearchMembersRequest, opts ...grpc.CallOption) (*SearchMembersResponse, error)
+	// SuspendMember bars the member from the platform and signs them out
+	// everywhere.
+	SuspendMember(ctx context.Context, in *SuspendMemberRequest, opts ...grpc.CallOption) (*SuspendMemberResponse, error)
+	// ReinstateMember lifts a suspension. The member is active again, or
+	// pending when they had not finished onboarding.
+	ReinstateMember(ctx context.Context, in *ReinstateMemberRequest, opts ...grpc.CallOption) (*ReinstateMemberResponse, error)
+	// RevokeSessions signs the member out on every device: tokens issued
+	// before now are rejected by the gateway.
+	RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...grpc.CallOption) (*RevokeSessionsResponse, error)
+	// SetMemberRoles replaces the member's staff roles, "support" or "admin".
+	SetMemberRoles(ctx context.Context, in *SetMemberRolesRequest, opts ...grpc.CallOption) (*SetMemberRolesResponse, error)
+	// ListAuditLog returns recorded admin calls, newest first.
+	ListAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*ListAuditLogResponse, error)
+}
+
+type memberAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMemberAdminServiceClient(cc grpc.ClientConnInterface) MemberAdminServiceClient {
+	return &memberAdminServiceClient{cc}
+}
+
+func (c *memberAdminServiceClient) GetMemberHistory(ctx context.Context, in *GetMemberHistoryRequest, opts ...grpc.CallOption) (*GetMemberHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMemberHistoryResponse)
+	err := c.cc.Invoke(ctx, MemberAdminService_GetMemberHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberAdminServiceClient) GetMemberStateAt(ctx context.Context, in *GetMemberStateAtRequest, opts ...grpc.CallOption) (*GetMemberStateAtResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMemberStateAtResponse)
+	err := c.cc.Invoke(ctx, MemberAdminService_GetMemberStateAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberAdminServiceClient) SearchMembers(ctx context.Context, in *SearchMembersRequest, opts ...grpc.CallOption) (*SearchMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMembersResponse)
+	err := c.cc.Invoke(ctx, MemberAdminService_SearchMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberAdminServiceClient) SuspendMember(ctx context.Context, in *SuspendMemberRequest, opts ...grpc.CallOption) (*SuspendMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspendMemberResponse)
+	err := c.cc.Invoke(ctx, MemberAdminService_SuspendMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberAdminServiceClient) ReinstateMember(ctx context.Context, in *ReinstateMemberRequest, opts ...grpc.CallOption) (*ReinstateMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReinstateMemberResponse)
+	err := c.cc.Invoke(ctx, MemberAdminService_ReinstateMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberAdminServiceClient) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...grpc.CallOption) (*RevokeSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionsResponse)
+	err := c.cc.Invoke(ctx, MemberAdminService_RevokeSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberAdminServiceClient) SetMemberRoles(ctx context.Context, in *SetMemberRolesRequest, opts ...grpc.CallOption) (*SetMemberRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMemberRolesResponse)
+	err := c.cc.Invoke(ctx, MemberAdminService_SetMemberRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberAdminServiceClient) ListAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*ListAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditLogResponse)
+	err := c.cc.Invoke(ctx, MemberAdminService_ListAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MemberAdminServiceServer is the server API for MemberAdminService service.
+// All implementations must embed UnimplementedMemberAdminServiceServer
+// for forward compatibility.
+//
+// MemberAdminService is for staff and internal tooling; members cannot
+// reach it. Staff call it through the gateway's /api/v1/admin routes and
+// need a role for each method, see SetMemberRoles. Every call is recorded
+// in the audit log.
+type MemberAdminServiceServer interface {
+	// GetMemberHistory returns a member's events as a timeline, oldest first,
+	// with personal details redacted.
+	GetMemberHistory(context.Context, *GetMemberHistoryRequest) (*GetMemberHistoryResponse, error)
+	// GetMemberStateAt returns the member as they were at a point in time.
+	GetMemberStateAt(context.Context, *GetMemberStateAtRequest) (*GetMemberStateAtResponse, error)
+	// SearchMembers lists members matching filters, a page at a time. It
+	// reads the members read model, so the members it returns carry the
+	// profile and status but not photos, prompts or preferences.
+	SearchMembers(context.Context, *SearchMembersRequest) (*SearchMembersResponse, error)
+	// SuspendMember bars the member from the platform and signs them out
+	// everywhere.
+	SuspendMember(context.Context, *SuspendMemberRequest) (*SuspendMemberResponse, error)
+	// ReinstateMember lifts a suspension. The member is active again, or
+	// pending when they had not finished onboarding.
+	ReinstateMember(context.Context, *ReinstateMemberRequest) (*ReinstateMemberResponse, error)
+	// RevokeSessions signs the member out on every device: tokens issued
+	// before now are rejected by the gateway.
+	RevokeSessions(context.Context, *RevokeSessionsRequest) (*RevokeSessionsResponse, error)
+	// SetMemberRoles replaces the member's staff roles, "support" or "admin".
+	SetMemberRoles(context.Context, *SetMemberRolesRequest) (*SetMemberRolesResponse, error)
+	// ListAuditLog returns recorded admin calls, newest first.
+	ListAuditLog(context.Context, *ListAuditLogRequest) (*ListAuditLogResponse, error)
+	mustEmbedUnimplementedMemberAdminServiceServer()
+}
+
+// UnimplementedMemberAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMemberAdminServiceServer struct{}
+
+func (UnimplementedMemberAdminServiceServer) GetMemberHistory(context.Context, *GetMemberHistoryRequest) (*GetMemberHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemberHistory not implemented")
+}
+func (UnimplementedMemberAdminServiceServer) GetMemberStateAt(context.Context, *GetMemberStateAtRequest) (*GetMemberStateAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemberStateAt not implemented")
+}
+func (UnimplementedMemberAdminServiceServer) SearchMembers(context.Context, *SearchMembersRequest) (*SearchMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMembers not implemented")
+}
+func (UnimplementedMemberAdminServiceServer) SuspendMember(context.Context, *SuspendMemberRequest) (*SuspendMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendMember not implemented")
+}
+func (UnimplementedMemberAdminServiceServer) ReinstateMember(context.Context, *ReinstateMemberRequest) (*ReinstateMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReinstateMember not implemented")
+}
+func (UnimplementedMemberAdminServiceServer) RevokeSessions(context.Context, *RevokeSessionsRequest) (*RevokeSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSessions not implemented")
+}
+func (UnimplementedMemberAdminServiceServer) SetMemberRoles(context.Context, *SetMemberRolesRequest) (*SetMemberRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMemberRoles not implemented")
+}
+func (UnimplementedMemberAdminServiceServer) ListAuditLog(context.Context, *ListAuditLogRequest) (*ListAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditLog not implemented")
+}
+func (UnimplementedMemberAdminServiceServer) mustEmbedUnimplementedMemberAdminServiceServer() {}
+func (UnimplementedMemberAdminServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeMemberAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MemberAdminServiceServer will
+// result in compilation errors.
+type UnsafeMemberAdminServiceServer interface {
+	mustEmbedUnimplementedMemberAdminServiceServer()
+}
+
+func RegisterMemberAdminServiceServer(s grpc.ServiceRegistrar, srv MemberAdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMemberAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MemberAdminService_ServiceDesc, srv)
+}
+
+func _MemberAdminService_GetMemberHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemberHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberAdminServiceServer).GetMemberHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberAdminService_GetMemberHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberAdminServiceServer).GetMemberHistory(ctx, req.(*GetMemberHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberAdminService_GetMemberStateAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemberStateAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberAdminServiceServer).GetMemberStateAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberAdminService_GetMemberStateAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberAdminServiceServer).GetMemberStateAt(ctx, req.(*GetMemberStateAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberAdminService_SearchMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberAdminServiceServer).SearchMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberAdminService_SearchMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberAdminServiceServer).SearchMembers(ctx, req.(*SearchMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberAdminService_SuspendMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberAdminServiceServer).SuspendMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberAdminService_SuspendMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberAdminServiceServer).SuspendMember(ctx, req.(*SuspendMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberAdminService_ReinstateMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReinstateMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberAdminServiceServer).ReinstateMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberAdminService_ReinstateMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberAdminServiceServer).ReinstateMember(ctx, req.(*ReinstateMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberAdminService_RevokeSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberAdminServiceServer).RevokeSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberAdminService_RevokeSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberAdminServiceServer).RevokeSessions(ctx, req.(*RevokeSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberAdminService_SetMemberRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemberRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberAdminServiceServer).SetMemberRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberAdminService_SetMemberRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberAdminServiceServer).SetMemberRoles(ctx, req.(*SetMemberRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberAdminService_ListAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberAdminServiceServer).ListAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberAdminService_ListAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberAdminServiceServer).ListAuditLog(ctx, req.(*ListAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MemberAdminService_ServiceDesc is the grpc.ServiceDesc for MemberAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MemberAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "member.v1.MemberAdminService",
+	HandlerType: (*MemberAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMemberHistory",
+			Handler:    _MemberAdminService_GetMemberHistory_Handler,
+		},
+		{
+			MethodName: "GetMemberStateAt",
+			Handler:    _MemberAdminService_GetMemberStateAt_Handler,
+		},
+		{
+			MethodName: "SearchMembers",
+			Handler:    _MemberAdminService_SearchMembers_Handler,
+		},
+		{
+			MethodName: "SuspendMember",
+			Handler:    _MemberAdminService_SuspendMember_Handler,
+		},
+		{
+			MethodName: "ReinstateMember",
+			Handler:    _MemberAdminService_ReinstateMember_Handler,
+		},
+		{
+			MethodName: "RevokeSessions",
+			Handler:    _MemberAdminService_RevokeSessions_Handler,
+		},
+		{
+			MethodName: "SetMemberRoles",
+			Handler:    _MemberAdminService_SetMemberRoles_Handler,
+		},
+		{
+			MethodName: "ListAuditLog",
+			Handler:    _MemberAdminService_ListAuditLog_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -0,0 +1,1058 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: moderation/v1/moderation.proto
+
+package moderationv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReportCategory int32
+
+const (
+	ReportCategory_REPORT_CATEGORY_UNSPECIFIED           ReportCategory = 0
+	ReportCategory_REPORT_CATEGORY_SPAM                  ReportCategory = 1
+	ReportCategory_REPORT_CATEGORY_FAKE_PROFILE          ReportCategory = 2
+	ReportCategory_REPORT_CATEGORY_INAPPROPRIATE_CONTENT ReportCategory = 3
+	ReportCategory_REPORT_CATEGORY_HARASSMENT            ReportCategory = 4
+	ReportCategory_REPORT_CATEGORY_SCAM                  ReportCategory = 5
+	ReportCategory_REPORT_CATEGORY_UNDERAGE              ReportCategory = 6
+	ReportCategory_REPORT_CATEGORY_OTHER                 ReportCategory = 7
+)
+
+// Enum value maps for ReportCategory.
+var (
+	ReportCategory_name = map[int32]string{
+		0: "REPORT_CATEGORY_UNSPECIFIED",
+		1: "REPORT_CATEGORY_SPAM",
+		2: "REPORT_CATEGORY_FAKE_PROFILE",
+		3: "REPORT_CATEGORY_INAPPROPRIATE_CONTENT",
+		4: "REPORT_CATEGORY_HARASSMENT",
+		5: "REPORT_CATEGORY_SCAM",
+		6: "REPORT_CATEGORY_UNDERAGE",
+		7: "REPORT_CATEGORY_OTHER",
+	}
+	ReportCategory_value = map[string]int32{
+		"REPORT_CATEGORY_UNSPECIFIED":           0,
+		"REPORT_CATEGORY_SPAM":                  1,
+		"REPORT_CATEGORY_FAKE_PROFILE":          2,
+		"REPORT_CATEGORY_INAPPROPRIATE_CONTENT": 3,
+		"REPORT_CATEGORY_HARASSMENT":            4,
+		"REPORT_CATEGORY_SCAM":                  5,
+		"REPORT_CATEGORY_UNDERAGE":              6,
+		"REPORT_CATEGORY_OTHER":                 7,
+	}
+)
+
+func (x ReportCategory) Enum() *ReportCategory {
+	p := new(ReportCategory)
+	*p = x
+	return p
+}
+
+func (x ReportCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_moderation_v1_moderation_proto_enumTypes[0].Descriptor()
+}
+
+func (ReportCategory) Type() protoreflect.EnumType {
+	return &file_moderation_v1_moderation_proto_enumTypes[0]
+}
+
+func (x ReportCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportCategory.Descriptor instead.
+func (ReportCategory) EnumDescriptor() ([]byte, []int) {
+	return file_moderation_v1_moderation_proto_rawDescGZIP(), []int{0}
+}
+
+type CaseStatus int32
+
+const (
+	CaseStatus_CASE_STATUS_UNSPECIFIED CaseStatus = 0
+	CaseStatus_CASE_STATUS_OPEN        CaseStatus = 1
+	CaseStatus_CASE_STATUS_IN_REVIEW   CaseStatus = 2
+	CaseStatus_CASE_STATUS_RESOLVED    CaseStatus = 3
+)
+
+// Enum value maps for CaseStatus.
+var (
+	CaseStatus_name = map[int32]string{
+		0: "CASE_STATUS_UNSPECIFIED",
+		1: "CASE_STATUS_OPEN",
+		2: "CASE_STATUS_IN_REVIEW",
+		3: "CASE_STATUS_RESOLVED",
+	}
+	CaseStatus_value = map[string]int32{
+		"CASE_STATUS_UNSPECIFIED": 0,
+		"CASE_STATUS_OPEN":        1,
+		"CASE_STATUS_IN_REVIEW":   2,
+		"CASE_STATUS_RESOLVED":    3,
+	}
+)
+
+func (x CaseStatus) Enum() *CaseStatus {
+	p := new(CaseStatus)
+	*p = x
+	return p
+}
+
+func (x CaseStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CaseStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_moderation_v1_moderation_proto_enumTypes[1].Descriptor()
+}
+
+func (CaseStatus) Type() protoreflect.EnumType {
+	return &file_moderation_v1_moderation_proto_enumTypes[1]
+}
+
+func (x CaseStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CaseStatus.Descriptor instead.
+func (CaseStatus) EnumDescriptor() ([]byte, []int) {
+	return file_moderation_v1_moderation_proto_rawDescGZIP(), []int{1}
+}
+
+type ModerationAction int32
+
+const (
+	ModerationAction_MODERATION_ACTION_UNSPECIFIED ModerationAction = 0
+	ModerationAction_MODERATION_ACTION_DISMISS     ModerationAction = 1
+	ModerationAction_MODERATION_ACTION_WARN        ModerationAction = 2
+	ModerationAction_MODERATION_ACTION_SUSPEND     ModerationAction = 3
+)
+
+// Enum value maps for ModerationAction.
+var (
+	ModerationAction_name = map[int32]string{
+		0: "MODERATION_ACTION_UNSPECIFIED",
+		1: "MODERATION_ACTION_DISMISS",
+		2: "MODERATION_ACTION_WARN",
+		3: "MODERATION_ACTION_SUSPEND",
+	}
+	ModerationAction_value = map[string]int32{
+		"MODERATION_ACTION_UNSPECIFIED": 0,
+		"MODERATION_ACTION_DISMISS":     1,
+		"MODERATION_ACTION_WARN":        2,
+		"MODERATION_ACTION_SUSPEND":     3,
+	}
+)
+
+func (x ModerationAction) Enum() *ModerationAction {
+	p := new(ModerationAction)
+	*p = x
+	return p
+}
+
+func (x ModerationAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModerationAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_moderation_v1_moderation_proto_enumTypes[2].Descriptor()
+}
+
+func (ModerationAction) Type() protoreflect.EnumType {
+	return &file_moderation_v1_moderation_proto_enumTypes[2]
+}
+
+func (x ModerationAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModerationAction.Descriptor instead.
+func (ModerationAction) EnumDescriptor() ([]byte, []int) {
+	return file_moderation_v1_moderation_proto_rawDescGZIP(), []int{2}
+}
+
+type Report struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReporterId    string                 `protobuf:"bytes,2,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	Category      ReportCategory         `protobuf:"varint,3,opt,name=category,proto3,enum=moderation.v1.ReportCategory" json:"category,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Report) Reset() {
+	*x = Report{}
+	mi := &file_moderation_v1_moderation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report) ProtoMessage() {}
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	mi := &file_moderation_v1_moderation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report.ProtoReflect.Descriptor instead.
+func (*Report) Descriptor() ([]byte, []int) {
+	return file_moderation_v1_moderation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Report) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Report) GetReporterId() string {
+	if x != nil {
+		return x.ReporterId
+	}
+	return ""
+}
+
+func (x *Report) GetCategory() ReportCategory {
+	if x != nil {
+		return x.Category
+	}
+	return ReportCategory_REPORT_CATEGORY_UNSPECIFIED
+}
+
+func (x *Report) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Report) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Case groups the open reports against a member for review.
+type Case struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MemberId       string                 `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Status         CaseStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=moderation.v1.CaseStatus" json:"status,omitempty"`
+	Priority       int32                  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	Reports        []*Report              `protobuf:"bytes,5,rep,name=reports,proto3" json:"reports,omitempty"`
+	AssigneeId     string                 `protobuf:"bytes,6,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	Action         ModerationAction       `protobuf:"varint,7,opt,name=action,proto3,enum=moderation.v1.ModerationAction" json:"action,omitempty"`
+	ResolutionNote string                 `protobuf:"bytes,8,opt,name=resolution_note,json=resolutionNote,proto3" json:"resolution_note,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ResolvedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Case) Reset() {
+	*x = Case{}
+	mi := &file_moderation_v1_moderation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Case) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Case) ProtoMessage() {}
+
+func (x *Case) ProtoReflect() protoreflect.Message {
+	mi := &file_moderation_v1_moderation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Case.ProtoReflect.Descriptor instead.
+func (*Case) Descriptor() ([]byte, []int) {
+	return file_moderation_v1_moderation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Case) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Case) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *Case) GetStatus() CaseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CaseStatus_CASE_STATUS_UNSPECIFIED
+}
+
+func (x *Case) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Case) GetReports() []*Report {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *Case) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+func (x *Case) GetAction() ModerationAction {
+	if x != nil {
+		return x.Action
+	}
+	return ModerationAction_MODERATION_ACTION_UNSPECIFIED
+}
+
+func (x *Case) GetResolutionNote() string {
+	if x != nil {
+		return x.ResolutionNote
+	}
+	return ""
+}
+
+func (x *Case) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Case) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Case) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+type FileReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReporterId    string                 `protobuf:"bytes,1,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	MemberId      string                 `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Category      ReportCategory         `protobuf:"varint,3,opt,name=category,proto3,enum=moderation.v1.ReportCategory" json:"category,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileReportRequest) Reset() {
+	*x = FileReportRequest{}
+	mi := &file_moderation_v1_moderation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileReportRequest) ProtoMessage() {}
+
+func (x *FileReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moderation_v1_moderation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileReportRequest.ProtoReflect.Descriptor instead.
+func (*FileReportRequest) Descriptor() ([]byte, []int) {
+	return file_moderation_v1_moderation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FileReportRequest) GetReporterId() string {
+	if x != nil {
+		return x.ReporterId
+	}
+	return ""
+}
+
+func (x *FileReportRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *FileReportRequest) GetCategory() ReportCategory {
+	if x != nil {
+		return x.Category
+	}
+	return ReportCategory_REPORT_CATEGORY_UNSPECIFIED
+}
+
+func (x *FileReportRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type FileReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportId      string                 `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileReportResponse) Reset() {
+	*x = FileReportResponse{}
+	mi := &file_moderation_v1_moderation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileReportResponse) ProtoMessage() {}
+
+func (x *FileReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moderation_v1_moderation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileReportResponse.ProtoReflect.Descriptor instead.
+func (*FileReportResponse) Descriptor() ([]byte, []int) {
+	return file_moderation_v1_moderation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FileReportResponse) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+type ListCasesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to open cases.
+	Status        CaseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=moderation.v1.CaseStatus" json:"status,omitempty"`
+	Limit         int32      `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCasesRequest) Reset() {
+	*x = ListCasesRequest{}
+	mi := &file_moderation_v1_moderation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCasesRequest) ProtoMessage() {}
+
+func (x *ListCasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moderation_v1_moderation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCasesRequest.ProtoReflect.Descriptor instead.
+func (*ListCasesRequest) Descriptor() ([]byte, []int) {
+	return file_moderation_v1_moderation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListCasesRequest) GetStatus() CaseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CaseStatus_CASE_STATUS_UNSPECIFIED
+}
+
+func (x *ListCasesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListCasesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cases         []*Case                `protobuf:"bytes,1,rep,name=cases,proto3" json:"cases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCasesResponse) Reset() {
+	*x = ListCasesResponse{}
+	mi := &file_moderation_v1_moderation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCasesResponse) ProtoMessage() {}
+
+func (x *ListCasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moderation_v1_moderation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCasesResponse.ProtoReflect.Descriptor instead.
+func (*ListCasesResponse) Descriptor() ([]byte, []int) {
+	return file_moderation_v1_moderation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListCasesResponse) GetCases() []*Case {
+	if x != nil {
+		return x.Cases
+	}
+	return nil
+}
+
+type GetCaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CaseId        string                 `protobuf:"bytes,1,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCaseRequest) Reset() {
+	*x = GetCaseRequest{}
+	mi := &file_moderation_v1_moderation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCaseRequest) ProtoMessage() {}
+
+func (x *GetCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moderation_v1_moderation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCaseRequest.ProtoReflect.Descriptor instead.
+func (*GetCaseRequest) Descriptor() ([]byte, []int) {
+	return file_moderation_v1_moderation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetCaseRequest) GetCaseId() string {
+	if x != nil {
+		return x.CaseId
+	}
+	return ""
+}
+
+type GetCaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Case          *Case                  `protobuf:"bytes,1,opt,name=case,proto3" json:"case,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCaseResponse) Reset() {
+	*x = GetCaseResponse{}
+	mi := &file_moderation_v1_moderation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCaseResponse) ProtoMessage() {}
+
+func (x *GetCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moderation_v1_moderation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCaseResponse.ProtoReflect.Descriptor instead.
+func (*GetCaseResponse) Descriptor() ([]byte, []int) {
+	return file_moderation_v1_moderation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetCaseResponse) GetCase() *Case {
+	if x != nil {
+		return x.Case
+	}
+	return nil
+}
+
+// ClaimCase and ResolveCase act for the signed-in staff member, whose
+// access token the caller forwards.
+type ClaimCaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CaseId        string                 `protobuf:"bytes,1,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimCaseRequest) Reset() {
+	*x = ClaimCaseRequest{}
+	mi := &file_moderation_v1_moderation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimCaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimCaseRequest) ProtoMessage() {}
+
+func (x *ClaimCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moderation_v1_moderation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimCaseRequest.ProtoReflect.Descriptor instead.
+func (*ClaimCaseRequest) Descriptor() ([]byte, []int) {
+	return file_moderation_v1_moderation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ClaimCaseRequest) GetCaseId() string {
+	if x != nil {
+		return x.CaseId
+	}
+	return ""
+}
+
+type ClaimCaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Case          *Case                  `protobuf:"bytes,1,opt,name=case,proto3" json:"case,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimCaseResponse) Reset() {
+	*x = ClaimCaseResponse{}
+	mi := &file_moderation_v1_moderation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimCaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimCaseResponse) ProtoMessage() {}
+
+func (x *ClaimCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moderation_v1_moderation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimCaseResponse.ProtoReflect.Descriptor instead.
+func (*ClaimCaseResponse) Descriptor() ([]byte, []int) {
+	return file_moderation_v1_moderation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ClaimCaseResponse) GetCase() *Case {
+	if x != nil {
+		return x.Case
+	}
+	return nil
+}
+
+type ResolveCaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CaseId        string                 `protobuf:"bytes,1,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+	Action        ModerationAction       `protobuf:"varint,3,opt,name=action,proto3,enum=moderation.v1.ModerationAction" json:"action,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveCaseRequest) Reset() {
+	*x = ResolveCaseRequest{}
+	mi := &file_moderation_v1_moderation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveCaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveCaseRequest) ProtoMessage() {}
+
+func (x *ResolveCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moderation_v1_moderation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveCaseRequest.ProtoReflect.Descriptor instead.
+func (*ResolveCaseRequest) Descriptor() ([]byte, []int) {
+	return file_moderation_v1_moderation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ResolveCaseRequest) GetCaseId() string {
+	if x != nil {
+		return x.CaseId
+	}
+	return ""
+}
+
+func (x *ResolveCaseRequest) GetAction() ModerationAction {
+	if x != nil {
+		return x.Action
+	}
+	return ModerationAction_MODERATION_ACTION_UNSPECIFIED
+}
+
+func (x *ResolveCaseRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ResolveCaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Case          *Case                  `protobuf:"bytes,1,opt,name=case,proto3" json:"case,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveCaseResponse) Reset() {
+	*x = ResolveCaseResponse{}
+	mi := &file_moderation_v1_moderation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveCaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveCaseResponse) ProtoMessage() {}
+
+func (x *ResolveCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moderation_v1_moderation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveCaseResponse.ProtoReflect.Descriptor instead.
+func (*ResolveCaseResponse) Descriptor() ([]byte, []int) {
+	return file_moderation_v1_moderation_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ResolveCaseResponse) GetCase() *Case {
+	if x != nil {
+		return x.Case
+	}
+	return nil
+}
+
+var File_moderation_v1_moderation_proto protoreflect.FileDescriptor
+
+const file_moderation_v1_moderation_proto_rawDesc = "" +
+	"\n" +
+	"\x1emoderation/v1/moderation.proto\x12\rmoderation.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd1\x01\n" +
+	"\x06Report\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vreporter_id\x18\x02 \x01(\tR\n" +
+	"reporterId\x129\n" +
+	"\bcategory\x18\x03 \x01(\x0e2\x1d.moderation.v1.ReportCategoryR\bcategory\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xe9\x03\n" +
+	"\x04Case\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tmember_id\x18\x02 \x01(\tR\bmemberId\x121\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x19.moderation.v1.CaseStatusR\x06status\x12\x1a\n" +
+	"\bpriority\x18\x04 \x01(\x05R\bpriority\x12/\n" +
+	"\areports\x18\x05 \x03(\v2\x15.moderation.v1.ReportR\areports\x12\x1f\n" +
+	"\vassignee_id\x18\x06 \x01(\tR\n" +
+	"assigneeId\x127\n" +
+	"\x06action\x18\a \x01(\x0e2\x1f.moderation.v1.ModerationActionR\x06action\x12'\n" +
+	"\x0fresolution_note\x18\b \x01(\tR\x0eresolutionNote\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12;\n" +
+	"\vresolved_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\"\xae\x01\n" +
+	"\x11FileReportRequest\x12\x1f\n" +
+	"\vreporter_id\x18\x01 \x01(\tR\n" +
+	"reporterId\x12\x1b\n" +
+	"\tmember_id\x18\x02 \x01(\tR\bmemberId\x129\n" +
+	"\bcategory\x18\x03 \x01(\x0e2\x1d.moderation.v1.ReportCategoryR\bcategory\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"1\n" +
+	"\x12FileReportResponse\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\tR\breportId\"[\n" +
+	"\x10ListCasesRequest\x121\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x19.moderation.v1.CaseStatusR\x06status\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\">\n" +
+	"\x11ListCasesResponse\x12)\n" +
+	"\x05cases\x18\x01 \x03(\v2\x13.moderation.v1.CaseR\x05cases\")\n" +
+	"\x0eGetCaseRequest\x12\x17\n" +
+	"\acase_id\x18\x01 \x01(\tR\x06caseId\":\n" +
+	"\x0fGetCaseResponse\x12'\n" +
+	"\x04case\x18\x01 \x01(\v2\x13.moderation.v1.CaseR\x04case\"?\n" +
+	"\x10ClaimCaseRequest\x12\x17\n" +
+	"\acase_id\x18\x01 \x01(\tR\x06caseIdJ\x04\b\x02\x10\x03R\fmoderator_id\"<\n" +
+	"\x11ClaimCaseResponse\x12'\n" +
+	"\x04case\x18\x01 \x01(\v2\x13.moderation.v1.CaseR\x04case\"\x8e\x01\n" +
+	"\x12ResolveCaseRequest\x12\x17\n" +
+	"\acase_id\x18\x01 \x01(\tR\x06caseId\x127\n" +
+	"\x06action\x18\x03 \x01(\x0e2\x1f.moderation.v1.ModerationActionR\x06action\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04noteJ\x04\b\x02\x10\x03R\fmoderator_id\">\n" +
+	"\x13ResolveCaseResponse\x12'\n" +
+	"\x04case\x18\x01 \x01(\v2\x13.moderation.v1.CaseR\x04case*\x8b\x02\n" +
+	"\x0eReportCategory\x12\x1f\n" +
+	"\x1bREPORT_CATEGORY_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14REPORT_CATEGORY_SPAM\x10\x01\x12 \n" +
+	"\x1cREPORT_CATEGORY_FAKE_PROFILE\x10\x02\x12)\n" +
+	"%REPORT_CATEGORY_INAPPROPRIATE_CONTENT\x10\x03\x12\x1e\n" +
+	"\x1aREPORT_CATEGORY_HARASSMENT\x10\x04\x12\x18\n" +
+	"\x14REPORT_CATEGORY_SCAM\x10\x05\x12\x1c\n" +
+	"\x18REPORT_CATEGORY_UNDERAGE\x10\x06\x12\x19\n" +
+	"\x15REPORT_CATEGORY_OTHER\x10\a*t\n" +
+	"\n" +
+	"CaseStatus\x12\x1b\n" +
+	"\x17CASE_STATUS_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10CASE_STATUS_OPEN\x10\x01\x12\x19\n" +
+	"\x15CASE_STATUS_IN_REVIEW\x10\x02\x12\x18\n" +
+	"\x14CASE_STATUS_RESOLVED\x10\x03*\x8f\x01\n" +
+	"\x10ModerationAction\x12!\n" +
+	"\x1dMODERATION_ACTION_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19MODERATION_ACTION_DISMISS\x10\x01\x12\x1a\n" +
+	"\x16MODERATION_ACTION_WARN\x10\x02\x12\x1d\n" +
+	"\x19MODERATION_ACTION_SUSPEND\x10\x032\xa6\x03\n" +
+	"\x11ModerationService\x12Q\n" +
+	"\n" +
+	"FileReport\x12 .moderation.v1.FileReportRequest\x1a!.moderation.v1.FileReportResponse\x12N\n" +
+	"\tListCases\x12\x1f.moderation.v1.ListCasesRequest\x1a .moderation.v1.ListCasesResponse\x12H\n" +
+	"\aGetCase\x12\x1d.moderation.v1.GetCaseRequest\x1a\x1e.moderation.v1.GetCaseResponse\x12N\n" +
+	"\tClaimCase\x12\x1f.moderation.v1.ClaimCaseRequest\x1a .moderation.v1.ClaimCaseResponse\x12T\n" +
+	"\vResolveCase\x12!.moderation.v1.ResolveCaseRequest\x1a\".moderation.v1.ResolveCaseResponseBRZPgithub.com/mattuttis/inetcontrol/zoekdeware/api/proto/moderation/v1;moderationv1b\x06proto3"
+
+var (
+	file_moderation_v1_moderation_proto_rawDescOnce sync.Once
+	file_moderation_v1_moderation_proto_rawDescData []byte
+)
+
+func file_moderation_v1_moderation_proto_rawDescGZIP() []byte {
+	file_moderation_v1_moderation_proto_rawDescOnce.Do(func() {
+		file_moderation_v1_moderation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_moderation_v1_moderation_proto_rawDesc), len(file_moderation_v1_moderation_proto_rawDesc)))
+	})
+	return file_moderation_v1_moderation_proto_rawDescData
+}
+
+var file_moderation_v1_moderation_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_moderation_v1_moderation_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_moderation_v1_moderation_proto_goTypes = []any{
+	(ReportCategory)(0),           // 0: moderation.v1.ReportCategory
+	(CaseStatus)(0),               // 1: moderation.v1.CaseStatus
+	(ModerationAction)(0),         // 2: moderation.v1.ModerationAction
+	(*Report)(nil),                // 3: moderation.v1.Report
+	(*Case)(nil),                  // 4: moderation.v1.Case
+	(*FileReportRequest)(nil),     // 5: moderation.v1.FileReportRequest
+	(*FileReportResponse)(nil),    // 6: moderation.v1.FileReportResponse
+	(*ListCasesRequest)(nil),      // 7: moderation.v1.ListCasesRequest
+	(*ListCasesResponse)(nil),     // 8: moderation.v1.ListCasesResponse
+	(*GetCaseRequest)(nil),        // 9: moderation.v1.GetCaseRequest
+	(*GetCaseResponse)(nil),       // 10: moderation.v1.GetCaseResponse
+	(*ClaimCaseRequest)(nil),      // 11: moderation.v1.ClaimCaseRequest
+	(*ClaimCaseResponse)(nil),     // 12: moderation.v1.ClaimCaseResponse
+	(*ResolveCaseRequest)(nil),    // 13: moderation.v1.ResolveCaseRequest
+	(*ResolveCaseResponse)(nil),   // 14: moderation.v1.ResolveCaseResponse
+	(*timestamppb.Timestamp)(nil), // 15: google.protobuf.Timestamp
+}
+var file_moderation_v1_moderation_proto_depIdxs = []int32{
+	0,  // 0: moderation.v1.Report.category:type_name -> moderation.v1.ReportCategory
+	15, // 1: moderation.v1.Report.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 2: moderation.v1.Case.status:type_name -> moderation.v1.CaseStatus
+	3,  // 3: moderation.v1.Case.reports:type_name -> moderation.v1.Report
+	2,  // 4: moderation.v1.Case.action:type_name -> moderation.v1.ModerationAction
+	15, // 5: moderation.v1.Case.created_at:type_name -> google.protobuf.Timestamp
+	15, // 6: moderation.v1.Case.updated_at:type_name -> google.protobuf.Timestamp
+	15, // 7: moderation.v1.Case.resolved_at:type_name -> google.protobuf.Timestamp
+	0,  // 8: moderation.v1.FileReportRequest.category:type_name -> moderation.v1.ReportCategory
+	1,  // 9: moderation.v1.ListCasesRequest.status:type_name -> moderation.v1.CaseStatus
+	4,  // 10: moderation.v1.ListCasesResponse.cases:type_name -> moderation.v1.Case
+	4,  // 11: moderation.v1.GetCaseResponse.case:type_name -> moderation.v1.Case
+	4,  // 12: moderation.v1.ClaimCaseResponse.case:type_name -> moderation.v1.Case
+	2,  // 13: moderation.v1.ResolveCaseRequest.action:type_name -> moderation.v1.ModerationAction
+	4,  // 14: moderation.v1.ResolveCaseResponse.case:type_name -> moderation.v1.Case
+	5,  // 15: moderation.v1.ModerationService.FileReport:input_type -> moderation.v1.FileReportRequest
+	7,  // 16: moderation.v1.ModerationService.ListCases:input_type -> moderation.v1.ListCasesRequest
+	9,  // 17: moderation.v1.ModerationService.GetCase:input_type -> moderation.v1.GetCaseRequest
+	11, // 18: moderation.v1.ModerationService.ClaimCase:input_type -> moderation.v1.ClaimCaseRequest
+	13, // 19: moderation.v1.ModerationService.ResolveCase:input_type -> moderation.v1.ResolveCaseRequest
+	6,  // 20: moderation.v1.ModerationService.FileReport:output_type -> moderation.v1.FileReportResponse
+	8,  // 21: moderation.v1.ModerationService.ListCases:output_type -> moderation.v1.ListCasesResponse
+	10, // 22: moderation.v1.ModerationService.GetCase:output_type -> moderation.v1.GetCaseResponse
+	12, // 23: moderation.v1.ModerationService.ClaimCase:output_type -> moderation.v1.ClaimCaseResponse
+	14, // 24: moderation.v1.ModerationService.ResolveCase:output_type -> moderation.v1.ResolveCaseResponse
+	20, // [20:25] is the sub-list for method output_type
+	15, // [15:20] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_moderation_v1_moderation_proto_init() }
+func file_moderation_v1_moderation_proto_init() {
+	if File_moderation_v1_moderation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_moderation_v1_moderation_proto_rawDesc), len(file_moderation_v1_moderation_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_moderation_v1_moderation_proto_goTypes,
+		DependencyIndexes: file_moderation_v1_moderation_proto_depIdxs,
+		EnumInfos:         file_moderation_v1_moderation_proto_enumTypes,
+		MessageInfos:      file_moderation_v1_moderation_proto_msgTypes,
+	}.Build()
+	File_moderation_v1_moderation_proto = out.File
+	file_moderation_v1_moderation_proto_goTypes = nil
+	file_moderation_v1_moderation_proto_depIdxs = nil
+}
@@ -0,0 +1,273 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.33.1
+// source: moderation/v1/moderation.proto
+
+package moderationv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ModerationService_FileReport_FullMethodName  = "/moderation.v1.ModerationService/FileReport"
+	ModerationService_ListCases_FullMethodName   = "/moderation.v1.ModerationService/ListCases"
+	ModerationService_GetCase_FullMethodName     = "/moderation.v1.ModerationService/GetCase"
+	ModerationService_ClaimCase_FullMethodName   = "/moderation.v1.ModerationService/ClaimCase"
+	ModerationService_ResolveCase_FullMethodName = "/moderation.v1.ModerationService/ResolveCase"
+)
+
+// ModerationServiceClient is the client API for ModerationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ModerationServiceClient interface {
+	FileReport(ctx context.Context, in *FileReportRequest, opts ...grpc.CallOption) (*FileReportResponse, error)
+	ListCases(ctx context.Context, in *ListCasesRequest, opts ...grpc.CallOption) (*ListCasesResponse, error)
+	GetCase(ctx context.Context, in *GetCaseRequest, opts ...grpc.CallOption) (*GetCaseResponse, error)
+	ClaimCase(ctx context.Context, in *ClaimCaseRequest, opts ...grpc.CallOption) (*ClaimCaseResponse, error)
+	ResolveCase(ctx context.Context, in *ResolveCaseRequest, opts ...grpc.CallOption) (*ResolveCaseResponse, error)
+}
+
+type moderationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewModerationServiceClient(cc grpc.ClientConnInterface) ModerationServiceClient {
+	return &moderationServiceClient{cc}
+}
+
+func (c *moderationServiceClient) FileReport(ctx context.Context, in *FileReportRequest, opts ...grpc.CallOption) (*FileReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileReportResponse)
+	err := c.cc.Invoke(ctx, ModerationService_FileReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) ListCases(ctx context.Context, in *ListCasesRequest, opts ...grpc.CallOption) (*ListCasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCasesResponse)
+	err := c.cc.Invoke(ctx, ModerationService_ListCases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) GetCase(ctx context.Context, in *GetCaseRequest, opts ...grpc.CallOption) (*GetCaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCaseResponse)
+	err := c.cc.Invoke(ctx, ModerationService_GetCase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) ClaimCase(ctx context.Context, in *ClaimCaseRequest, opts ...grpc.CallOption) (*ClaimCaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimCaseResponse)
+	err := c.cc.Invoke(ctx, ModerationService_ClaimCase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) ResolveCase(ctx context.Context, in *ResolveCaseRequest, opts ...grpc.CallOption) (*ResolveCaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveCaseResponse)
+	err := c.cc.Invoke(ctx, ModerationService_ResolveCase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ModerationServiceServer is the server API for ModerationService service.
+// All implementations must embed UnimplementedModerationServiceServer
+// for forward compatibility.
+type ModerationServiceServer interface {
+	FileReport(context.Context, *FileReportRequest) (*FileReportResponse, error)
+	ListCases(context.Context, *ListCasesRequest) (*ListCasesResponse, error)
+	GetCase(context.Context, *GetCaseRequest) (*GetCaseResponse, error)
+	ClaimCase(context.Context, *ClaimCaseRequest) (*ClaimCaseResponse, error)
+	ResolveCase(context.Context, *ResolveCaseRequest) (*ResolveCaseResponse, error)
+	mustEmbedUnimplementedModerationServiceServer()
+}
+
+// UnimplementedModerationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedModerationServiceServer struct{}
+
+func (UnimplementedModerationServiceServer) FileReport(context.Context, *FileReportRequest) (*FileReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FileReport not implemented")
+}
+func (UnimplementedModerationServiceServer) ListCases(context.Context, *ListCasesRequest) (*ListCasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCases not implemented")
+}
+func (UnimplementedModerationServiceServer) GetCase(context.Context, *GetCaseRequest) (*GetCaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCase not implemented")
+}
+func (UnimplementedModerationServiceServer) ClaimCase(context.Context, *ClaimCaseRequest) (*ClaimCaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimCase not implemented")
+}
+func (UnimplementedModerationServiceServer) ResolveCase(context.Context, *ResolveCaseRequest) (*ResolveCaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveCase not implemented")
+}
+func (UnimplementedModerationServiceServer) mustEmbedUnimplementedModerationServiceServer() {}
+func (UnimplementedModerationServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeModerationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ModerationServiceServer will
+// result in compilation errors.
+type UnsafeModerationServiceServer interface {
+	mustEmbedUnimplementedModerationServiceServer()
+}
+
+func RegisterModerationServiceServer(s grpc.ServiceRegistrar, srv ModerationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedModerationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ModerationService_ServiceDesc, srv)
+}
+
+func _ModerationService_FileReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).FileReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_FileReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).FileReport(ctx, req.(*FileReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_ListCases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ListCases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_ListCases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ListCases(ctx, req.(*ListCasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_GetCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).GetCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_GetCase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).GetCase(ctx, req.(*GetCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_ClaimCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ClaimCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_ClaimCase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ClaimCase(ctx, req.(*ClaimCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_ResolveCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ResolveCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_ResolveCase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ResolveCase(ctx, req.(*ResolveCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ModerationService_ServiceDesc is the grpc.ServiceDesc for ModerationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ModerationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "moderation.v1.ModerationService",
+	HandlerType: (*ModerationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FileReport",
+			Handler:    _ModerationService_FileReport_Handler,
+		},
+		{
+			MethodName: "ListCases",
+			Handler:    _ModerationService_ListCases_Handler,
+		},
+		{
+			MethodName: "GetCase",
+			Handler:    _ModerationService_GetCase_Handler,
+		},
+		{
+			MethodName: "ClaimCase",
+			Handler:    _ModerationService_ClaimCase_Handler,
+		},
+		{
+			MethodName: "ResolveCase",
+			Handler:    _ModerationService_ResolveCase_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "moderation/v1/moderation.proto",
+}
@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: validate/v1/validate.proto
+
+package validatev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Constraints on a request field, checked by the shared gRPC validation
+// interceptor before the request reaches its handler. Empty optional
+// fields are not checked; string rules apply to each element of a
+// repeated field.
+type FieldRules struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Strings and repeated fields must not be empty; messages must be set.
+	Required bool `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"`
+	// Minimum length of a string, in characters.
+	MinLen uint32 `protobuf:"varint,2,opt,name=min_len,json=minLen,proto3" json:"min_len,omitempty"`
+	// Maximum length of a string in characters, or of a repeated field in elements.
+	MaxLen uint32 `protobuf:"varint,3,opt,name=max_len,json=maxLen,proto3" json:"max_len,omitempty"`
+	// The string must be a UUID in its canonical form.
+	Uuid bool `protobuf:"varint,4,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Inclusive lower bound of an integer.
+	Gte *int64 `protobuf:"varint,5,opt,name=gte,proto3,oneof" json:"gte,omitempty"`
+	// Inclusive upper bound of an integer.
+	Lte           *int64 `protobuf:"varint,6,opt,name=lte,proto3,oneof" json:"lte,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldRules) Reset() {
+	*x = FieldRules{}
+	mi := &file_validate_v1_validate_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldRules) ProtoMessage() {}
+
+func (x *FieldRules) ProtoReflect() protoreflect.Message {
+	mi := &file_validate_v1_validate_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldRules.ProtoReflect.Descriptor instead.
+func (*FieldRules) Descriptor() ([]byte, []int) {
+	return file_validate_v1_validate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FieldRules) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *FieldRules) GetMinLen() uint32 {
+	if x != nil {
+		return x.MinLen
+	}
+	return 0
+}
+
+func (x *FieldRules) GetMaxLen() uint32 {
+	if x != nil {
+		return x.MaxLen
+	}
+	return 0
+}
+
+func (x *FieldRules) GetUuid() bool {
+	if x != nil {
+		return x.Uuid
+	}
+	return false
+}
+
+func (x *FieldRules) GetGte() int64 {
+	if x != nil && x.Gte != nil {
+		return *x.Gte
+	}
+	return 0
+}
+
+func (x *FieldRules) GetLte() int64 {
+	if x != nil && x.Lte != nil {
+		return *x.Lte
+	}
+	return 0
+}
+
+var file_validate_v1_validate_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*FieldRules)(nil),
+		Field:         51000,
+		Name:          "validate.v1.field",
+		Tag:           "bytes,51000,opt,name=field",
+		Filename:      "validate/v1/validate.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// Number taken from the range reserved for an organisation's own options.
+	//
+	// optional validate.v1.FieldRules field = 51000;
+	E_Field = &file_validate_v1_validate_proto_extTypes[0]
+)
+
+var File_validate_v1_validate_proto protoreflect.FileDescriptor
+
+const file_validate_v1_validate_proto_rawDesc = "" +
+	"\n" +
+	"\x1avalidate/v1/validate.proto\x12\vvalidate.v1\x1a google/protobuf/descriptor.proto\"\xac\x01\n" +
+	"\n" +
+	"FieldRules\x12\x1a\n" +
+	"\brequired\x18\x01 \x01(\bR\brequired\x12\x17\n" +
+	"\amin_len\x18\x02 \x01(\rR\x06minLen\x12\x17\n" +
+	"\amax_len\x18\x03 \x01(\rR\x06maxLen\x12\x12\n" +
+	"\x04uuid\x18\x04 \x01(\bR\x04uuid\x12\x15\n" +
+	"\x03gte\x18\x05 \x01(\x03H\x00R\x03gte\x88\x01\x01\x12\x15\n" +
+	"\x03lte\x18\x06 \x01(\x03H\x01R\x03lte\x88\x01\x01B\x06\n" +
+	"\x04_gteB\x06\n" +
+	"\x04_lte:N\n" +
+	"\x05field\x12\x1d.google.protobuf.FieldOptions\x18\xb8\x8e\x03 \x01(\v2\x17.validate.v1.FieldRulesR\x05fieldBNZLgithub.com/mattuttis/inetcontrol/zoekdeware/api/proto/validate/v1;validatev1b\x06proto3"
+
+var (
+	file_validate_v1_validate_proto_rawDescOnce sync.Once
+	file_validate_v1_validate_proto_rawDescData []byte
+)
+
+func file_validate_v1_validate_proto_rawDescGZIP() []byte {
+	file_validate_v1_validate_proto_rawDescOnce.Do(func() {
+		file_validate_v1_validate_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_validate_v1_validate_proto_rawDesc), len(file_validate_v1_validate_proto_rawDesc)))
+	})
+	return file_validate_v1_validate_proto_rawDescData
+}
+
+var file_validate_v1_validate_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_validate_v1_validate_proto_goTypes = []any{
+	(*FieldRules)(nil),                // 0: validate.v1.FieldRules
+	(*descriptorpb.FieldOptions)(nil), // 1: google.protobuf.FieldOptions
+}
+var file_validate_v1_validate_proto_depIdxs = []int32{
+	1, // 0: validate.v1.field:extendee -> google.protobuf.FieldOptions
+	0, // 1: validate.v1.field:type_name -> validate.v1.FieldRules
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	1, // [1:2] is the sub-list for extension type_name
+	0, // [0:1] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_validate_v1_validate_proto_init() }
+func file_validate_v1_validate_proto_init() {
+	if File_validate_v1_validate_proto != nil {
+		return
+	}
+	file_validate_v1_validate_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_validate_v1_validate_proto_rawDesc), len(file_validate_v1_validate_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_validate_v1_validate_proto_goTypes,
+		DependencyIndexes: file_validate_v1_validate_proto_depIdxs,
+		MessageInfos:      file_validate_v1_validate_proto_msgTypes,
+		ExtensionInfos:    file_validate_v1_validate_proto_extTypes,
+	}.Build()
+	File_validate_v1_validate_proto = out.File
+	file_validate_v1_validate_proto_goTypes = nil
+	file_validate_v1_validate_proto_depIdxs = nil
+}
@@ -10,21 +10,68 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
+	experimentsv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/experiments/v1"
+	featureflagsv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/featureflags/v1"
+	mediav1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/media/v1"
 	memberv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/member/v1"
+	moderationv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/moderation/v1"
+	notificationv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/notification/v1"
 	"github.com/mattuttis/inetcontrol/zoekdeware/backend/gateway/internal/config"
 	"github.com/mattuttis/inetcontrol/zoekdeware/backend/gateway/internal/handlers"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/gateway/internal/idempotency"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/gateway/internal/middleware"
 	"github.com/mattuttis/inetcontrol/zoekdeware/backend/gateway/internal/router"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/gateway/internal/sessions"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/experiments"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/featureflags"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/grpcclient"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/health"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/interceptors"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/logging"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/messaging"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/metrics"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/mtls"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/telemetry"
 )
 
+// memberReads are the methods served by the member service that change
+// nothing, so they are safe to retry and hedge. Admin reads are left out,
+// since each call is recorded in the audit log.
+var memberReads = []string{
+	memberv1.MemberService_GetMember_FullMethodName,
+	memberv1.MemberService_CheckBlocked_FullMethodName,
+	memberv1.MemberService_ListBlockedMembers_FullMethodName,
+	memberv1.MemberService_ListInterests_FullMethodName,
+	memberv1.MemberService_ListPrompts_FullMethodName,
+	memberv1.MemberService_GetPreferences_FullMethodName,
+	memberv1.MemberService_ListSessionRevocations_FullMethodName,
+	featureflagsv1.FeatureFlagService_ListFlags_FullMethodName,
+	experimentsv1.ExperimentService_ListExperiments_FullMethodName,
+}
+
 func main() {
 	cfg := config.Load()
+	logging.Setup("gateway")
 
-	// Connect to member service
-	memberConn, err := grpc.NewClient(
-		cfg.MemberServiceAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	shutdownTracing, err := telemetry.Setup(context.Background(), "gateway")
+	if err != nil {
+		log.Fatalf("failed to set up tracing: %v", err)
+	}
+
+	// Connections to internal services use mTLS when GRPC_TLS_* is set
+	transportCreds, err := mtls.ClientCredentials(cfg.TLS)
+	if err != nil {
+		log.Fatalf("failed to load TLS credentials: %v", err)
+	}
+
+	// Connect to member service. Reads are retried and hedged across its
+	// replicas; writes are sent once.
+	memberConn, err := grpcclient.New("member", cfg.MemberServiceAddr,
+		grpcclient.WithTransportCredentials(transportCreds),
+		grpcclient.WithRetry(memberReads...),
+		grpcclient.WithHedging(cfg.HedgeDelay, memberReads...),
+		grpcclient.WithDialOptions(grpc.WithPerRPCCredentials(interceptors.ServiceToken(cfg.ServiceToken))),
 	)
 	if err != nil {
 		log.Fatalf("failed to connect to member service: %v", err)
@@ -32,12 +79,134 @@ func main() {
 	defer memberConn.Close()
 
 	memberClient := memberv1.NewMemberServiceClient(memberConn)
+	adminClient := memberv1.NewMemberAdminServiceClient(memberConn)
+
+	// Connect to media service. Uploads are forwarded whole, so the send
+	// limit must cover the largest accepted photo.
+	mediaConn, err := grpcclient.New("media", cfg.MediaServiceAddr,
+		grpcclient.WithTransportCredentials(transportCreds),
+		grpcclient.WithRetry(mediav1.MediaService_GetPhoto_FullMethodName, mediav1.MediaService_ListPhotos_FullMethodName),
+		grpcclient.WithDialOptions(
+			grpc.WithPerRPCCredentials(interceptors.ServiceToken(cfg.ServiceToken)),
+			grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(handlers.MaxUploadSize+1<<20)),
+		),
+	)
+	if err != nil {
+		log.Fatalf("failed to connect to media service: %v", err)
+	}
+	defer mediaConn.Close()
+
+	mediaClient := mediav1.NewMediaServiceClient(mediaConn)
+
+	// Connect to notification service
+	notificationConn, err := grpcclient.New("notification", cfg.NotificationServiceAddr,
+		grpcclient.WithTransportCredentials(transportCreds),
+		grpcclient.WithRetry(notificationv1.NotificationService_GetPreferences_FullMethodName),
+		grpcclient.WithDialOptions(grpc.WithPerRPCCredentials(interceptors.ServiceToken(cfg.ServiceToken))),
+	)
+	if err != nil {
+		log.Fatalf("failed to connect to notification service: %v", err)
+	}
+	defer notificationConn.Close()
+
+	notificationClient := notificationv1.NewNotificationServiceClient(notificationConn)
+
+	// Connect to moderation service
+	moderationConn, err := grpcclient.New("moderation", cfg.ModerationServiceAddr,
+		grpcclient.WithTransportCredentials(transportCreds),
+		grpcclient.WithRetry(moderationv1.ModerationService_ListCases_FullMethodName, moderationv1.ModerationService_GetCase_FullMethodName),
+		grpcclient.WithDialOptions(grpc.WithPerRPCCredentials(interceptors.ServiceToken(cfg.ServiceToken))),
+	)
+	if err != nil {
+		log.Fatalf("failed to connect to moderation service: %v", err)
+	}
+	defer moderationConn.Close()
+
+	moderationClient := moderationv1.NewModerationServiceClient(moderationConn)
+
+	// The gateway cannot serve without the member service, which handles
+	// sign-in and profiles. Without the broker flag and experiment changes
+	// only apply on the next refresh and exposures are not recorded.
+	checks := health.New()
+	checks.Add("member", health.GRPC(memberConn, ""))
+
+	var (
+		publisher  messaging.Publisher
+		subscriber messaging.BroadcastSubscriber
+	)
+	broker, err := messaging.NewNATSBroker(cfg.NATSURL, "gateway")
+	if err != nil {
+		log.Printf("warning: message broker not available, feature flags refresh every %s: %v", cfg.FlagRefreshInterval, err)
+		brokerErr := err
+		checks.AddNonCritical("nats", func(context.Context) error { return brokerErr })
+	} else {
+		defer broker.Close()
+		checks.AddNonCritical("nats", broker.Check)
+		publisher, subscriber = broker, broker
+	}
+
+	// Responses to requests with an Idempotency-Key are shared between
+	// instances through Redis when it is configured. While Redis is down
+	// requests run without the protection, so it does not fail readiness.
+	var responses idempotency.Store
+	if cfg.RedisURL == "" {
+		log.Printf("warning: REDIS_URL not set, idempotent responses are kept per instance")
+		responses = idempotency.NewMemoryStore()
+	} else {
+		redisStore, err := idempotency.NewRedisStore(cfg.RedisURL)
+		if err != nil {
+			log.Fatalf("failed to configure idempotency store: %v", err)
+		}
+		defer redisStore.Close()
+		checks.AddNonCritical("redis", redisStore.Check)
+		responses = redisStore
+	}
+
+	// Feature flags and experiments are copied from the member service,
+	// refreshed periodically and whenever a change is announced on the
+	// broker
+	flagsCtx, stopFlags := context.WithCancel(context.Background())
+	defer stopFlags()
+	flags := featureflags.NewClient(featureflags.GRPCSource(featureflagsv1.NewFeatureFlagServiceClient(memberConn)))
+	experimentClient := experiments.NewClient(experiments.GRPCSource(experimentsv1.NewExperimentServiceClient(memberConn)), publisher)
+	// Session revocations are copied the same way, so signing a member
+	// out everywhere takes effect on every instance
+	revocations := sessions.NewRevocations(memberClient, handlers.RefreshTokenLifetime)
+	go flags.Run(flagsCtx, cfg.FlagRefreshInterval)
+	go experimentClient.Run(flagsCtx, cfg.FlagRefreshInterval)
+	go revocations.Run(flagsCtx, cfg.FlagRefreshInterval)
+	if subscriber != nil {
+		if err := flags.Listen(flagsCtx, subscriber); err != nil {
+			log.Printf("warning: failed to subscribe to feature flag changes: %v", err)
+		}
+		if err := experimentClient.Listen(flagsCtx, subscriber); err != nil {
+			log.Printf("warning: failed to subscribe to experiment changes: %v", err)
+		}
+		if err := revocations.Listen(flagsCtx, subscriber); err != nil {
+			log.Printf("warning: failed to subscribe to session revocations: %v", err)
+		}
+	}
 
 	// Create handlers with gRPC clients
-	h := handlers.NewHandlers(memberClient, cfg.JWTSecret)
+	h := handlers.NewHandlers(memberClient, adminClient, mediaClient, notificationClient, moderationClient, flags, experimentClient, cfg.JWTSecret)
 
-	// Create router
-	r := router.New(cfg, h)
+	// Create router. Every route must be in the OpenAPI document; in
+	// development responses are checked against it too.
+	spec, err := middleware.LoadOpenAPI(cfg.OpenAPISpec, cfg.Environment == "development")
+	if err != nil {
+		log.Fatalf("failed to load OpenAPI document: %v", err)
+	}
+	memberSpec, err := middleware.LoadOpenAPI(cfg.MemberOpenAPISpec, cfg.Environment == "development")
+	if err != nil {
+		log.Fatalf("failed to load member OpenAPI document: %v", err)
+	}
+	// Member endpoints generated from member.proto are served beside the
+	// hand-written ones, which remain for existing app versions
+	members, err := h.MemberREST(context.Background())
+	if err != nil {
+		log.Fatalf("failed to set up member REST endpoints: %v", err)
+	}
+	r := router.New(cfg, h, checks, responses, spec, memberSpec, members, revocations)
 
 	server := &http.Server{
 		Addr:         cfg.HTTPAddr,
@@ -47,6 +216,23 @@ func main() {
 		IdleTimeout:  60 * time.Second,
 	}
 
+	// Metrics are served on their own port so they are not public
+	metricsMux := http.NewServeMux()
+	metricsMux.Handle("/metrics", metrics.Handler())
+	metricsServer := &http.Server{
+		Addr:         cfg.MetricsAddr,
+		Handler:      metricsMux,
+		ReadTimeout:  5 * time.Second,
+		WriteTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		log.Printf("Metrics listening on %s", cfg.MetricsAddr)
+		if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("failed to start metrics server: %v", err)
+		}
+	}()
+
 	go func() {
 		log.Printf("API Gateway listening on %s", cfg.HTTPAddr)
 		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -58,7 +244,11 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
+	// Fail readiness first and give load balancers time to notice before
+	// refusing new requests
 	log.Println("Shutting down server...")
+	checks.Shutdown()
+	time.Sleep(cfg.ShutdownDelay)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -66,6 +256,10 @@ func main() {
 	if err := server.Shutdown(ctx); err != nil {
 		log.Fatalf("server forced to shutdown: %v", err)
 	}
+	_ = metricsServer.Shutdown(ctx)
+	if err := shutdownTracing(ctx); err != nil {
+		log.Printf("failed to flush traces: %v", err)
+	}
 
 	log.Println("Server exited")
 }
@@ -1,38 +1,73 @@
 package config
 
-import "os"
+import (
+	"errors"
+	"time"
+
+	sharedconfig "github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/config"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/mtls"
+)
 
 type Config struct {
-	HTTPAddr    string
-	JWTSecret   string
-	Environment string
-
-	MemberServiceAddr       string
-	MatchingServiceAddr     string
-	MessagingServiceAddr    string
-	NotificationServiceAddr string
-	MediaServiceAddr        string
-	LocationServiceAddr     string
+	HTTPAddr    string `env:"HTTP_ADDR" default:":8000"`
+	MetricsAddr string `env:"METRICS_ADDR" default:":9100"`
+	JWTSecret   string `env:"JWT_SECRET" default:"change-me-in-production" insecure:"true" secret:"true"`
+	Environment string `env:"ENVIRONMENT" default:"development" oneof:"development,staging,production"`
+
+	// ShutdownDelay is how long the gateway reports not ready before it
+	// stops accepting requests
+	ShutdownDelay time.Duration `env:"SHUTDOWN_DELAY" default:"5s"`
+
+	// HedgeDelay is how long a read from the member service may take
+	// before a second copy is sent to another replica
+	HedgeDelay time.Duration `env:"HEDGE_DELAY" default:"100ms"`
+
+	NATSURL string `env:"NATS_URL" default:"nats://localhost:4222"`
+
+	// FlagRefreshInterval is how often feature flags and experiments are
+	// reloaded when no change notification arrives
+	FlagRefreshInterval time.Duration `env:"FLAG_REFRESH_INTERVAL" default:"30s"`
+
+	// OpenAPISpec is the OpenAPI document requests are validated against.
+	// The default finds it when the gateway runs from backend/gateway.
+	OpenAPISpec string `env:"OPENAPI_SPEC" default:"../../api/openapi/gateway.yaml"`
+	// MemberOpenAPISpec is the document generated from member.proto that
+	// the transcoded /api/v2 requests are validated against.
+	MemberOpenAPISpec string `env:"MEMBER_OPENAPI_SPEC" default:"../../api/openapi/member.swagger.yaml"`
+
+	// RedisURL points at the Redis-compatible server that keeps
+	// idempotent responses, shared by all gateway instances. Without it
+	// each instance keeps them in memory.
+	RedisURL string `env:"REDIS_URL" secret:"true"`
+	// IdempotencyTTL is how long a response is replayed for retries with
+	// the same Idempotency-Key
+	IdempotencyTTL time.Duration `env:"IDEMPOTENCY_TTL" default:"24h"`
+
+	// ServiceToken authenticates the gateway to the services it calls
+	ServiceToken string `env:"SERVICE_TOKEN" default:"dev-gateway-token" insecure:"true" secret:"true"`
+	TLS          mtls.Config
+
+	MemberServiceAddr       string `env:"MEMBER_SERVICE_ADDR" default:"localhost:9090"`
+	MatchingServiceAddr     string `env:"MATCHING_SERVICE_ADDR" default:"localhost:9091"`
+	MessagingServiceAddr    string `env:"MESSAGING_SERVICE_ADDR" default:"localhost:9092"`
+	NotificationServiceAddr string `env:"NOTIFICATION_SERVICE_ADDR" default:"localhost:9093"`
+	MediaServiceAddr        string `env:"MEDIA_SERVICE_ADDR" default:"localhost:9094"`
+	LocationServiceAddr     string `env:"LOCATION_SERVICE_ADDR" default:"localhost:9095"`
+	ModerationServiceAddr   string `env:"MODERATION_SERVICE_ADDR" default:"localhost:9096"`
 }
 
+// Load reads the configuration and exits if it is invalid.
 func Load() *Config {
-	return &Config{
-		HTTPAddr:    getEnv("HTTP_ADDR", ":8000"),
-		JWTSecret:   getEnv("JWT_SECRET", "change-me-in-production"),
-		Environment: getEnv("ENVIRONMENT", "development"),
-
-		MemberServiceAddr:       getEnv("MEMBER_SERVICE_ADDR", "localhost:9090"),
-		MatchingServiceAddr:     getEnv("MATCHING_SERVICE_ADDR", "localhost:9091"),
-		MessagingServiceAddr:    getEnv("MESSAGING_SERVICE_ADDR", "localhost:9092"),
-		NotificationServiceAddr: getEnv("NOTIFICATION_SERVICE_ADDR", "localhost:9093"),
-		MediaServiceAddr:        getEnv("MEDIA_SERVICE_ADDR", "localhost:9094"),
-		LocationServiceAddr:     getEnv("LOCATION_SERVICE_ADDR", "localhost:9095"),
-	}
+	var cfg Config
+	sharedconfig.MustLoad(&cfg)
+	return &cfg
 }
 
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+// Validate rejects secrets too short to sign tokens safely outside
+// development.
+func (c *Config) Validate() error {
+	if c.Environment != "development" && len(c.JWTSecret) < 32 {
+		return errors.New("JWT_SECRET: must be at least 32 characters outside development")
 	}
-	return defaultValue
+	return nil
 }
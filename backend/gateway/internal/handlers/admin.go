@@ -0,0 +1,401 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	memberv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/member/v1"
+)
+
+// AdminMember is a member as staff see them in the admin API.
+type AdminMember struct {
+	ID                string     `json:"id"`
+	Email             string     `json:"email"`
+	DisplayName       string     `json:"display_name,omitempty"`
+	Gender            string     `json:"gender,omitempty"`
+	BirthDate         string     `json:"birth_date,omitempty"`
+	Status            string     `json:"status"`
+	EmailVerified     bool       `json:"email_verified"`
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
+	Roles             []string   `json:"roles"`
+	SessionsRevokedAt *time.Time `json:"sessions_revoked_at,omitempty"`
+}
+
+// AdminMembersResponse is a page of the members found by a search.
+type AdminMembersResponse struct {
+	Members       []AdminMember `json:"members"`
+	NextPageToken string        `json:"next_page_token,omitempty"`
+}
+
+// AdminReasonRequest is the body of admin actions that must be explained,
+// such as suspending a member.
+type AdminReasonRequest struct {
+	Reason string `json:"reason"`
+}
+
+// AdminRolesRequest replaces a member's staff roles.
+type AdminRolesRequest struct {
+	Roles []string `json:"roles"`
+}
+
+// AdminRolesResponse lists a member's staff roles.
+type AdminRolesResponse struct {
+	Roles []string `json:"roles"`
+}
+
+// SessionsRevokedResponse says from when the member's tokens are rejected.
+type SessionsRevokedResponse struct {
+	RevokedAt time.Time `json:"revoked_at"`
+}
+
+// MemberHistoryResponse is a page of a member's timeline, oldest first.
+type MemberHistoryResponse struct {
+	Entries       []MemberHistoryEntry `json:"entries"`
+	NextPageToken string               `json:"next_page_token,omitempty"`
+}
+
+// MemberHistoryEntry is one event in a member's timeline.
+type MemberHistoryEntry struct {
+	Version    int32           `json:"version"`
+	EventType  string          `json:"event_type"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Summary    string          `json:"summary"`
+	Details    []HistoryDetail `json:"details"`
+	RequestID  string          `json:"request_id,omitempty"`
+	UserID     string          `json:"user_id,omitempty"`
+}
+
+// HistoryDetail is a labelled value of a history entry.
+type HistoryDetail struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// AuditLogResponse is a page of the audit log, newest first.
+type AuditLogResponse struct {
+	Entries       []AuditLogEntry `json:"entries"`
+	NextPageToken string          `json:"next_page_token,omitempty"`
+}
+
+// AuditLogEntry is one recorded admin call.
+type AuditLogEntry struct {
+	ID         int64           `json:"id"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	ActorID    string          `json:"actor_id,omitempty"`
+	Caller     string          `json:"caller"`
+	Method     string          `json:"method"`
+	MemberID   string          `json:"member_id,omitempty"`
+	Request    json.RawMessage `json:"request"`
+	Code       string          `json:"code"`
+	RequestID  string          `json:"request_id,omitempty"`
+}
+
+func (h *Handlers) AdminSearchMembers(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	pageSize, _ := strconv.Atoi(query.Get("page_size"))
+	minAge, _ := strconv.Atoi(query.Get("min_age"))
+	maxAge, _ := strconv.Atoi(query.Get("max_age"))
+
+	req := &memberv1.SearchMembersRequest{
+		Query:     query.Get("q"),
+		MinAge:    int32(minAge),
+		MaxAge:    int32(maxAge),
+		Sort:      parseMemberSort(query.Get("sort")),
+		PageSize:  int32(pageSize),
+		PageToken: query.Get("page_token"),
+	}
+	for _, s := range query["status"] {
+		status, ok := parseMemberStatus(s)
+		if !ok {
+			writeError(w, http.StatusBadRequest, "invalid status")
+			return
+		}
+		req.Statuses = append(req.Statuses, status)
+	}
+	for _, g := range query["gender"] {
+		gender, ok := parseGender(g)
+		if !ok {
+			writeError(w, http.StatusBadRequest, "invalid gender")
+			return
+		}
+		req.Genders = append(req.Genders, gender)
+	}
+	var ok bool
+	if req.CreatedAfter, ok = parseQueryTime(query.Get("created_after")); !ok {
+		writeError(w, http.StatusBadRequest, "invalid created_after")
+		return
+	}
+	if req.CreatedBefore, ok = parseQueryTime(query.Get("created_before")); !ok {
+		writeError(w, http.StatusBadRequest, "invalid created_before")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.adminClient.SearchMembers(ctx, req)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	members := make([]AdminMember, len(resp.Members))
+	for i, details := range resp.Members {
+		members[i] = toAdminMember(details.Member, details.Roles)
+		if details.SessionsRevokedAt != nil {
+			revokedAt := details.SessionsRevokedAt.AsTime()
+			members[i].SessionsRevokedAt = &revokedAt
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(AdminMembersResponse{Members: members, NextPageToken: resp.NextPageToken})
+}
+
+func (h *Handlers) AdminGetMemberHistory(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	pageSize, _ := strconv.Atoi(query.Get("page_size"))
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.adminClient.GetMemberHistory(ctx, &memberv1.GetMemberHistoryRequest{
+		MemberId:  mux.Vars(r)["id"],
+		PageSize:  int32(pageSize),
+		PageToken: query.Get("page_token"),
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	entries := make([]MemberHistoryEntry, len(resp.Entries))
+	for i, e := range resp.Entries {
+		details := make([]HistoryDetail, len(e.Details))
+		for j, d := range e.Details {
+			details[j] = HistoryDetail{Name: d.Name, Value: d.Value}
+		}
+		entries[i] = MemberHistoryEntry{
+			Version:    e.Version,
+			EventType:  e.EventType,
+			OccurredAt: e.OccurredAt.AsTime(),
+			Summary:    e.Summary,
+			Details:    details,
+			RequestID:  e.GetMetadata().GetCorrelationId(),
+			UserID:     e.GetMetadata().GetUserId(),
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(MemberHistoryResponse{Entries: entries, NextPageToken: resp.NextPageToken})
+}
+
+func (h *Handlers) AdminSuspendMember(w http.ResponseWriter, r *http.Request) {
+	var req AdminReasonRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.adminClient.SuspendMember(ctx, &memberv1.SuspendMemberRequest{
+		MemberId: mux.Vars(r)["id"],
+		Reason:   req.Reason,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(toAdminMember(resp.Member, nil))
+}
+
+func (h *Handlers) AdminReinstateMember(w http.ResponseWriter, r *http.Request) {
+	var req AdminReasonRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.adminClient.ReinstateMember(ctx, &memberv1.ReinstateMemberRequest{
+		MemberId: mux.Vars(r)["id"],
+		Reason:   req.Reason,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(toAdminMember(resp.Member, nil))
+}
+
+func (h *Handlers) AdminRevokeSessions(w http.ResponseWriter, r *http.Request) {
+	var req AdminReasonRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.adminClient.RevokeSessions(ctx, &memberv1.RevokeSessionsRequest{
+		MemberId: mux.Vars(r)["id"],
+		Reason:   req.Reason,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(SessionsRevokedResponse{RevokedAt: resp.RevokedAt.AsTime()})
+}
+
+func (h *Handlers) AdminSetMemberRoles(w http.ResponseWriter, r *http.Request) {
+	var req AdminRolesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.adminClient.SetMemberRoles(ctx, &memberv1.SetMemberRolesRequest{
+		MemberId: mux.Vars(r)["id"],
+		Roles:    req.Roles,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	roles := resp.Roles
+	if roles == nil {
+		roles = []string{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(AdminRolesResponse{Roles: roles})
+}
+
+func (h *Handlers) AdminListAuditLog(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	pageSize, _ := strconv.Atoi(query.Get("page_size"))
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.adminClient.ListAuditLog(ctx, &memberv1.ListAuditLogRequest{
+		MemberId:  query.Get("member_id"),
+		ActorId:   query.Get("actor_id"),
+		PageSize:  int32(pageSize),
+		PageToken: query.Get("page_token"),
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	entries := make([]AuditLogEntry, len(resp.Entries))
+	for i, e := range resp.Entries {
+		entries[i] = AuditLogEntry{
+			ID:         e.Id,
+			OccurredAt: e.OccurredAt.AsTime(),
+			ActorID:    e.ActorId,
+			Caller:     e.Caller,
+			Method:     e.Method,
+			MemberID:   e.MemberId,
+			Request:    json.RawMessage(e.Request),
+			Code:       e.Code,
+			RequestID:  e.RequestId,
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(AuditLogResponse{Entries: entries, NextPageToken: resp.NextPageToken})
+}
+
+func toAdminMember(m *memberv1.Member, roles []string) AdminMember {
+	if roles == nil {
+		roles = []string{}
+	}
+	member := AdminMember{
+		ID:            m.GetId(),
+		Email:         m.GetEmail(),
+		DisplayName:   m.GetProfile().GetDisplayName(),
+		Gender:        formatGender(m.GetProfile().GetGender()),
+		Status:        formatMemberStatus(m.GetStatus()),
+		EmailVerified: m.GetEmailVerified(),
+		Roles:         roles,
+	}
+	if m.GetCreatedAt() != nil {
+		createdAt := m.GetCreatedAt().AsTime()
+		member.CreatedAt = &createdAt
+	}
+	if birthDate := m.GetProfile().GetBirthDate(); birthDate != nil {
+		member.BirthDate = birthDate.AsTime().Format(time.DateOnly)
+	}
+	return member
+}
+
+func formatMemberStatus(s memberv1.MemberStatus) string {
+	return strings.ToLower(strings.TrimPrefix(s.String(), "MEMBER_STATUS_"))
+}
+
+// parseMemberStatus converts the JSON member status to its protobuf enum.
+func parseMemberStatus(s string) (memberv1.MemberStatus, bool) {
+	switch s {
+	case "pending":
+		return memberv1.MemberStatus_MEMBER_STATUS_PENDING, true
+	case "active":
+		return memberv1.MemberStatus_MEMBER_STATUS_ACTIVE, true
+	case "suspended":
+		return memberv1.MemberStatus_MEMBER_STATUS_SUSPENDED, true
+	default:
+		return memberv1.MemberStatus_MEMBER_STATUS_UNSPECIFIED, false
+	}
+}
+
+// parseQueryTime parses an optional RFC 3339 query parameter; an empty
+// value is nil.
+func parseQueryTime(v string) (*timestamppb.Timestamp, bool) {
+	if v == "" {
+		return nil, true
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, false
+	}
+	return timestamppb.New(t), true
+}
+
+// parseMemberSort converts the sort query parameter to its protobuf enum;
+// anything else sorts newest first.
+func parseMemberSort(s string) memberv1.MemberSort {
+	switch s {
+	case "oldest":
+		return memberv1.MemberSort_MEMBER_SORT_OLDEST
+	case "email":
+		return memberv1.MemberSort_MEMBER_SORT_EMAIL
+	case "display_name":
+		return memberv1.MemberSort_MEMBER_SORT_DISPLAY_NAME
+	default:
+		return memberv1.MemberSort_MEMBER_SORT_NEWEST
+	}
+}
@@ -3,28 +3,55 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/gorilla/mux"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	mediav1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/media/v1"
 	memberv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/member/v1"
+	moderationv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/moderation/v1"
+	notificationv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/notification/v1"
 	"github.com/mattuttis/inetcontrol/zoekdeware/backend/gateway/internal/middleware"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/auth"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/experiments"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/featureflags"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/grpcclient"
 )
 
+// MaxUploadSize is the largest photo upload accepted by the gateway.
+const MaxUploadSize = 10 << 20
+
 // Handlers holds the gRPC clients for all services.
 type Handlers struct {
-	memberClient memberv1.MemberServiceClient
-	jwtSecret    string
+	memberClient       memberv1.MemberServiceClient
+	adminClient        memberv1.MemberAdminServiceClient
+	mediaClient        mediav1.MediaServiceClient
+	notificationClient notificationv1.NotificationServiceClient
+	moderationClient   moderationv1.ModerationServiceClient
+	flags              *featureflags.Client
+	experiments        *experiments.Client
+	jwtSecret          string
 }
 
 // NewHandlers creates a new Handlers instance with the given gRPC clients.
-func NewHandlers(memberClient memberv1.MemberServiceClient, jwtSecret string) *Handlers {
+func NewHandlers(memberClient memberv1.MemberServiceClient, adminClient memberv1.MemberAdminServiceClient, mediaClient mediav1.MediaServiceClient, notificationClient notificationv1.NotificationServiceClient, moderationClient moderationv1.ModerationServiceClient, flags *featureflags.Client, experimentClient *experiments.Client, jwtSecret string) *Handlers {
 	return &Handlers{
-		memberClient: memberClient,
-		jwtSecret:    jwtSecret,
+		memberClient:       memberClient,
+		adminClient:        adminClient,
+		mediaClient:        mediaClient,
+		notificationClient: notificationClient,
+		moderationClient:   moderationClient,
+		flags:              flags,
+		experiments:        experimentClient,
+		jwtSecret:          jwtSecret,
 	}
 }
 
@@ -41,15 +68,6 @@ type AuthResponse struct {
 	ExpiresAt    int64  `json:"expires_at"`
 }
 
-// ErrorResponse represents a JSON error response.
-type ErrorResponse struct {
-	Error string `json:"error"`
-}
-
-func (h *Handlers) Health(w http.ResponseWriter, r *http.Request) {
-	_ = json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
-}
-
 func (h *Handlers) Register(w http.ResponseWriter, r *http.Request) {
 	var req RegisterRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -75,7 +93,7 @@ func (h *Handlers) Register(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Generate JWT tokens
-	authResp, err := h.generateTokens(resp.Member.Id)
+	authResp, err := h.generateTokens(resp.Member.Id, nil)
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, "failed to generate tokens")
 		return
@@ -86,8 +104,13 @@ func (h *Handlers) Register(w http.ResponseWriter, r *http.Request) {
 	_ = json.NewEncoder(w).Encode(authResp)
 }
 
-// generateTokens creates access and refresh tokens for a user.
-func (h *Handlers) generateTokens(userID string) (*AuthResponse, error) {
+// RefreshTokenLifetime is how long the longest-lived token is valid. Session
+// revocations are kept for as long.
+const RefreshTokenLifetime = 7 * 24 * time.Hour
+
+// generateTokens creates access and refresh tokens for a user. Staff get
+// their roles and the scopes these grant in the access token.
+func (h *Handlers) generateTokens(userID string, roles []string) (*AuthResponse, error) {
 	now := time.Now()
 	expiresAt := now.Add(24 * time.Hour) // Access token expires in 24 hours
 
@@ -97,6 +120,10 @@ func (h *Handlers) generateTokens(userID string) (*AuthResponse, error) {
 		"iat": now.Unix(),
 		"exp": expiresAt.Unix(),
 	}
+	if len(roles) > 0 {
+		accessClaims["roles"] = roles
+		accessClaims["scope"] = strings.Join(auth.ScopesFor(roles), " ")
+	}
 	accessToken := jwt.NewWithClaims(jwt.SigningMethodHS256, accessClaims)
 	accessTokenString, err := accessToken.SignedString([]byte(h.jwtSecret))
 	if err != nil {
@@ -104,12 +131,12 @@ func (h *Handlers) generateTokens(userID string) (*AuthResponse, error) {
 	}
 
 	// Create refresh token (longer expiry)
-	refreshExpiresAt := now.Add(7 * 24 * time.Hour) // Refresh token expires in 7 days
+	refreshExpiresAt := now.Add(RefreshTokenLifetime)
 	refreshClaims := jwt.MapClaims{
 		"sub":  userID,
 		"iat":  now.Unix(),
 		"exp":  refreshExpiresAt.Unix(),
-		"type": "refresh",
+		"type": auth.TokenTypeRefresh,
 	}
 	refreshToken := jwt.NewWithClaims(jwt.SigningMethodHS256, refreshClaims)
 	refreshTokenString, err := refreshToken.SignedString([]byte(h.jwtSecret))
@@ -154,12 +181,15 @@ func (h *Handlers) Login(w http.ResponseWriter, r *http.Request) {
 		Password: req.Password,
 	})
 	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			middleware.RecordAuthFailure(middleware.AuthFailureInvalidCredentials)
+		}
 		handleGRPCError(w, err)
 		return
 	}
 
-	// Generate JWT tokens
-	authResp, err := h.generateTokens(resp.Member.Id)
+	// Generate JWT tokens, carrying staff roles and the scopes they grant
+	authResp, err := h.generateTokens(resp.Member.Id, resp.Roles)
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, "failed to generate tokens")
 		return
@@ -174,6 +204,55 @@ func (h *Handlers) RefreshToken(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// VerifyEmailRequest represents the JSON request body for confirming an
+// email address with the token from a verification link.
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+// VerifyEmail is public: members may open the link on a device where they
+// are not logged in.
+func (h *Handlers) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	var req VerifyEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.Token == "" {
+		writeError(w, http.StatusBadRequest, "token is required")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	if _, err := h.memberClient.VerifyEmail(ctx, &memberv1.VerifyEmailRequest{
+		Token: req.Token,
+	}); err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handlers) SendEmailVerification(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	if _, err := h.memberClient.SendEmailVerification(ctx, &memberv1.SendEmailVerificationRequest{
+		MemberId: userID,
+	}); err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
 func (h *Handlers) GetProfile(w http.ResponseWriter, r *http.Request) {
 	userID := r.Context().Value(middleware.UserIDKey).(string)
 
@@ -192,91 +271,1118 @@ func (h *Handlers) GetProfile(w http.ResponseWriter, r *http.Request) {
 	_ = json.NewEncoder(w).Encode(resp.Member)
 }
 
+// OnboardingResponse represents a member's progress through onboarding.
+type OnboardingResponse struct {
+	NextStep       string   `json:"next_step"`
+	CompletedSteps []string `json:"completed_steps"`
+	Completeness   int32    `json:"completeness"`
+	EmailVerified  bool     `json:"email_verified"`
+}
+
+func (h *Handlers) GetOnboarding(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.memberClient.GetMember(ctx, &memberv1.GetMemberRequest{
+		MemberId: userID,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	onboarding := resp.Member.GetOnboarding()
+	completed := make([]string, len(onboarding.GetCompletedSteps()))
+	for i, step := range onboarding.GetCompletedSteps() {
+		completed[i] = formatOnboardingStep(step)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(OnboardingResponse{
+		NextStep:       formatOnboardingStep(onboarding.GetNextStep()),
+		CompletedSteps: completed,
+		Completeness:   onboarding.GetCompleteness(),
+		EmailVerified:  resp.Member.EmailVerified,
+	})
+}
+
+// UpdateProfileRequest represents the JSON request body for profile updates.
+// Photos are managed through the photo endpoints and are never changed here.
+type UpdateProfileRequest struct {
+	DisplayName string `json:"display_name"`
+	Bio         string `json:"bio"`
+	BirthDate   string `json:"birth_date"`
+	Gender      string `json:"gender"`
+}
+
 func (h *Handlers) UpdateProfile(w http.ResponseWriter, r *http.Request) {
-	// TODO: Forward to member service
-	w.WriteHeader(http.StatusNotImplemented)
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+
+	var req UpdateProfileRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	birthDate, err := time.Parse(time.DateOnly, req.BirthDate)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "birth_date must be formatted as YYYY-MM-DD")
+		return
+	}
+
+	gender, ok := parseGender(req.Gender)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "gender must be one of male, female, other")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.memberClient.UpdateProfile(ctx, &memberv1.UpdateProfileRequest{
+		MemberId: userID,
+		Profile: &memberv1.Profile{
+			DisplayName: req.DisplayName,
+			Bio:         req.Bio,
+			BirthDate:   timestamppb.New(birthDate),
+			Gender:      gender,
+		},
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp.Member)
 }
 
-func (h *Handlers) Discover(w http.ResponseWriter, r *http.Request) {
-	// TODO: Forward to matching service with location context
-	// Return empty response for now
+// ReorderPhotosRequest represents the JSON request body for reordering
+// profile photos. The first URL becomes the primary photo.
+type ReorderPhotosRequest struct {
+	PhotoURLs []string `json:"photo_urls"`
+}
+
+func (h *Handlers) ReorderPhotos(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+
+	var req ReorderPhotosRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if len(req.PhotoURLs) == 0 {
+		writeError(w, http.StatusBadRequest, "photo_urls is required")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.memberClient.ReorderPhotos(ctx, &memberv1.ReorderPhotosRequest{
+		MemberId:  userID,
+		PhotoUrls: req.PhotoURLs,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
-	_ = json.NewEncoder(w).Encode(map[string]any{"profiles": []any{}})
+	_ = json.NewEncoder(w).Encode(resp.Member)
 }
 
-func (h *Handlers) Swipe(w http.ResponseWriter, r *http.Request) {
-	// TODO: Forward to matching service
-	w.WriteHeader(http.StatusNotImplemented)
+// SetPrimaryPhotoRequest represents the JSON request body for choosing the
+// primary profile photo.
+type SetPrimaryPhotoRequest struct {
+	PhotoURL string `json:"photo_url"`
 }
 
-func (h *Handlers) GetMatches(w http.ResponseWriter, r *http.Request) {
-	// TODO: Forward to matching service
+func (h *Handlers) SetPrimaryPhoto(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+
+	var req SetPrimaryPhotoRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.PhotoURL == "" {
+		writeError(w, http.StatusBadRequest, "photo_url is required")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.memberClient.SetPrimaryPhoto(ctx, &memberv1.SetPrimaryPhotoRequest{
+		MemberId: userID,
+		PhotoUrl: req.PhotoURL,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
-	_ = json.NewEncoder(w).Encode(map[string]any{"matches": []any{}})
+	_ = json.NewEncoder(w).Encode(resp.Member)
 }
 
-func (h *Handlers) GetConversations(w http.ResponseWriter, r *http.Request) {
-	// TODO: Forward to messaging service
+// InterestsResponse represents the interests catalogue.
+type InterestsResponse struct {
+	Interests    []Interest `json:"interests"`
+	MaxInterests int32      `json:"max_interests"`
+}
+
+// Interest represents a catalogue entry, named in the requested language.
+type Interest struct {
+	ID           string `json:"id"`
+	Category     string `json:"category"`
+	CategoryName string `json:"category_name"`
+	Name         string `json:"name"`
+}
+
+// SetInterestsRequest represents the JSON request body for choosing profile
+// interests.
+type SetInterestsRequest struct {
+	InterestIDs []string `json:"interest_ids"`
+}
+
+// GetInterests returns the interests catalogue. The language is taken from
+// the locale query parameter, falling back to Accept-Language.
+func (h *Handlers) GetInterests(w http.ResponseWriter, r *http.Request) {
+	locale := r.URL.Query().Get("locale")
+	if locale == "" {
+		locale = r.Header.Get("Accept-Language")
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.memberClient.ListInterests(ctx, &memberv1.ListInterestsRequest{
+		Locale: locale,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	interests := make([]Interest, len(resp.Interests))
+	for i, interest := range resp.Interests {
+		interests[i] = Interest{
+			ID:           interest.Id,
+			Category:     interest.Category,
+			CategoryName: interest.CategoryName,
+			Name:         interest.Name,
+		}
+	}
+
 	w.Header().Set("Content-Type", "application/json")
-	_ = json.NewEncoder(w).Encode(map[string]any{"conversations": []any{}})
+	_ = json.NewEncoder(w).Encode(InterestsResponse{
+		Interests:    interests,
+		MaxInterests: resp.MaxInterests,
+	})
 }
 
-func (h *Handlers) GetConversation(w http.ResponseWriter, r *http.Request) {
-	// TODO: Forward to messaging service
-	w.WriteHeader(http.StatusNotImplemented)
+func (h *Handlers) SetInterests(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+
+	var req SetInterestsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.memberClient.SetInterests(ctx, &memberv1.SetInterestsRequest{
+		MemberId:    userID,
+		InterestIds: req.InterestIDs,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp.Member)
 }
 
-func (h *Handlers) SendMessage(w http.ResponseWriter, r *http.Request) {
-	// TODO: Forward to messaging service
-	w.WriteHeader(http.StatusNotImplemented)
+// PromptsResponse represents the prompt catalogue.
+type PromptsResponse struct {
+	Prompts    []Prompt `json:"prompts"`
+	MaxPrompts int32    `json:"max_prompts"`
 }
 
-func (h *Handlers) UploadMedia(w http.ResponseWriter, r *http.Request) {
-	// TODO: Forward to media service
-	w.WriteHeader(http.StatusNotImplemented)
+// Prompt represents a catalogue prompt, in the requested language.
+type Prompt struct {
+	ID       string `json:"id"`
+	Question string `json:"question"`
 }
 
-func (h *Handlers) DeleteMedia(w http.ResponseWriter, r *http.Request) {
-	// TODO: Forward to media service
-	w.WriteHeader(http.StatusNotImplemented)
+// AnswerPromptRequest represents the JSON request body for answering a prompt.
+type AnswerPromptRequest struct {
+	Answer string `json:"answer"`
 }
 
-func (h *Handlers) UpdateLocation(w http.ResponseWriter, r *http.Request) {
-	// TODO: Forward to location service
-	w.WriteHeader(http.StatusNotImplemented)
+// ReorderPromptsRequest represents the JSON request body for reordering
+// answered prompts.
+type ReorderPromptsRequest struct {
+	PromptIDs []string `json:"prompt_ids"`
 }
 
-func (h *Handlers) WebSocketChat(w http.ResponseWriter, r *http.Request) {
-	// TODO: Upgrade to WebSocket, handle real-time messaging
-	w.WriteHeader(http.StatusNotImplemented)
+// FlagsResponse holds whether each feature flag is on for the member.
+type FlagsResponse struct {
+	Flags map[string]bool `json:"flags"`
 }
 
-// writeError writes a JSON error response.
-func writeError(w http.ResponseWriter, statusCode int, message string) {
+// GetFlags evaluates the feature flags for the signed-in member. The app
+// reports its version, platform and the member's country in the
+// X-App-Version, X-Platform and X-Country headers, which flags can target.
+func (h *Handlers) GetFlags(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+
+	flags := h.flags.Evaluate(featureflags.Context{
+		UserID: userID,
+		Attributes: map[string]string{
+			featureflags.AttrAppVersion: r.Header.Get("X-App-Version"),
+			featureflags.AttrPlatform:   strings.ToLower(r.Header.Get("X-Platform")),
+			featureflags.AttrCountry:    strings.ToUpper(r.Header.Get("X-Country")),
+		},
+	})
+
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
-	_ = json.NewEncoder(w).Encode(ErrorResponse{Error: message})
+	// Flags change per member and over time, so the app must not reuse them
+	w.Header().Set("Cache-Control", "no-store")
+	_ = json.NewEncoder(w).Encode(FlagsResponse{Flags: flags})
 }
 
-// handleGRPCError converts gRPC errors to HTTP responses.
-func handleGRPCError(w http.ResponseWriter, err error) {
-	st, ok := status.FromError(err)
-	if !ok {
-		writeError(w, http.StatusInternalServerError, "internal server error")
+// ExperimentResponse holds the variant of an experiment the member is in.
+// Variant is empty when the member does not take part, and the app shows
+// its default experience.
+type ExperimentResponse struct {
+	Experiment string `json:"experiment"`
+	Variant    string `json:"variant,omitempty"`
+}
+
+// GetExperiment assigns the signed-in member a variant of an experiment
+// and records the exposure. The app asks when it is about to show the
+// experiment, not in advance, so only members who saw a variant are
+// counted in its results.
+func (h *Handlers) GetExperiment(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+	key := mux.Vars(r)["key"]
+
+	variant, _ := h.experiments.Assign(r.Context(), key, userID)
+
+	w.Header().Set("Content-Type", "application/json")
+	// Assignments can change when an experiment is stopped or reallocated
+	w.Header().Set("Cache-Control", "no-store")
+	_ = json.NewEncoder(w).Encode(ExperimentResponse{Experiment: key, Variant: variant})
+}
+
+// GetPrompts returns the prompt catalogue. The language is taken from the
+// locale query parameter, falling back to Accept-Language.
+func (h *Handlers) GetPrompts(w http.ResponseWriter, r *http.Request) {
+	locale := r.URL.Query().Get("locale")
+	if locale == "" {
+		locale = r.Header.Get("Accept-Language")
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.memberClient.ListPrompts(ctx, &memberv1.ListPromptsRequest{
+		Locale: locale,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
 		return
 	}
 
-	switch st.Code() {
-	case codes.InvalidArgument:
-		writeError(w, http.StatusBadRequest, st.Message())
-	case codes.NotFound:
-		writeError(w, http.StatusNotFound, st.Message())
-	case codes.AlreadyExists:
-		writeError(w, http.StatusConflict, st.Message())
-	case codes.Unauthenticated:
-		writeError(w, http.StatusUnauthorized, st.Message())
-	case codes.PermissionDenied:
-		writeError(w, http.StatusForbidden, st.Message())
+	prompts := make([]Prompt, len(resp.Prompts))
+	for i, prompt := range resp.Prompts {
+		prompts[i] = Prompt{ID: prompt.Id, Question: prompt.Question}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(PromptsResponse{
+		Prompts:    prompts,
+		MaxPrompts: resp.MaxPrompts,
+	})
+}
+
+func (h *Handlers) AnswerPrompt(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+	promptID := mux.Vars(r)["id"]
+
+	var req AnswerPromptRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.memberClient.AnswerPrompt(ctx, &memberv1.AnswerPromptRequest{
+		MemberId: userID,
+		PromptId: promptID,
+		Answer:   req.Answer,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp.Member)
+}
+
+func (h *Handlers) RemovePrompt(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+	promptID := mux.Vars(r)["id"]
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.memberClient.RemovePrompt(ctx, &memberv1.RemovePromptRequest{
+		MemberId: userID,
+		PromptId: promptID,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp.Member)
+}
+
+func (h *Handlers) ReorderPrompts(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+
+	var req ReorderPromptsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.memberClient.ReorderPrompts(ctx, &memberv1.ReorderPromptsRequest{
+		MemberId:  userID,
+		PromptIds: req.PromptIDs,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp.Member)
+}
+
+// MemberPreferences represents who a member wants to see in discovery.
+type MemberPreferences struct {
+	MinAge        int32    `json:"min_age"`
+	MaxAge        int32    `json:"max_age"`
+	Genders       []string `json:"genders"`
+	MaxDistanceKm int32    `json:"max_distance_km"`
+	Dealbreakers  []string `json:"dealbreakers"`
+}
+
+func (h *Handlers) GetPreferences(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.memberClient.GetPreferences(ctx, &memberv1.GetPreferencesRequest{
+		MemberId: userID,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(toMemberPreferences(resp.Preferences))
+}
+
+func (h *Handlers) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+
+	var req MemberPreferences
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	preferences := &memberv1.Preferences{
+		MinAge:        req.MinAge,
+		MaxAge:        req.MaxAge,
+		MaxDistanceKm: req.MaxDistanceKm,
+	}
+	for _, g := range req.Genders {
+		gender, ok := parseGender(g)
+		if !ok {
+			writeError(w, http.StatusBadRequest, "genders must be male, female or other")
+			return
+		}
+		preferences.Genders = append(preferences.Genders, gender)
+	}
+	for _, d := range req.Dealbreakers {
+		dealbreaker, ok := parseDealbreaker(d)
+		if !ok {
+			writeError(w, http.StatusBadRequest, "dealbreakers must be age, gender or distance")
+			return
+		}
+		preferences.Dealbreakers = append(preferences.Dealbreakers, dealbreaker)
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.memberClient.UpdatePreferences(ctx, &memberv1.UpdatePreferencesRequest{
+		MemberId:    userID,
+		Preferences: preferences,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(toMemberPreferences(resp.Preferences))
+}
+
+// toMemberPreferences converts protobuf preferences to the JSON representation.
+func toMemberPreferences(p *memberv1.Preferences) MemberPreferences {
+	preferences := MemberPreferences{
+		MinAge:        p.MinAge,
+		MaxAge:        p.MaxAge,
+		MaxDistanceKm: p.MaxDistanceKm,
+		Genders:       make([]string, 0, len(p.Genders)),
+		Dealbreakers:  make([]string, 0, len(p.Dealbreakers)),
+	}
+	for _, g := range p.Genders {
+		preferences.Genders = append(preferences.Genders, formatGender(g))
+	}
+	for _, d := range p.Dealbreakers {
+		preferences.Dealbreakers = append(preferences.Dealbreakers, formatDealbreaker(d))
+	}
+	return preferences
+}
+
+func (h *Handlers) Discover(w http.ResponseWriter, r *http.Request) {
+	// TODO: Forward to matching service with location context
+	// Return empty response for now
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"profiles": []any{}})
+}
+
+// SwipeRequest represents the JSON request body for a swipe.
+type SwipeRequest struct {
+	SwipedID  string `json:"swiped_id"`
+	Direction string `json:"direction"`
+}
+
+func (h *Handlers) Swipe(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+
+	var req SwipeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.SwipedID == "" {
+		writeError(w, http.StatusBadRequest, "swiped_id is required")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	// Blocked members must never be able to match, whichever side blocked.
+	// They are reported as not found so a block is not revealed.
+	resp, err := h.memberClient.CheckBlocked(ctx, &memberv1.CheckBlockedRequest{
+		MemberId:      userID,
+		OtherMemberId: req.SwipedID,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+	if resp.Blocked {
+		writeError(w, http.StatusNotFound, "member not found")
+		return
+	}
+
+	// TODO: Forward to matching service
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (h *Handlers) GetMatches(w http.ResponseWriter, r *http.Request) {
+	// TODO: Forward to matching service
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"matches": []any{}})
+}
+
+func (h *Handlers) GetConversations(w http.ResponseWriter, r *http.Request) {
+	// TODO: Forward to messaging service
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"conversations": []any{}})
+}
+
+func (h *Handlers) GetConversation(w http.ResponseWriter, r *http.Request) {
+	// TODO: Forward to messaging service
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (h *Handlers) SendMessage(w http.ResponseWriter, r *http.Request) {
+	// TODO: Forward to messaging service
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (h *Handlers) UploadMedia(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+
+	// Allow for multipart framing on top of the file itself.
+	r.Body = http.MaxBytesReader(w, r.Body, MaxUploadSize+1<<20)
+	if err := r.ParseMultipartForm(MaxUploadSize); err != nil {
+		writeError(w, http.StatusRequestEntityTooLarge, "upload is too large or not a multipart form")
+		return
+	}
+	defer func() { _ = r.MultipartForm.RemoveAll() }()
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "file is required")
+		return
+	}
+	defer file.Close()
+
+	if header.Size > MaxUploadSize {
+		writeError(w, http.StatusRequestEntityTooLarge, "file exceeds the maximum upload size")
+		return
+	}
+
+	data, err := io.ReadAll(io.LimitReader(file, MaxUploadSize))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "failed to read file")
+		return
+	}
+
+	// Processing resizes several variants, so allow more than the usual timeout.
+	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	defer cancel()
+
+	resp, err := h.mediaClient.UploadPhoto(ctx, &mediav1.UploadPhotoRequest{
+		MemberId: userID,
+		Data:     data,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(toMediaResponse(resp.Photo))
+}
+
+func (h *Handlers) DeleteMedia(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+	photoID := mux.Vars(r)["id"]
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	_, err := h.mediaClient.DeletePhoto(ctx, &mediav1.DeletePhotoRequest{
+		MemberId: userID,
+		PhotoId:  photoID,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RegisterDeviceRequest represents the JSON request body for registering a
+// push token.
+type RegisterDeviceRequest struct {
+	Token    string `json:"token"`
+	Platform string `json:"platform"`
+}
+
+// DeviceResponse represents a registered push token.
+type DeviceResponse struct {
+	Token        string    `json:"token"`
+	Platform     string    `json:"platform"`
+	RegisteredAt time.Time `json:"registered_at"`
+}
+
+func (h *Handlers) RegisterDevice(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+
+	var req RegisterDeviceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.Token == "" {
+		writeError(w, http.StatusBadRequest, "token is required")
+		return
+	}
+	if req.Platform != "ios" {
+		writeError(w, http.StatusBadRequest, "platform must be ios")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.notificationClient.RegisterDevice(ctx, &notificationv1.RegisterDeviceRequest{
+		MemberId: userID,
+		Token:    req.Token,
+		Platform: notificationv1.Platform_PLATFORM_IOS,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(DeviceResponse{
+		Token:        resp.Device.Token,
+		Platform:     req.Platform,
+		RegisteredAt: resp.Device.RegisteredAt.AsTime(),
+	})
+}
+
+func (h *Handlers) UnregisterDevice(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+	token := mux.Vars(r)["token"]
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	_, err := h.notificationClient.UnregisterDevice(ctx, &notificationv1.UnregisterDeviceRequest{
+		MemberId: userID,
+		Token:    token,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// NotificationPreferences represents a member's notification settings.
+type NotificationPreferences struct {
+	Matches    bool        `json:"matches"`
+	Messages   bool        `json:"messages"`
+	Moderation bool        `json:"moderation"`
+	QuietHours *QuietHours `json:"quiet_hours,omitempty"`
+}
+
+// QuietHours is a daily "HH:MM" window during which pushes arrive silently.
+type QuietHours struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	TimeZone string `json:"time_zone"`
+}
+
+func (h *Handlers) GetNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.notificationClient.GetPreferences(ctx, &notificationv1.GetPreferencesRequest{
+		MemberId: userID,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(toNotificationPreferences(resp.Preferences))
+}
+
+func (h *Handlers) UpdateNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+
+	var req NotificationPreferences
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	preferences := &notificationv1.Preferences{
+		Matches:    req.Matches,
+		Messages:   req.Messages,
+		Moderation: req.Moderation,
+	}
+	if q := req.QuietHours; q != nil {
+		preferences.QuietHours = &notificationv1.QuietHours{
+			Start:    q.Start,
+			End:      q.End,
+			TimeZone: q.TimeZone,
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.notificationClient.UpdatePreferences(ctx, &notificationv1.UpdatePreferencesRequest{
+		MemberId:    userID,
+		Preferences: preferences,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(toNotificationPreferences(resp.Preferences))
+}
+
+// toNotificationPreferences converts protobuf preferences to the JSON
+// representation, which keeps disabled categories instead of omitting them.
+func toNotificationPreferences(p *notificationv1.Preferences) NotificationPreferences {
+	preferences := NotificationPreferences{
+		Matches:    p.Matches,
+		Messages:   p.Messages,
+		Moderation: p.Moderation,
+	}
+	if q := p.QuietHours; q != nil {
+		preferences.QuietHours = &QuietHours{
+			Start:    q.Start,
+			End:      q.End,
+			TimeZone: q.TimeZone,
+		}
+	}
+	return preferences
+}
+
+// BlockedMembersResponse lists the members the caller has blocked.
+type BlockedMembersResponse struct {
+	BlockedMemberIDs []string `json:"blocked_member_ids"`
+}
+
+func (h *Handlers) BlockMember(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+	memberID := mux.Vars(r)["id"]
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	_, err := h.memberClient.BlockMember(ctx, &memberv1.BlockMemberRequest{
+		MemberId:        userID,
+		BlockedMemberId: memberID,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handlers) UnblockMember(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+	memberID := mux.Vars(r)["id"]
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	_, err := h.memberClient.UnblockMember(ctx, &memberv1.UnblockMemberRequest{
+		MemberId:        userID,
+		BlockedMemberId: memberID,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handlers) GetBlockedMembers(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.memberClient.ListBlockedMembers(ctx, &memberv1.ListBlockedMembersRequest{
+		MemberId: userID,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	blocked := resp.BlockedMemberIds
+	if blocked == nil {
+		blocked = []string{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(BlockedMembersResponse{BlockedMemberIDs: blocked})
+}
+
+// ReportMemberRequest represents the JSON request body for reporting a
+// member. Block also blocks the member, which is what most reporters want.
+type ReportMemberRequest struct {
+	Category    string `json:"category"`
+	Description string `json:"description"`
+	Block       bool   `json:"block"`
+}
+
+// ReportResponse represents a filed report.
+type ReportResponse struct {
+	ReportID string `json:"report_id"`
+}
+
+func (h *Handlers) ReportMember(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDKey).(string)
+	memberID := mux.Vars(r)["id"]
+
+	var req ReportMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	category, ok := parseReportCategory(req.Category)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "invalid category")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.moderationClient.FileReport(ctx, &moderationv1.FileReportRequest{
+		ReporterId:  userID,
+		MemberId:    memberID,
+		Category:    category,
+		Description: req.Description,
+	})
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	if req.Block {
+		_, err := h.memberClient.BlockMember(ctx, &memberv1.BlockMemberRequest{
+			MemberId:        userID,
+			BlockedMemberId: memberID,
+		})
+		if err != nil {
+			handleGRPCError(w, err)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(ReportResponse{ReportID: resp.ReportId})
+}
+
+func (h *Handlers) UpdateLocation(w http.ResponseWriter, r *http.Request) {
+	// TODO: Forward to location service
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (h *Handlers) WebSocketChat(w http.ResponseWriter, r *http.Request) {
+	// TODO: Upgrade to WebSocket, handle real-time messaging
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// MediaResponse represents an uploaded photo and its stored variants.
+type MediaResponse struct {
+	ID       string                  `json:"id"`
+	URL      string                  `json:"url"`
+	Width    int32                   `json:"width"`
+	Height   int32                   `json:"height"`
+	Blurhash string                  `json:"blurhash"`
+	Variants map[string]MediaVariant `json:"variants"`
+}
+
+// MediaVariant represents a single stored rendition of a photo.
+type MediaVariant struct {
+	URL    string `json:"url"`
+	Width  int32  `json:"width"`
+	Height int32  `json:"height"`
+}
+
+// toMediaResponse converts a media service photo to its JSON representation.
+// URL points at the large variant, matching the URL stored on the profile.
+func toMediaResponse(p *mediav1.Photo) MediaResponse {
+	resp := MediaResponse{
+		ID:       p.Id,
+		Width:    p.Width,
+		Height:   p.Height,
+		Blurhash: p.Blurhash,
+		Variants: make(map[string]MediaVariant, len(p.Variants)),
+	}
+	for _, v := range p.Variants {
+		resp.Variants[v.Name] = MediaVariant{URL: v.Url, Width: v.Width, Height: v.Height}
+		if v.Name == "large" {
+			resp.URL = v.Url
+		}
+	}
+	return resp
+}
+
+// parseGender converts the JSON gender value to its protobuf enum.
+func parseGender(g string) (memberv1.Gender, bool) {
+	switch g {
+	case "male":
+		return memberv1.Gender_GENDER_MALE, true
+	case "female":
+		return memberv1.Gender_GENDER_FEMALE, true
+	case "other":
+		return memberv1.Gender_GENDER_OTHER, true
+	default:
+		return memberv1.Gender_GENDER_UNSPECIFIED, false
+	}
+}
+
+// formatGender converts a protobuf gender to its JSON value.
+func formatGender(g memberv1.Gender) string {
+	switch g {
+	case memberv1.Gender_GENDER_MALE:
+		return "male"
+	case memberv1.Gender_GENDER_FEMALE:
+		return "female"
+	case memberv1.Gender_GENDER_OTHER:
+		return "other"
+	default:
+		return ""
+	}
+}
+
+// formatOnboardingStep converts a protobuf onboarding step to its JSON value.
+func formatOnboardingStep(step memberv1.OnboardingStep) string {
+	switch step {
+	case memberv1.OnboardingStep_ONBOARDING_STEP_PROFILE:
+		return "profile"
+	case memberv1.OnboardingStep_ONBOARDING_STEP_PHOTOS:
+		return "photos"
+	case memberv1.OnboardingStep_ONBOARDING_STEP_PROMPTS:
+		return "prompts"
+	case memberv1.OnboardingStep_ONBOARDING_STEP_PREFERENCES:
+		return "preferences"
+	case memberv1.OnboardingStep_ONBOARDING_STEP_VERIFY_EMAIL:
+		return "verify_email"
+	case memberv1.OnboardingStep_ONBOARDING_STEP_COMPLETE:
+		return "complete"
+	default:
+		return ""
+	}
+}
+
+// parseDealbreaker converts the JSON dealbreaker value to its protobuf enum.
+func parseDealbreaker(d string) (memberv1.Dealbreaker, bool) {
+	switch d {
+	case "age":
+		return memberv1.Dealbreaker_DEALBREAKER_AGE, true
+	case "gender":
+		return memberv1.Dealbreaker_DEALBREAKER_GENDER, true
+	case "distance":
+		return memberv1.Dealbreaker_DEALBREAKER_DISTANCE, true
+	default:
+		return memberv1.Dealbreaker_DEALBREAKER_UNSPECIFIED, false
+	}
+}
+
+// formatDealbreaker converts a protobuf dealbreaker to its JSON value.
+func formatDealbreaker(d memberv1.Dealbreaker) string {
+	switch d {
+	case memberv1.Dealbreaker_DEALBREAKER_AGE:
+		return "age"
+	case memberv1.Dealbreaker_DEALBREAKER_GENDER:
+		return "gender"
+	case memberv1.Dealbreaker_DEALBREAKER_DISTANCE:
+		return "distance"
+	default:
+		return ""
+	}
+}
+
+// parseReportCategory converts the JSON report category to its protobuf enum.
+func parseReportCategory(c string) (moderationv1.ReportCategory, bool) {
+	switch c {
+	case "spam":
+		return moderationv1.ReportCategory_REPORT_CATEGORY_SPAM, true
+	case "fake_profile":
+		return moderationv1.ReportCategory_REPORT_CATEGORY_FAKE_PROFILE, true
+	case "inappropriate_content":
+		return moderationv1.ReportCategory_REPORT_CATEGORY_INAPPROPRIATE_CONTENT, true
+	case "harassment":
+		return moderationv1.ReportCategory_REPORT_CATEGORY_HARASSMENT, true
+	case "scam":
+		return moderationv1.ReportCategory_REPORT_CATEGORY_SCAM, true
+	case "underage":
+		return moderationv1.ReportCategory_REPORT_CATEGORY_UNDERAGE, true
+	case "other":
+		return moderationv1.ReportCategory_REPORT_CATEGORY_OTHER, true
+	default:
+		return moderationv1.ReportCategory_REPORT_CATEGORY_UNSPECIFIED, false
+	}
+}
+
+// writeError writes a problem details error response.
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	middleware.WriteProblem(w, statusCode, message)
+}
+
+// handleGRPCError converts gRPC errors to HTTP responses.
+func handleGRPCError(w http.ResponseWriter, err error) {
+	st, ok := status.FromError(err)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	switch st.Code() {
+	case codes.InvalidArgument:
+		writeError(w, http.StatusBadRequest, st.Message())
+	case codes.NotFound:
+		writeError(w, http.StatusNotFound, st.Message())
+	case codes.AlreadyExists:
+		writeError(w, http.StatusConflict, st.Message())
+	case codes.Unauthenticated:
+		writeError(w, http.StatusUnauthorized, st.Message())
+	case codes.PermissionDenied:
+		writeError(w, http.StatusForbidden, st.Message())
+	case codes.FailedPrecondition:
+		writeError(w, http.StatusConflict, st.Message())
+	case codes.Unavailable:
+		// An open circuit says when to come back; otherwise the service
+		// is expected back shortly
+		retryAfter := int(grpcclient.RetryAfter(err).Seconds())
+		if retryAfter < 1 {
+			retryAfter = 1
+		}
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		writeError(w, http.StatusServiceUnavailable, "service temporarily unavailable")
 	default:
 		writeError(w, http.StatusInternalServerError, "internal server error")
 	}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	memberv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/member/v1"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/gateway/internal/middleware"
+)
+
+// memberMePrefix is the path clients use for their own member, instead of
+// their member ID.
+const memberMePrefix = "/v2/members/me"
+
+// MemberREST serves the MemberService RPCs that have an HTTP rule in
+// member.proto, such as GET /v2/members/{member_id}. Requests and responses
+// are transcoded with protojson, so JSON uses the proto field names and
+// enum names. It must run after Auth; the member service only lets members
+// act on themselves.
+func (h *Handlers) MemberREST(ctx context.Context) (http.Handler, error) {
+	gw := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true},
+		}),
+		// The member service learns who is calling from the metadata Auth
+		// sets, so client headers must not reach it as metadata, nor its
+		// response metadata reach the client
+		runtime.WithIncomingHeaderMatcher(func(string) (string, bool) { return "", false }),
+		runtime.WithOutgoingHeaderMatcher(func(string) (string, bool) { return "", false }),
+		runtime.WithErrorHandler(transcodingError),
+		runtime.WithRoutingErrorHandler(transcodingRoutingError),
+	)
+	if err := memberv1.RegisterMemberServiceHandlerClient(ctx, gw, h.memberClient); err != nil {
+		return nil, fmt.Errorf("register member REST handlers: %w", err)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+		r = r.WithContext(ctx)
+
+		if rest, ok := strings.CutPrefix(r.URL.Path, memberMePrefix); ok && (rest == "" || strings.HasPrefix(rest, "/")) {
+			userID, _ := ctx.Value(middleware.UserIDKey).(string)
+			url := *r.URL
+			url.Path = "/v2/members/" + userID + rest
+			url.RawPath = ""
+			r.URL = &url
+		}
+		gw.ServeHTTP(w, r)
+	}), nil
+}
+
+// transcodingError writes a failed RPC as a problem, listing the fields the
+// member service rejected.
+func transcodingError(_ context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, _ *http.Request, err error) {
+	st := status.Convert(err)
+	if st.Code() == codes.InvalidArgument {
+		var violations []middleware.Violation
+		for _, detail := range st.Details() {
+			if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+				for _, v := range badRequest.GetFieldViolations() {
+					violations = append(violations, middleware.Violation{Field: v.GetField(), Message: v.GetDescription()})
+				}
+			}
+		}
+		middleware.WriteProblem(w, http.StatusBadRequest, st.Message(), violations...)
+		return
+	}
+	handleGRPCError(w, err)
+}
+
+func transcodingRoutingError(_ context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, r *http.Request, httpStatus int) {
+	// The URL was rewritten on the way in; report the one the client sent
+	path, _, _ := strings.Cut(r.RequestURI, "?")
+	middleware.WriteProblem(w, httpStatus, fmt.Sprintf("%s %s is not an endpoint", r.Method, path))
+}
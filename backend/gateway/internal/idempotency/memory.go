@@ -0,0 +1,65 @@
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore keeps records in the gateway's memory. Each instance has its
+// own records, so it only fits a single gateway or development.
+type MemoryStore struct {
+	mu        sync.Mutex
+	entries   map[string]memoryEntry
+	lastSweep time.Time
+}
+
+type memoryEntry struct {
+	record    Record
+	expiresAt time.Time
+}
+
+// NewMemoryStore creates an empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: make(map[string]memoryEntry)}
+}
+
+func (s *MemoryStore) Begin(_ context.Context, key, fingerprint string, ttl time.Duration) (Record, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.sweep(now)
+	if entry, ok := s.entries[key]; ok && now.Before(entry.expiresAt) {
+		return entry.record, false, nil
+	}
+	s.entries[key] = memoryEntry{record: Record{Fingerprint: fingerprint}, expiresAt: now.Add(ttl)}
+	return Record{}, true, nil
+}
+
+func (s *MemoryStore) Complete(_ context.Context, key string, record Record, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[key] = memoryEntry{record: record, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
+
+func (s *MemoryStore) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, key)
+	return nil
+}
+
+// sweep drops expired records, at most once a minute.
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < time.Minute {
+		return
+	}
+	s.lastSweep = now
+	for key, entry := range s.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(s.entries, key)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// keyPrefix namespaces the records in a Redis database shared with other
+// data.
+const keyPrefix = "idempotency:"
+
+// RedisStore keeps records in Redis or any server speaking its protocol,
+// so every gateway instance sees the same keys.
+type RedisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore connects to the server at url, such as
+// redis://:password@redis:6379/0.
+func NewRedisStore(url string) (*RedisStore, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("parse redis url: %w", err)
+	}
+	return &RedisStore{client: redis.NewClient(opts)}, nil
+}
+
+// Check pings the server, for readiness checks.
+func (s *RedisStore) Check(ctx context.Context) error {
+	return s.client.Ping(ctx).Err()
+}
+
+// Close closes the connections to the server.
+func (s *RedisStore) Close() error {
+	return s.client.Close()
+}
+
+func (s *RedisStore) Begin(ctx context.Context, key, fingerprint string, ttl time.Duration) (Record, bool, error) {
+	claim, err := json.Marshal(Record{Fingerprint: fingerprint})
+	if err != nil {
+		return Record{}, false, err
+	}
+
+	// The claim may expire between SET and GET; claiming again then
+	// succeeds, so two attempts always settle it
+	for attempt := 0; attempt < 2; attempt++ {
+		claimed, err := s.client.SetNX(ctx, keyPrefix+key, claim, ttl).Result()
+		if err != nil {
+			return Record{}, false, fmt.Errorf("claim idempotency key: %w", err)
+		}
+		if claimed {
+			return Record{}, true, nil
+		}
+
+		data, err := s.client.Get(ctx, keyPrefix+key).Bytes()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return Record{}, false, fmt.Errorf("get idempotency record: %w", err)
+		}
+		var record Record
+		if err := json.Unmarshal(data, &record); err != nil {
+			return Record{}, false, fmt.Errorf("unmarshal idempotency record: %w", err)
+		}
+		return record, false, nil
+	}
+	return Record{}, false, errors.New("claim idempotency key: record expired while reading it")
+}
+
+func (s *RedisStore) Complete(ctx context.Context, key string, record Record, ttl time.Duration) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if err := s.client.Set(ctx, keyPrefix+key, data, ttl).Err(); err != nil {
+		return fmt.Errorf("store idempotency record: %w", err)
+	}
+	return nil
+}
+
+func (s *RedisStore) Release(ctx context.Context, key string) error {
+	if err := s.client.Del(ctx, keyPrefix+key).Err(); err != nil {
+		return fmt.Errorf("release idempotency key: %w", err)
+	}
+	return nil
+}
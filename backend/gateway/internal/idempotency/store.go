@@ -0,0 +1,36 @@
+// Package idempotency stores the responses of requests sent with an
+// Idempotency-Key, so a client that retries gets the original response
+// instead of repeating the side effect.
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Record is what is kept for a key: the fingerprint of the request that
+// claimed it and, once that request finished, its response. The body is
+// encrypted by the caller.
+type Record struct {
+	Fingerprint string      `json:"fingerprint"`
+	Completed   bool        `json:"completed"`
+	Status      int         `json:"status,omitempty"`
+	Header      http.Header `json:"header,omitempty"`
+	Body        []byte      `json:"body,omitempty"`
+}
+
+// Store keeps records by key. Implementations must make Begin atomic, so
+// that of two concurrent requests with the same key only one proceeds.
+type Store interface {
+	// Begin claims key for the request with fingerprint for at most ttl.
+	// When the key was claimed before, it returns the existing record and
+	// false.
+	Begin(ctx context.Context, key, fingerprint string, ttl time.Duration) (Record, bool, error)
+
+	// Complete stores the finished record for key, kept for ttl.
+	Complete(ctx context.Context, key string, record Record, ttl time.Duration) error
+
+	// Release gives up a claim, so the request can be tried again.
+	Release(ctx context.Context, key string) error
+}
@@ -0,0 +1,278 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/gateway/internal/idempotency"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/logging"
+)
+
+const (
+	headerIdempotencyKey = "Idempotency-Key"
+	headerReplayed       = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+	// maxIdempotentBodySize bounds the request bodies read to fingerprint
+	// a request; the endpoints using keys take small JSON bodies.
+	maxIdempotentBodySize = 1 << 20
+	// idempotencyClaimTTL is how long a request holds its key before a
+	// retry may run it again. It outlasts any request, so the claim only
+	// expires when the gateway stopped before storing the response.
+	idempotencyClaimTTL = time.Minute
+)
+
+// Results recorded by gateway_idempotent_requests_total.
+const (
+	IdempotencyStored     = "stored"
+	IdempotencyReplayed   = "replayed"
+	IdempotencyMismatch   = "mismatch"
+	IdempotencyInProgress = "in_progress"
+	IdempotencyStoreError = "store_error"
+)
+
+// Idempotency makes retries of a request sent with an Idempotency-Key
+// header safe. The first request with a key runs and its response is kept
+// for ttl; retries get that response again with Idempotent-Replayed: true.
+// Keys are scoped to the signed-in member, so it must run after Auth.
+// Requests before sign-in, like registration, are scoped to the request
+// itself, so anonymous clients choosing the same key do not meet.
+//
+// A member reusing a key with a different request is rejected with 409,
+// and a retry while the first request is still running with 425. Only
+// successful responses and conflicts are kept: other client errors and
+// server errors release the key, so the request can be corrected or
+// retried. Kept response bodies are encrypted with a key derived from the
+// request, so only a retry of that request can read them, and the tokens
+// returned on registration are not stored in plaintext. When the store is
+// unavailable requests run without protection rather than failing.
+func Idempotency(store idempotency.Store, ttl time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(headerIdempotencyKey)
+			if key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if !validIdempotencyKey(key) {
+				WriteProblem(w, http.StatusBadRequest, "invalid Idempotency-Key header")
+				return
+			}
+
+			body, err := io.ReadAll(io.LimitReader(r.Body, maxIdempotentBodySize+1))
+			if err != nil {
+				WriteProblem(w, http.StatusBadRequest, "failed to read request body")
+				return
+			}
+			if len(body) > maxIdempotentBodySize {
+				WriteProblem(w, http.StatusRequestEntityTooLarge, "request body too large")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			userID, _ := r.Context().Value(UserIDKey).(string)
+			fingerprint := hash(r.Method, r.URL.Path, string(body))
+			storeKey := hash(userID, key)
+			if userID == "" {
+				storeKey = hash("", key, fingerprint)
+			}
+			sealKey := responseKey(userID, key, r.Method, r.URL.Path, body)
+
+			ctx := r.Context()
+			record, claimed, err := store.Begin(ctx, storeKey, fingerprint, idempotencyClaimTTL)
+			if err != nil {
+				idempotentRequests.WithLabelValues(IdempotencyStoreError).Inc()
+				logging.FromContext(ctx).WarnContext(ctx, "idempotency store unavailable, handling request without it", "error", err)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if !claimed {
+				switch {
+				case record.Fingerprint != fingerprint:
+					idempotentRequests.WithLabelValues(IdempotencyMismatch).Inc()
+					WriteProblem(w, http.StatusConflict, "Idempotency-Key was already used for a different request")
+				case !record.Completed:
+					idempotentRequests.WithLabelValues(IdempotencyInProgress).Inc()
+					w.Header().Set("Retry-After", "1")
+					WriteProblem(w, http.StatusTooEarly, "a request with this Idempotency-Key is still in progress")
+				default:
+					responseBody, err := open(sealKey, record.Body)
+					if err != nil {
+						idempotentRequests.WithLabelValues(IdempotencyStoreError).Inc()
+						logging.FromContext(ctx).WarnContext(ctx, "unreadable idempotent response, handling request without it", "error", err)
+						next.ServeHTTP(w, r)
+						return
+					}
+					idempotentRequests.WithLabelValues(IdempotencyReplayed).Inc()
+					replay(w, record, responseBody)
+				}
+				return
+			}
+
+			// Headers set by earlier middleware, such as X-Request-ID,
+			// belong to this request and are not replayed
+			inherited := make(map[string]bool, len(w.Header()))
+			for name := range w.Header() {
+				inherited[name] = true
+			}
+
+			capture := &responseCapture{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(capture, r)
+
+			// A client that gave up must still find the response when it
+			// retries
+			ctx = context.WithoutCancel(ctx)
+			if !keepResponse(capture.status) {
+				if err := store.Release(ctx, storeKey); err != nil {
+					logging.FromContext(ctx).WarnContext(ctx, "failed to release idempotency key", "error", err)
+				}
+				return
+			}
+			sealed, err := seal(sealKey, capture.body.Bytes())
+			if err != nil {
+				logging.FromContext(ctx).WarnContext(ctx, "failed to encrypt idempotent response", "error", err)
+				_ = store.Release(ctx, storeKey)
+				return
+			}
+
+			header := make(http.Header)
+			for name, values := range w.Header() {
+				if !inherited[name] {
+					header[name] = values
+				}
+			}
+			err = store.Complete(ctx, storeKey, idempotency.Record{
+				Fingerprint: fingerprint,
+				Completed:   true,
+				Status:      capture.status,
+				Header:      header,
+				Body:        sealed,
+			}, ttl)
+			if err != nil {
+				logging.FromContext(ctx).WarnContext(ctx, "failed to store idempotent response", "error", err)
+				return
+			}
+			idempotentRequests.WithLabelValues(IdempotencyStored).Inc()
+		})
+	}
+}
+
+// keepResponse reports whether a response with status is the outcome of
+// the request and kept for retries: a success, or a conflict such as an
+// email address that is already registered.
+func keepResponse(status int) bool {
+	return status >= 200 && status < 300 || status == http.StatusConflict
+}
+
+func validIdempotencyKey(key string) bool {
+	if len(key) > maxIdempotencyKeyLength {
+		return false
+	}
+	for _, c := range key {
+		if c < 0x21 || c > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// hash joins parts with NUL bytes, which cannot occur in headers or paths,
+// and returns their SHA-256.
+func hash(parts ...string) string {
+	h := sha256.New()
+	for _, part := range parts {
+		_, _ = io.WriteString(h, part)
+		_, _ = h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// responseKey derives the AES-256 key a response body is kept under from
+// the request, which the store never sees.
+func responseKey(userID, key, method, path string, body []byte) []byte {
+	h := sha256.New()
+	for _, part := range []string{"idempotent response", userID, key, method, path} {
+		_, _ = io.WriteString(h, part)
+		_, _ = h.Write([]byte{0})
+	}
+	_, _ = h.Write(body)
+	return h.Sum(nil)
+}
+
+// seal encrypts body with AES-GCM, prefixing the random nonce.
+func seal(key, body []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(body)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, body, nil), nil
+}
+
+// open decrypts a body sealed with key.
+func open(key, sealed []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("sealed response is too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func replay(w http.ResponseWriter, record idempotency.Record, body []byte) {
+	for name, values := range record.Header {
+		w.Header()[name] = values
+	}
+	w.Header().Set(headerReplayed, "true")
+	w.WriteHeader(record.Status)
+	_, _ = w.Write(body)
+}
+
+// responseCapture passes the response through while keeping a copy to
+// replay.
+type responseCapture struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (c *responseCapture) WriteHeader(status int) {
+	if !c.wroteHeader {
+		c.status = status
+		c.wroteHeader = true
+	}
+	c.ResponseWriter.WriteHeader(status)
+}
+
+func (c *responseCapture) Write(b []byte) (int, error) {
+	if !c.wroteHeader {
+		c.WriteHeader(http.StatusOK)
+	}
+	c.body.Write(b)
+	return c.ResponseWriter.Write(b)
+}
@@ -0,0 +1,166 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/gateway/internal/idempotency"
+)
+
+// recordingStore keeps the records completed in the wrapped store.
+type recordingStore struct {
+	idempotency.Store
+	completed []idempotency.Record
+}
+
+func (s *recordingStore) Complete(ctx context.Context, key string, record idempotency.Record, ttl time.Duration) error {
+	s.completed = append(s.completed, record)
+	return s.Store.Complete(ctx, key, record, ttl)
+}
+
+// countingHandler answers with status and a body naming the call, and
+// counts how often it ran.
+type countingHandler struct {
+	status int
+	calls  int
+}
+
+func (h *countingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.calls++
+	body, _ := io.ReadAll(r.Body)
+	w.WriteHeader(h.status)
+	fmt.Fprintf(w, `{"access_token":"secret-%d","request":%q}`, h.calls, body)
+}
+
+func idempotentRequest(t *testing.T, handler http.Handler, userID, key, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/register", strings.NewReader(body))
+	req.Header.Set(headerIdempotencyKey, key)
+	if userID != "" {
+		req = req.WithContext(context.WithValue(req.Context(), UserIDKey, userID))
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestIdempotencyReplaysSuccess(t *testing.T) {
+	next := &countingHandler{status: http.StatusCreated}
+	handler := Idempotency(idempotency.NewMemoryStore(), time.Hour)(next)
+
+	first := idempotentRequest(t, handler, "", "key-1", `{"email":"a@example.com"}`)
+	retry := idempotentRequest(t, handler, "", "key-1", `{"email":"a@example.com"}`)
+
+	if next.calls != 1 {
+		t.Fatalf("handler ran %d times, want 1", next.calls)
+	}
+	if retry.Code != http.StatusCreated || retry.Header().Get(headerReplayed) != "true" {
+		t.Errorf("retry = %d replayed=%q, want 201 replayed", retry.Code, retry.Header().Get(headerReplayed))
+	}
+	if retry.Body.String() != first.Body.String() {
+		t.Errorf("retry body %q, want %q", retry.Body, first.Body)
+	}
+}
+
+// Anonymous clients picking the same key must neither see each other's
+// responses nor be refused as a mismatch.
+func TestIdempotencyScopesAnonymousKeysToTheRequest(t *testing.T) {
+	next := &countingHandler{status: http.StatusCreated}
+	handler := Idempotency(idempotency.NewMemoryStore(), time.Hour)(next)
+
+	first := idempotentRequest(t, handler, "", "shared", `{"email":"a@example.com"}`)
+	other := idempotentRequest(t, handler, "", "shared", `{"email":"b@example.com"}`)
+
+	if next.calls != 2 {
+		t.Fatalf("handler ran %d times, want 2", next.calls)
+	}
+	if other.Code != http.StatusCreated || other.Header().Get(headerReplayed) != "" {
+		t.Errorf("other client got %d replayed=%q, want a fresh 201", other.Code, other.Header().Get(headerReplayed))
+	}
+	if other.Body.String() == first.Body.String() {
+		t.Error("other client got the first client's response")
+	}
+}
+
+func TestIdempotencyRejectsKeyReusedByMember(t *testing.T) {
+	next := &countingHandler{status: http.StatusCreated}
+	handler := Idempotency(idempotency.NewMemoryStore(), time.Hour)(next)
+
+	idempotentRequest(t, handler, "member-1", "key-1", `{"swiped_id":"a"}`)
+	reused := idempotentRequest(t, handler, "member-1", "key-1", `{"swiped_id":"b"}`)
+
+	if reused.Code != http.StatusConflict {
+		t.Errorf("reused key = %d, want 409", reused.Code)
+	}
+	if next.calls != 1 {
+		t.Errorf("handler ran %d times, want 1", next.calls)
+	}
+}
+
+func TestIdempotencyKeepsOnlySuccessesAndConflicts(t *testing.T) {
+	for _, tc := range []struct {
+		status int
+		kept   bool
+	}{
+		{http.StatusOK, true},
+		{http.StatusCreated, true},
+		{http.StatusConflict, true},
+		{http.StatusBadRequest, false},
+		{http.StatusUnauthorized, false},
+		{http.StatusTooManyRequests, false},
+		{http.StatusNotImplemented, false},
+		{http.StatusServiceUnavailable, false},
+	} {
+		t.Run(http.StatusText(tc.status), func(t *testing.T) {
+			next := &countingHandler{status: tc.status}
+			handler := Idempotency(idempotency.NewMemoryStore(), time.Hour)(next)
+
+			idempotentRequest(t, handler, "member-1", "key-1", `{}`)
+			retry := idempotentRequest(t, handler, "member-1", "key-1", `{}`)
+
+			wantCalls := 2
+			if tc.kept {
+				wantCalls = 1
+			}
+			if next.calls != wantCalls {
+				t.Errorf("handler ran %d times, want %d", next.calls, wantCalls)
+			}
+			if retry.Code != tc.status {
+				t.Errorf("retry = %d, want %d", retry.Code, tc.status)
+			}
+		})
+	}
+}
+
+func TestIdempotencyEncryptsStoredBodies(t *testing.T) {
+	store := &recordingStore{Store: idempotency.NewMemoryStore()}
+	next := &countingHandler{status: http.StatusCreated}
+	handler := Idempotency(store, time.Hour)(next)
+
+	idempotentRequest(t, handler, "", "key-1", `{"email":"a@example.com"}`)
+
+	if len(store.completed) != 1 {
+		t.Fatalf("%d records stored, want 1", len(store.completed))
+	}
+	stored := store.completed[0].Body
+	if bytes.Contains(stored, []byte("secret-1")) || bytes.Contains(stored, []byte("a@example.com")) {
+		t.Errorf("stored body is readable: %q", stored)
+	}
+
+	// The key is derived from the request, so another request cannot
+	// read the body
+	if _, err := open(responseKey("", "key-1", http.MethodPost, "/api/v1/auth/register", []byte(`{"email":"b@example.com"}`)), stored); err == nil {
+		t.Error("body opened with the key of a different request")
+	}
+	body, err := open(responseKey("", "key-1", http.MethodPost, "/api/v1/auth/register", []byte(`{"email":"a@example.com"}`)), stored)
+	if err != nil || !bytes.Contains(body, []byte("secret-1")) {
+		t.Errorf("open = %q, %v; want the response", body, err)
+	}
+}
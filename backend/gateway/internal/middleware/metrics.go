@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Reasons recorded by gateway_auth_failures_total.
+const (
+	AuthFailureMissingHeader      = "missing_header"
+	AuthFailureMalformedHeader    = "malformed_header"
+	AuthFailureInvalidToken       = "invalid_token"
+	AuthFailureRevokedToken       = "revoked_token"
+	AuthFailureInvalidCredentials = "invalid_credentials"
+)
+
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gateway_http_requests_total",
+		Help: "HTTP requests handled, by route template, method and status code.",
+	}, []string{"route", "method", "code"})
+
+	httpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "gateway_http_request_duration_seconds",
+		Help:    "Time taken to handle HTTP requests, by route template and method.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"route", "method"})
+
+	rateLimited = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "gateway_rate_limited_requests_total",
+		Help: "Requests rejected by the rate limiter.",
+	})
+
+	authFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gateway_auth_failures_total",
+		Help: "Rejected bearer tokens and failed logins, by reason.",
+	}, []string{"reason"})
+
+	idempotentRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gateway_idempotent_requests_total",
+		Help: "Requests sent with an Idempotency-Key, by result.",
+	}, []string{"result"})
+)
+
+func init() {
+	for _, reason := range []string{
+		AuthFailureMissingHeader,
+		AuthFailureMalformedHeader,
+		AuthFailureInvalidToken,
+		AuthFailureRevokedToken,
+		AuthFailureInvalidCredentials,
+	} {
+		authFailures.WithLabelValues(reason)
+	}
+	for _, result := range []string{
+		IdempotencyStored,
+		IdempotencyReplayed,
+		IdempotencyMismatch,
+		IdempotencyInProgress,
+		IdempotencyStoreError,
+	} {
+		idempotentRequests.WithLabelValues(result)
+	}
+}
+
+// RecordAuthFailure counts a failed authentication attempt.
+func RecordAuthFailure(reason string) {
+	authFailures.WithLabelValues(reason).Inc()
+}
+
+// Metrics records the count and duration of requests per route. Labels use
+// the route template, not the path, so IDs do not create new series.
+func Metrics(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := "unknown"
+		if current := mux.CurrentRoute(r); current != nil {
+			if tpl, err := current.GetPathTemplate(); err == nil {
+				route = tpl
+			}
+		}
+
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		httpRequests.WithLabelValues(route, r.Method, strconv.Itoa(rec.status)).Inc()
+		httpDuration.WithLabelValues(route, r.Method).Observe(time.Since(start).Seconds())
+	})
+}
+
+// InitializeRouteMetrics creates the duration series of every route on r,
+// so they are exported as zero before the first request.
+func InitializeRouteMetrics(r *mux.Router) {
+	_ = r.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		// Subrouter prefixes have no handler of their own
+		tpl, err := route.GetPathTemplate()
+		if err != nil || route.GetHandler() == nil {
+			return nil
+		}
+		methods, err := route.GetMethods()
+		if err != nil {
+			// Routes without a method restriction, like the WebSocket
+			// upgrade, are only ever called with GET
+			methods = []string{http.MethodGet}
+		}
+		for _, method := range methods {
+			httpDuration.WithLabelValues(tpl, method)
+		}
+		return nil
+	})
+}
+
+// statusRecorder captures the response status for metrics and logging. It
+// passes Hijack through so WebSocket upgrades keep working.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := r.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not support hijacking")
+	}
+	r.status = http.StatusSwitchingProtocols
+	return hijacker.Hijack()
+}
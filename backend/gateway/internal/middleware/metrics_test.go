@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/metrics"
+)
+
+type noRevocations struct{}
+
+func (noRevocations) Revoked(string, time.Time) bool { return false }
+
+func TestMetricsEndpointExposesGatewayMetrics(t *testing.T) {
+	r := mux.NewRouter()
+	r.Use(Metrics)
+	r.Handle("/members/{id}", Auth("secret", noRevocations{})(http.NotFoundHandler())).Methods(http.MethodGet)
+	InitializeRouteMetrics(r)
+
+	// Unauthenticated, so it counts an auth failure under the route template
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/members/123", nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+
+	families, body := scrape(t, metrics.Handler())
+	for _, name := range []string{
+		"gateway_http_requests_total",
+		"gateway_http_request_duration_seconds",
+		"gateway_rate_limited_requests_total",
+		"gateway_auth_failures_total",
+		"gateway_idempotent_requests_total",
+		"go_goroutines",
+	} {
+		if !families[name] {
+			t.Errorf("metric %s not exposed", name)
+		}
+	}
+
+	for _, series := range []string{
+		`gateway_http_requests_total{code="401",method="GET",route="/members/{id}"} 1`,
+		`gateway_auth_failures_total{reason="missing_header"} 1`,
+	} {
+		if !strings.Contains(body, series) {
+			t.Errorf("series %s not exposed", series)
+		}
+	}
+	if strings.Contains(body, `route="/members/123"`) {
+		t.Error("requests are labelled with the path instead of the route template")
+	}
+}
+
+// scrape fetches /metrics from h over HTTP and returns the metric families
+// it exposes, with the raw body.
+func scrape(t *testing.T, h http.Handler) (map[string]bool, string) {
+	t.Helper()
+
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/metrics")
+	if err != nil {
+		t.Fatalf("scrape: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("scrape returned status %d", resp.StatusCode)
+	}
+
+	families := make(map[string]bool)
+	var body strings.Builder
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		body.WriteString(line + "\n")
+		// Every family is introduced by "# TYPE <name> <type>"
+		if fields := strings.Fields(line); len(fields) == 4 && fields[1] == "TYPE" {
+			families[fields[2]] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("read scrape: %v", err)
+	}
+	return families, body.String()
+}
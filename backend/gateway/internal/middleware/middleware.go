@@ -2,32 +2,107 @@ package middleware
 
 import (
 	"context"
-	"log"
+	"fmt"
+	"log/slog"
 	"net/http"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/auth"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/correlation"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/eventstore"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/interceptors"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/logging"
 )
 
 type contextKey string
 
 const UserIDKey contextKey = "userID"
 
+// ClaimsKey holds the *auth.Claims of the request's access token.
+const ClaimsKey contextKey = "claims"
+
+// maxRequestIDLength bounds client supplied request IDs, which end up in
+// logs and on every stored event.
+const maxRequestIDLength = 128
+
+// RequestID assigns every request an ID, reusing the client's X-Request-ID
+// when it is well-formed. The ID is returned in the response header and
+// forwarded to backend services as the correlation ID. The causation ID is
+// left empty: a client request is not a message or event, and services set
+// it from the message that triggers later work.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(correlation.HeaderRequestID)
+		if !validRequestID(requestID) {
+			requestID = uuid.New().String()
+		}
+		w.Header().Set(correlation.HeaderRequestID, requestID)
+
+		ctx := correlation.NewContext(r.Context(), eventstore.Metadata{
+			CorrelationID: requestID,
+		})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '-', c == '_', c == '.', c == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// loggedUserKey holds the user ID Auth found for the request. Auth runs on
+// subrouters, inside Logging, so it reports the user back through the
+// context Logging created.
+type loggedUserKey struct{}
+
+// Logging writes one structured log line per request with its status,
+// duration, request ID, trace ID and authenticated user.
 func Logging(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
-		next.ServeHTTP(w, r)
-		log.Printf("%s %s %s", r.Method, r.RequestURI, time.Since(start))
+		userID := new(string)
+		ctx := context.WithValue(r.Context(), loggedUserKey{}, userID)
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r.WithContext(ctx))
+
+		level := slog.LevelInfo
+		if rec.status >= http.StatusInternalServerError {
+			level = slog.LevelError
+		}
+		attrs := []any{
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", rec.status,
+			"duration_ms", time.Since(start).Milliseconds(),
+		}
+		if *userID != "" {
+			attrs = append(attrs, "user_id", *userID)
+		}
+		logging.FromContext(ctx).Log(ctx, level, "http request", attrs...)
 	})
 }
 
 func CORS(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Request-ID, X-App-Version, X-Platform, X-Country")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Request-ID")
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
@@ -38,47 +113,97 @@ func CORS(next http.Handler) http.Handler {
 	})
 }
 
-func Auth(jwtSecret string) func(http.Handler) http.Handler {
+// RevocationChecker reports whether a member's token issued at issuedAt was
+// revoked because they were signed out everywhere.
+type RevocationChecker interface {
+	Revoked(memberID string, issuedAt time.Time) bool
+}
+
+func Auth(jwtSecret string, revocations RevocationChecker) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			authHeader := r.Header.Get("Authorization")
 			if authHeader == "" {
-				http.Error(w, "missing authorization header", http.StatusUnauthorized)
+				RecordAuthFailure(AuthFailureMissingHeader)
+				WriteProblem(w, http.StatusUnauthorized, "missing authorization header")
 				return
 			}
 
 			parts := strings.Split(authHeader, " ")
 			if len(parts) != 2 || parts[0] != "Bearer" {
-				http.Error(w, "invalid authorization header", http.StatusUnauthorized)
+				RecordAuthFailure(AuthFailureMalformedHeader)
+				WriteProblem(w, http.StatusUnauthorized, "invalid authorization header")
 				return
 			}
 
-			token, err := jwt.Parse(parts[1], func(token *jwt.Token) (interface{}, error) {
+			claims := &auth.Claims{}
+			token, err := jwt.ParseWithClaims(parts[1], claims, func(token *jwt.Token) (interface{}, error) {
 				return []byte(jwtSecret), nil
 			})
 			if err != nil || !token.Valid {
-				http.Error(w, "invalid token", http.StatusUnauthorized)
+				RecordAuthFailure(AuthFailureInvalidToken)
+				WriteProblem(w, http.StatusUnauthorized, "invalid token")
 				return
 			}
 
-			claims, ok := token.Claims.(jwt.MapClaims)
-			if !ok {
-				http.Error(w, "invalid token claims", http.StatusUnauthorized)
+			// A refresh token lives longer and is only meant for the
+			// refresh endpoint
+			if claims.Type == auth.TokenTypeRefresh {
+				RecordAuthFailure(AuthFailureInvalidToken)
+				WriteProblem(w, http.StatusUnauthorized, "refresh tokens cannot be used for requests")
 				return
 			}
 
-			userID, ok := claims["sub"].(string)
-			if !ok {
-				http.Error(w, "invalid token subject", http.StatusUnauthorized)
+			userID := claims.UserID
+			if userID == "" {
+				RecordAuthFailure(AuthFailureInvalidToken)
+				WriteProblem(w, http.StatusUnauthorized, "invalid token subject")
 				return
 			}
 
+			// Tokens without an issue time cannot be checked and are
+			// treated as issued before any revocation
+			var issuedAt time.Time
+			if claims.IssuedAt != nil {
+				issuedAt = claims.IssuedAt.Time
+			}
+			if revocations.Revoked(userID, issuedAt) {
+				RecordAuthFailure(AuthFailureRevokedToken)
+				WriteProblem(w, http.StatusUnauthorized, "token has been revoked, sign in again")
+				return
+			}
+
+			if logged, ok := r.Context().Value(loggedUserKey{}).(*string); ok {
+				*logged = userID
+			}
+
 			ctx := context.WithValue(r.Context(), UserIDKey, userID)
+			ctx = context.WithValue(ctx, ClaimsKey, claims)
+			// Services verify the token again rather than trust the user ID
+			ctx = interceptors.NewMemberTokenContext(ctx, parts[1])
+			md := correlation.FromContext(ctx)
+			md.UserID = userID
+			ctx = correlation.NewContext(ctx, md)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
 
+// RequireScope rejects requests whose token does not carry scope with a
+// 403 problem. It must run after Auth.
+func RequireScope(scope string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, _ := r.Context().Value(ClaimsKey).(*auth.Claims)
+			if claims == nil || !claims.HasScope(scope) {
+				WriteProblem(w, http.StatusForbidden, fmt.Sprintf("this endpoint requires the %s scope", scope))
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
 type rateLimiter struct {
 	mu       sync.Mutex
 	requests map[string][]time.Time
@@ -110,7 +235,8 @@ func RateLimiter(next http.Handler) http.Handler {
 
 		if len(valid) >= limiter.limit {
 			limiter.mu.Unlock()
-			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			rateLimited.Inc()
+			WriteProblem(w, http.StatusTooManyRequests, "rate limit exceeded")
 			return
 		}
 
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/auth"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/correlation"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/eventstore"
+)
+
+func TestRequestIDCorrelatesWithoutCausation(t *testing.T) {
+	var md eventstore.Metadata
+	handler := RequestID(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		md = correlation.FromContext(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/profile", nil)
+	req.Header.Set(correlation.HeaderRequestID, "client-request-1")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if md.CorrelationID != "client-request-1" || md.CausationID != "" {
+		t.Errorf("metadata = %+v, want correlation client-request-1 and no causation", md)
+	}
+	if got := rec.Header().Get(correlation.HeaderRequestID); got != "client-request-1" {
+		t.Errorf("%s = %q, want client-request-1", correlation.HeaderRequestID, got)
+	}
+}
+
+// A refresh token outlives the access token and must only be exchanged for
+// new tokens.
+func TestAuthRejectsRefreshTokens(t *testing.T) {
+	const secret = "test-secret-that-is-long-enough-0123"
+	pair, err := auth.NewJWTService(secret, time.Hour, 24*time.Hour).GenerateTokenPair("11111111-2222-3333-4444-555555555555", "member@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := Auth(secret, noRevocations{})(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	for token, want := range map[string]int{
+		pair.AccessToken:  http.StatusNoContent,
+		pair.RefreshToken: http.StatusUnauthorized,
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/profile", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != want {
+			t.Errorf("status = %d, want %d", rec.Code, want)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi2"
+	"github.com/getkin/kin-openapi/openapi2conv"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/gorilla/mux"
+	"github.com/invopop/yaml"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/logging"
+)
+
+// maxJSONBodySize bounds JSON request bodies. Uploads are multipart and
+// limited by their handler.
+const maxJSONBodySize = 64 << 10
+
+// OpenAPI enforces an OpenAPI document: the gateway's own,
+// api/openapi/gateway.yaml, or the one generated from member.proto for the
+// transcoded /api/v2 routes. Routes are looked up by the template
+// gorilla/mux matched, so a route's template must equal its path in the
+// document after the server's base path, including the names of path
+// parameters.
+type OpenAPI struct {
+	doc               *openapi3.T
+	basePath          string
+	validateResponses bool
+}
+
+// LoadOpenAPI reads and checks the document at path, which may be an
+// OpenAPI 3 document or a Swagger 2.0 one as protoc-gen-openapiv2 writes.
+// With validateResponses, responses that do not match the document are
+// logged, which is meant for development.
+func LoadOpenAPI(path string, validateResponses bool) (*OpenAPI, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("load %s: %w", path, err)
+	}
+	var version struct {
+		Swagger string `json:"swagger"`
+	}
+	if err := yaml.Unmarshal(data, &version); err != nil {
+		return nil, fmt.Errorf("load %s: %w", path, err)
+	}
+
+	loader := openapi3.NewLoader()
+	var doc *openapi3.T
+	basePath := ""
+	if version.Swagger != "" {
+		var doc2 openapi2.T
+		if err := yaml.Unmarshal(data, &doc2); err != nil {
+			return nil, fmt.Errorf("load %s: %w", path, err)
+		}
+		if doc, err = openapi2conv.ToV3(&doc2); err != nil {
+			return nil, fmt.Errorf("convert %s: %w", path, err)
+		}
+		// Without a host the conversion drops the base path
+		basePath = doc2.BasePath
+	} else if doc, err = loader.LoadFromFile(path); err != nil {
+		return nil, fmt.Errorf("load %s: %w", path, err)
+	}
+	if err := doc.Validate(loader.Context); err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI document %s: %w", path, err)
+	}
+
+	if len(doc.Servers) > 0 {
+		if basePath, err = doc.Servers[0].BasePath(); err != nil {
+			return nil, fmt.Errorf("server URL of %s: %w", path, err)
+		}
+	}
+	return &OpenAPI{doc: doc, basePath: strings.TrimSuffix(basePath, "/"), validateResponses: validateResponses}, nil
+}
+
+// Route is an operation of the document, with its path as a gorilla/mux
+// template including the base path.
+type Route struct {
+	Method string
+	Path   string
+}
+
+// Routes returns every operation in the document, for serving them from
+// one handler while still validating each against its own operation.
+func (o *OpenAPI) Routes() []Route {
+	var routes []Route
+	for _, path := range o.doc.Paths.InMatchingOrder() {
+		for method := range o.doc.Paths.Value(path).Operations() {
+			routes = append(routes, Route{Method: method, Path: o.basePath + path})
+		}
+	}
+	return routes
+}
+
+func (o *OpenAPI) operation(tpl, method string) *openapi3.Operation {
+	item := o.doc.Paths.Value(strings.TrimPrefix(tpl, o.basePath))
+	if item == nil {
+		return nil
+	}
+	return item.GetOperation(method)
+}
+
+// Validate rejects requests whose parameters, headers or body do not match
+// the document with a 400 problem listing every violation. It must run
+// after Auth, so unauthenticated requests are rejected first.
+func (o *OpenAPI) Validate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := mux.CurrentRoute(r)
+		if current == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		tpl, err := current.GetPathTemplate()
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		operation := o.operation(tpl, r.Method)
+		if operation == nil {
+			// The router tests ensure this does not happen for served routes
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		multipart := strings.HasPrefix(mediaType, "multipart/")
+		if !multipart {
+			r.Body = http.MaxBytesReader(w, r.Body, maxJSONBodySize)
+		}
+
+		path := strings.TrimPrefix(tpl, o.basePath)
+		input := &openapi3filter.RequestValidationInput{
+			Request:    r,
+			PathParams: mux.Vars(r),
+			Route: &routers.Route{
+				Spec:      o.doc,
+				Path:      path,
+				PathItem:  o.doc.Paths.Value(path),
+				Method:    r.Method,
+				Operation: operation,
+			},
+			Options: &openapi3filter.Options{
+				// Uploads are checked by their handler, which streams them
+				ExcludeRequestBody:  multipart,
+				MultiError:          true,
+				SkipSettingDefaults: true,
+				// Auth checks the bearer token
+				AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+			},
+		}
+		if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				WriteProblem(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("request body exceeds %d bytes", tooLarge.Limit))
+				return
+			}
+			WriteProblem(w, http.StatusBadRequest, "request does not match the API specification", violations("", err)...)
+			return
+		}
+
+		// Upgraded connections have no response to check
+		if !o.validateResponses || r.Header.Get("Upgrade") != "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		capture := &responseCapture{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(capture, r)
+		err = openapi3filter.ValidateResponse(r.Context(), &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: input,
+			Status:                 capture.status,
+			Header:                 w.Header(),
+			Body:                   io.NopCloser(bytes.NewReader(capture.body.Bytes())),
+			Options:                &openapi3filter.Options{MultiError: true},
+		})
+		if err != nil {
+			logging.FromContext(r.Context()).ErrorContext(r.Context(), "response does not match the API specification",
+				"route", tpl, "status", capture.status, "error", err)
+		}
+	})
+}
+
+// violations flattens a validation error into one violation per problem.
+// Fields are named like body.genders.0 or query.limit.
+func violations(field string, err error) []Violation {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		var all []Violation
+		for _, err := range e {
+			all = append(all, violations(field, err)...)
+		}
+		return all
+	case *openapi3filter.RequestError:
+		switch {
+		case e.Parameter != nil:
+			field = e.Parameter.In + "." + e.Parameter.Name
+		case e.RequestBody != nil:
+			field = "body"
+		}
+		if e.Err == nil {
+			return []Violation{{Field: field, Message: e.Reason}}
+		}
+		return violations(field, e.Err)
+	case *openapi3.SchemaError:
+		for _, part := range e.JSONPointer() {
+			field += "." + part
+		}
+		return []Violation{{Field: strings.TrimPrefix(field, "."), Message: e.Reason}}
+	case *openapi3filter.ParseError:
+		return []Violation{{Field: field, Message: e.Error()}}
+	default:
+		return []Violation{{Field: field, Message: err.Error()}}
+	}
+}
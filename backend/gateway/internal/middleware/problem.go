@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Problem is an error response in the RFC 9457 problem details format,
+// which every error from the gateway uses.
+type Problem struct {
+	Type   string      `json:"type"`
+	Title  string      `json:"title"`
+	Status int         `json:"status"`
+	Detail string      `json:"detail,omitempty"`
+	Errors []Violation `json:"errors,omitempty"`
+}
+
+// Violation is one problem with a request, such as a missing property.
+type Violation struct {
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// WriteProblem writes an application/problem+json response.
+func WriteProblem(w http.ResponseWriter, status int, detail string, violations ...Violation) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+		Errors: violations,
+	})
+}
@@ -1,50 +1,143 @@
 package router
 
 import (
+	"net/http"
+	"strings"
+
 	"github.com/gorilla/mux"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
+
 	"github.com/mattuttis/inetcontrol/zoekdeware/backend/gateway/internal/config"
 	"github.com/mattuttis/inetcontrol/zoekdeware/backend/gateway/internal/handlers"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/gateway/internal/idempotency"
 	"github.com/mattuttis/inetcontrol/zoekdeware/backend/gateway/internal/middleware"
+	sharedauth "github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/auth"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/health"
 )
 
-func New(cfg *config.Config, h *handlers.Handlers) *mux.Router {
+func New(cfg *config.Config, h *handlers.Handlers, checks *health.Health, responses idempotency.Store, spec, memberSpec *middleware.OpenAPI, members http.Handler, revocations middleware.RevocationChecker) *mux.Router {
 	r := mux.NewRouter()
 
+	// Tokens issued before a member was signed out everywhere are rejected
+	authenticate := middleware.Auth(cfg.JWTSecret, revocations)
+
+	// One span per route, named after the route template
+	r.Use(otelmux.Middleware("gateway"))
+	r.Use(middleware.Metrics)
+	r.Use(middleware.RequestID)
 	r.Use(middleware.Logging)
 	r.Use(middleware.CORS)
 	r.Use(middleware.RateLimiter)
 
-	r.HandleFunc("/health", h.Health).Methods("GET")
+	// /health is kept as an alias of /livez for existing clients
+	r.Handle("/livez", checks.LiveHandler()).Methods("GET")
+	r.Handle("/health", checks.LiveHandler()).Methods("GET")
+	r.Handle("/readyz", checks.ReadyHandler()).Methods("GET")
+
+	// Requests mobile clients retry on flaky networks honour an
+	// Idempotency-Key, so a retry does not register, swipe or send twice.
+	// Swipes and messages answer 501 until the matching and messaging
+	// services exist, and those responses are not kept.
+	idempotent := func(handler http.HandlerFunc) http.Handler {
+		return middleware.Idempotency(responses, cfg.IdempotencyTTL)(handler)
+	}
 
 	api := r.PathPrefix("/api/v1").Subrouter()
 
+	// Requests are validated against the OpenAPI document once they are
+	// authenticated
 	auth := api.PathPrefix("/auth").Subrouter()
-	auth.HandleFunc("/register", h.Register).Methods("POST")
+	auth.Use(spec.Validate)
+	auth.Handle("/register", idempotent(h.Register)).Methods("POST")
 	auth.HandleFunc("/login", h.Login).Methods("POST")
 	auth.HandleFunc("/refresh", h.RefreshToken).Methods("POST")
+	auth.HandleFunc("/verify-email", h.VerifyEmail).Methods("POST")
+
+	// Staff endpoints need a scope from the roles in the access token. The
+	// member service checks the roles again and records every call in its
+	// audit log.
+	admin := api.PathPrefix("/admin").Subrouter()
+	admin.Use(authenticate)
+	scoped := func(scope string, handler http.HandlerFunc) http.Handler {
+		return middleware.RequireScope(scope)(spec.Validate(handler))
+	}
+	admin.Handle("/members", scoped(sharedauth.ScopeMembersRead, h.AdminSearchMembers)).Methods("GET")
+	admin.Handle("/members/{id}/history", scoped(sharedauth.ScopeMembersRead, h.AdminGetMemberHistory)).Methods("GET")
+	admin.Handle("/members/{id}/suspend", scoped(sharedauth.ScopeMembersWrite, h.AdminSuspendMember)).Methods("POST")
+	admin.Handle("/members/{id}/reinstate", scoped(sharedauth.ScopeMembersWrite, h.AdminReinstateMember)).Methods("POST")
+	admin.Handle("/members/{id}/sessions/revoke", scoped(sharedauth.ScopeSessionsRevoke, h.AdminRevokeSessions)).Methods("POST")
+	admin.Handle("/members/{id}/roles", scoped(sharedauth.ScopeMembersWrite, h.AdminSetMemberRoles)).Methods("PUT")
+	admin.Handle("/audit-log", scoped(sharedauth.ScopeAuditRead, h.AdminListAuditLog)).Methods("GET")
 
 	protected := api.PathPrefix("").Subrouter()
-	protected.Use(middleware.Auth(cfg.JWTSecret))
+	protected.Use(authenticate)
+	protected.Use(spec.Validate)
 
 	protected.HandleFunc("/profile", h.GetProfile).Methods("GET")
 	protected.HandleFunc("/profile", h.UpdateProfile).Methods("PUT")
+	protected.HandleFunc("/profile/email/verification", h.SendEmailVerification).Methods("POST")
+	protected.HandleFunc("/onboarding", h.GetOnboarding).Methods("GET")
+	protected.HandleFunc("/flags", h.GetFlags).Methods("GET")
+	protected.HandleFunc("/experiments/{key}", h.GetExperiment).Methods("GET")
+	protected.HandleFunc("/profile/photos/order", h.ReorderPhotos).Methods("PUT")
+	protected.HandleFunc("/profile/photos/primary", h.SetPrimaryPhoto).Methods("PUT")
+	protected.HandleFunc("/profile/interests", h.SetInterests).Methods("PUT")
+	protected.HandleFunc("/interests", h.GetInterests).Methods("GET")
+	// The order route must be registered before the {id} routes it would
+	// otherwise match.
+	protected.HandleFunc("/profile/prompts/order", h.ReorderPrompts).Methods("PUT")
+	protected.HandleFunc("/profile/prompts/{id}", h.AnswerPrompt).Methods("PUT")
+	protected.HandleFunc("/profile/prompts/{id}", h.RemovePrompt).Methods("DELETE")
+	protected.HandleFunc("/prompts", h.GetPrompts).Methods("GET")
+	protected.HandleFunc("/preferences", h.GetPreferences).Methods("GET")
+	protected.HandleFunc("/preferences", h.UpdatePreferences).Methods("PUT")
 
 	protected.HandleFunc("/discover", h.Discover).Methods("GET")
-	protected.HandleFunc("/swipe", h.Swipe).Methods("POST")
+	protected.Handle("/swipe", idempotent(h.Swipe)).Methods("POST")
 	protected.HandleFunc("/matches", h.GetMatches).Methods("GET")
 
 	protected.HandleFunc("/conversations", h.GetConversations).Methods("GET")
 	protected.HandleFunc("/conversations/{id}", h.GetConversation).Methods("GET")
-	protected.HandleFunc("/conversations/{id}/messages", h.SendMessage).Methods("POST")
+	protected.Handle("/conversations/{id}/messages", idempotent(h.SendMessage)).Methods("POST")
 
 	protected.HandleFunc("/media", h.UploadMedia).Methods("POST")
 	protected.HandleFunc("/media/{id}", h.DeleteMedia).Methods("DELETE")
 
+	protected.HandleFunc("/devices", h.RegisterDevice).Methods("POST")
+	protected.HandleFunc("/devices/{token}", h.UnregisterDevice).Methods("DELETE")
+	protected.HandleFunc("/notifications/preferences", h.GetNotificationPreferences).Methods("GET")
+	protected.HandleFunc("/notifications/preferences", h.UpdateNotificationPreferences).Methods("PUT")
+
+	protected.HandleFunc("/members/{id}/block", h.BlockMember).Methods("POST")
+	protected.HandleFunc("/members/{id}/block", h.UnblockMember).Methods("DELETE")
+	protected.HandleFunc("/members/{id}/report", h.ReportMember).Methods("POST")
+	protected.HandleFunc("/blocks", h.GetBlockedMembers).Methods("GET")
+
 	protected.HandleFunc("/location", h.UpdateLocation).Methods("PUT")
 
 	ws := api.PathPrefix("/ws").Subrouter()
-	ws.Use(middleware.Auth(cfg.JWTSecret))
+	ws.Use(authenticate)
+	ws.Use(spec.Validate)
 	ws.HandleFunc("/chat", h.WebSocketChat)
 
+	// /api/v2 is transcoded from member.proto, which also generates its
+	// OpenAPI document, api/openapi/member.swagger.yaml. Each operation in
+	// it gets its own route, so requests are validated against it and
+	// writes honour an Idempotency-Key like the v1 routes; requests for
+	// anything else do not reach the member service.
+	v2 := r.PathPrefix("/api/v2").Subrouter()
+	v2.Use(authenticate)
+	v2.Use(memberSpec.Validate)
+	transcoded := http.StripPrefix("/api", members)
+	for _, route := range memberSpec.Routes() {
+		handler := transcoded
+		if route.Method != http.MethodGet {
+			handler = middleware.Idempotency(responses, cfg.IdempotencyTTL)(transcoded)
+		}
+		v2.Handle(strings.TrimPrefix(route.Path, "/api/v2"), handler).Methods(route.Method)
+	}
+
+	middleware.InitializeRouteMetrics(r)
+
 	return r
 }
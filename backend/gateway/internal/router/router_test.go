@@ -0,0 +1,168 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gorilla/mux"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/gateway/internal/config"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/gateway/internal/handlers"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/gateway/internal/idempotency"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/gateway/internal/middleware"
+	sharedauth "github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/auth"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/health"
+)
+
+const (
+	specPath       = "../../../../api/openapi/gateway.yaml"
+	memberSpecPath = "../../../../api/openapi/member.swagger.yaml"
+)
+
+type noRevocations struct{}
+
+func (noRevocations) Revoked(string, time.Time) bool { return false }
+
+// TestRoutesAreInOpenAPIDocument fails for every route under the
+// document's base path that the document does not describe, so requests to
+// it would not be validated.
+func TestRoutesAreInOpenAPIDocument(t *testing.T) {
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromFile(specPath)
+	if err != nil {
+		t.Fatalf("load OpenAPI document: %v", err)
+	}
+	basePath, err := doc.Servers[0].BasePath()
+	if err != nil {
+		t.Fatalf("server URL: %v", err)
+	}
+	basePath = strings.TrimSuffix(basePath, "/")
+
+	r := newRouter(t, http.NotFoundHandler())
+
+	routes := 0
+	err = r.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		tpl, err := route.GetPathTemplate()
+		if err != nil || route.GetHandler() == nil || !strings.HasPrefix(tpl, basePath+"/") {
+			return nil
+		}
+		methods, err := route.GetMethods()
+		if err != nil {
+			// The WebSocket upgrade has no method restriction but is
+			// always a GET
+			methods = []string{http.MethodGet}
+		}
+		for _, method := range methods {
+			routes++
+			item := doc.Paths.Value(strings.TrimPrefix(tpl, basePath))
+			if item == nil || item.GetOperation(method) == nil {
+				t.Errorf("%s %s is not in the OpenAPI document", method, tpl)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("walk routes: %v", err)
+	}
+	if routes == 0 {
+		t.Fatalf("no routes under %s", basePath)
+	}
+}
+
+func newRouter(t *testing.T, members http.Handler) *mux.Router {
+	t.Helper()
+	spec, err := middleware.LoadOpenAPI(specPath, false)
+	if err != nil {
+		t.Fatalf("load OpenAPI middleware: %v", err)
+	}
+	memberSpec, err := middleware.LoadOpenAPI(memberSpecPath, false)
+	if err != nil {
+		t.Fatalf("load member OpenAPI middleware: %v", err)
+	}
+	cfg := &config.Config{JWTSecret: jwtSecret, IdempotencyTTL: time.Hour}
+	h := handlers.NewHandlers(nil, nil, nil, nil, nil, nil, nil, cfg.JWTSecret)
+	return New(cfg, h, health.New(), idempotency.NewMemoryStore(), spec, memberSpec, members, noRevocations{})
+}
+
+const jwtSecret = "test-secret-that-is-long-enough-0123"
+
+// v2Request sends an authenticated request to r and returns the response.
+func v2Request(t *testing.T, r http.Handler, method, path, body string, header http.Header) *httptest.ResponseRecorder {
+	t.Helper()
+	pair, err := sharedauth.NewJWTService(jwtSecret, time.Hour, time.Hour).GenerateTokenPair("11111111-2222-3333-4444-555555555555", "member@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+pair.AccessToken)
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	return rec
+}
+
+// The transcoded routes are validated against the document generated from
+// member.proto before they reach the member service.
+func TestV2RequestsAreValidated(t *testing.T) {
+	calls := 0
+	r := newRouter(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		name    string
+		method  string
+		path    string
+		body    string
+		want    int
+		reached bool
+	}{
+		{"valid", http.MethodPost, "/api/v2/members/me/blocks", `{"blocked_member_id":"m-2"}`, http.StatusOK, true},
+		{"wrong type", http.MethodPost, "/api/v2/members/me/blocks", `{"blocked_member_id":42}`, http.StatusBadRequest, false},
+		{"unknown enum", http.MethodPatch, "/api/v2/members/me/preferences", `{"genders":["GENDER_ROBOT"]}`, http.StatusBadRequest, false},
+		{"not in the document", http.MethodPost, "/api/v2/members/me/photos", `{}`, http.StatusNotFound, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls = 0
+			rec := v2Request(t, r, tt.method, tt.path, tt.body, nil)
+			if rec.Code != tt.want {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.want, rec.Body)
+			}
+			if reached := calls > 0; reached != tt.reached {
+				t.Errorf("reached the member service: %v, want %v", reached, tt.reached)
+			}
+		})
+	}
+}
+
+func TestV2WritesHonourIdempotencyKey(t *testing.T) {
+	calls := 0
+	r := newRouter(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"call":%d}`, calls)
+	}))
+
+	header := http.Header{"Idempotency-Key": {"block-1"}}
+	first := v2Request(t, r, http.MethodPost, "/api/v2/members/me/blocks", `{"blocked_member_id":"m-2"}`, header)
+	retry := v2Request(t, r, http.MethodPost, "/api/v2/members/me/blocks", `{"blocked_member_id":"m-2"}`, header)
+
+	if calls != 1 {
+		t.Fatalf("member service called %d times, want 1", calls)
+	}
+	if retry.Code != http.StatusOK || retry.Body.String() != first.Body.String() || retry.Header().Get("Idempotent-Replayed") != "true" {
+		t.Errorf("retry = %d %q replayed=%q, want the first response replayed", retry.Code, retry.Body, retry.Header().Get("Idempotent-Replayed"))
+	}
+}
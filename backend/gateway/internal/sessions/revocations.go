@@ -0,0 +1,115 @@
+// Package sessions tracks members who were signed out everywhere, so the
+// gateway can reject the tokens they were issued before.
+package sessions
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	memberv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/member/v1"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/messaging"
+)
+
+// RevokedTopic is where the member service announces that a member was
+// signed out everywhere.
+const RevokedTopic = "member.sessions_revoked"
+
+// Revocations keeps a local copy of recent session revocations, so
+// checking a token never waits on the network. Revocations older than the
+// longest token lifetime can no longer matter and are dropped.
+type Revocations struct {
+	client memberv1.MemberServiceClient
+	window time.Duration
+
+	mu      sync.RWMutex
+	revoked map[string]time.Time
+}
+
+// NewRevocations creates a copy of the revocations made within window,
+// which must be at least the lifetime of the longest-lived token.
+func NewRevocations(client memberv1.MemberServiceClient, window time.Duration) *Revocations {
+	return &Revocations{client: client, window: window, revoked: make(map[string]time.Time)}
+}
+
+// Refresh replaces the local copy with the revocations from the member
+// service.
+func (r *Revocations) Refresh(ctx context.Context) error {
+	resp, err := r.client.ListSessionRevocations(ctx, &memberv1.ListSessionRevocationsRequest{
+		Since: timestamppb.New(time.Now().Add(-r.window)),
+	})
+	if err != nil {
+		return err
+	}
+
+	revoked := make(map[string]time.Time, len(resp.Revocations))
+	for _, rev := range resp.Revocations {
+		revoked[rev.MemberId] = rev.RevokedAt.AsTime()
+	}
+	r.mu.Lock()
+	// Keep revocations announced while the list was being fetched
+	for memberID, at := range r.revoked {
+		if at.After(revoked[memberID]) && time.Since(at) < r.window {
+			revoked[memberID] = at
+		}
+	}
+	r.revoked = revoked
+	r.mu.Unlock()
+	return nil
+}
+
+// Run refreshes the revocations every interval until ctx is cancelled. It
+// keeps the copy current when an announcement is missed.
+func (r *Revocations) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := r.Refresh(ctx); err != nil && ctx.Err() == nil {
+			slog.WarnContext(ctx, "failed to refresh session revocations", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Listen applies revocations announced on RevokedTopic, so a member signed
+// out on one instance is signed out on all of them within moments.
+func (r *Revocations) Listen(ctx context.Context, subscriber messaging.BroadcastSubscriber) error {
+	return subscriber.SubscribeAll(ctx, RevokedTopic, func(_ context.Context, msg messaging.Message) error {
+		var event struct {
+			MemberID  string
+			Timestamp time.Time
+		}
+		if err := json.Unmarshal(msg.Payload, &event); err != nil {
+			return err
+		}
+		r.revoke(event.MemberID, event.Timestamp)
+		return nil
+	})
+}
+
+func (r *Revocations) revoke(memberID string, at time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if at.After(r.revoked[memberID]) {
+		r.revoked[memberID] = at
+	}
+}
+
+// Revoked reports whether a token the member was issued at issuedAt has
+// been revoked. Token times are in whole seconds, so a token issued in the
+// same second as the revocation counts as revoked.
+func (r *Revocations) Revoked(memberID string, issuedAt time.Time) bool {
+	r.mu.RLock()
+	at, ok := r.revoked[memberID]
+	r.mu.RUnlock()
+	return ok && !issuedAt.After(at.Truncate(time.Second))
+}
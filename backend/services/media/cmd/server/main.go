@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	_ "github.com/lib/pq"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	mediav1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/media/v1"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/application"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/config"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/infrastructure/imaging"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/infrastructure/outbox"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/infrastructure/persistence"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/infrastructure/storage"
+	grpchandler "github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/interfaces/grpc"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/correlation"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/grpcclient"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/interceptors"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/logging"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/messaging"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/mtls"
+)
+
+// localMediaPrefix is the HTTP path the local blob store is served under.
+const localMediaPrefix = "/media/"
+
+func main() {
+	cfg := config.Load()
+	logging.Setup("media")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Database connection
+	db, err := sql.Open("postgres", cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.PingContext(ctx); err != nil {
+		log.Printf("warning: database not available: %v", err)
+	}
+
+	// Photo events are stored in the outbox with the photos and published
+	// while the broker is available
+	broker, err := messaging.NewNATSBroker(cfg.NATSURL, "media")
+	if err != nil {
+		log.Printf("warning: message broker not available, photo events stay in the outbox: %v", err)
+	} else {
+		defer broker.Close()
+		relay := outbox.NewRelay(db, broker, cfg.OutboxInterval)
+		go relay.Run(ctx)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	})
+
+	// Blob storage
+	var store application.BlobStore
+	switch cfg.StorageBackend {
+	case "local":
+		publicURL := cfg.PublicURL
+		if publicURL == "" {
+			publicURL = "http://localhost:8084/media"
+		}
+		local, err := storage.NewLocalStore(cfg.LocalStorageDir, publicURL)
+		if err != nil {
+			log.Fatalf("failed to initialize local storage: %v", err)
+		}
+		mux.Handle(localMediaPrefix, http.StripPrefix(localMediaPrefix, local.Handler()))
+		store = local
+	case "s3":
+		s3, err := storage.NewS3Store(ctx, storage.S3Config{
+			Endpoint:  cfg.S3Endpoint,
+			Region:    cfg.S3Region,
+			Bucket:    cfg.S3Bucket,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+			UseSSL:    cfg.S3UseSSL,
+			PublicURL: cfg.PublicURL,
+		})
+		if err != nil {
+			log.Fatalf("failed to initialize s3 storage: %v", err)
+		}
+		store = s3
+	}
+
+	// Initialize repository and service
+	repo := persistence.NewPostgresPhotoRepository(db)
+	processor := imaging.NewProcessor(imaging.DefaultVariants)
+	mediaService := application.NewMediaService(repo, store, processor)
+
+	// Initialize gRPC handler
+	mediaHandler := grpchandler.NewMediaHandler(mediaService)
+
+	// Callers authenticate as in the member service; the member a call is
+	// made for is taken from the access token the gateway forwards
+	tokens, err := interceptors.ParseTokens(cfg.ServiceTokens)
+	if err != nil {
+		log.Fatalf("invalid SERVICE_TOKENS: %v", err)
+	}
+	authenticate := interceptors.FirstOf(
+		interceptors.SPIFFEAuthenticator(cfg.SPIFFETrustDomain, grpchandler.CallerGateway, grpchandler.CallerAdmin),
+		interceptors.TokenAuthenticator(tokens),
+	)
+
+	serverCreds, err := mtls.ServerCredentials(cfg.TLS)
+	if err != nil {
+		log.Fatalf("failed to load TLS credentials: %v", err)
+	}
+
+	// Create gRPC server. The receive limit leaves headroom above the
+	// upload limit for the rest of the request.
+	grpcServer := grpc.NewServer(
+		grpc.Creds(serverCreds),
+		grpc.MaxRecvMsgSize(grpchandler.MaxUploadSize+1<<20),
+		grpcclient.ServerKeepalive(),
+		grpc.ChainUnaryInterceptor(
+			correlation.UnaryServerInterceptor(),
+			logging.UnaryServerInterceptor(),
+			interceptors.Authorize(authenticate, grpchandler.Policies,
+				interceptors.WithMembers(interceptors.JWTMemberAuthenticator(cfg.JWTSecret)),
+			),
+		),
+	)
+	mediav1.RegisterMediaServiceServer(grpcServer, mediaHandler)
+	reflection.Register(grpcServer) // Enable reflection for grpcurl
+
+	httpServer := &http.Server{
+		Addr:         cfg.HTTPAddr,
+		Handler:      mux,
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 30 * time.Second,
+	}
+
+	// Start gRPC server
+	go func() {
+		lis, err := net.Listen("tcp", cfg.GRPCAddr)
+		if err != nil {
+			log.Fatalf("failed to listen: %v", err)
+		}
+		log.Printf("gRPC server listening on %s", cfg.GRPCAddr)
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Fatalf("failed to serve gRPC: %v", err)
+		}
+	}()
+
+	// Start HTTP server
+	go func() {
+		log.Printf("HTTP server listening on %s", httpServer.Addr)
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("failed to serve HTTP: %v", err)
+		}
+	}()
+
+	// Graceful shutdown
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	log.Println("Shutting down servers...")
+	grpcServer.GracefulStop()
+	_ = httpServer.Shutdown(ctx)
+}
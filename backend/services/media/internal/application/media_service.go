@@ -0,0 +1,133 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/domain/aggregate"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/domain/commands"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/domain/repository"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/infrastructure/imaging"
+)
+
+// BlobStore stores processed photo variants.
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
+}
+
+type MediaService struct {
+	repo      repository.PhotoRepository
+	store     BlobStore
+	processor *imaging.Processor
+}
+
+// NewMediaService creates the media service. Photo events are stored with
+// the photos by repo and published from the outbox.
+func NewMediaService(repo repository.PhotoRepository, store BlobStore, processor *imaging.Processor) *MediaService {
+	return &MediaService{
+		repo:      repo,
+		store:     store,
+		processor: processor,
+	}
+}
+
+// UploadPhoto validates and processes the upload, stores every variant and
+// announces the new photo so the member service can attach it to the profile.
+// Members at the photo limit are turned away before the upload is processed;
+// the repository enforces the limit for uploads racing each other.
+func (s *MediaService) UploadPhoto(ctx context.Context, cmd commands.UploadPhoto) (*aggregate.Photo, error) {
+	existing, err := s.repo.ListByMember(ctx, cmd.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= aggregate.MaxPhotosPerMember {
+		return nil, aggregate.ErrPhotoLimitReached
+	}
+
+	result, err := s.processor.Process(cmd.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	variants := make([]aggregate.Variant, 0, len(result.Variants))
+	for _, v := range result.Variants {
+		key := variantKey(cmd.MemberID, cmd.PhotoID, v.Name)
+		if err := s.store.Put(ctx, key, v.Data, v.ContentType); err != nil {
+			s.deleteBlobs(ctx, variants)
+			return nil, fmt.Errorf("store %s variant: %w", v.Name, err)
+		}
+		variants = append(variants, aggregate.Variant{
+			Name:   v.Name,
+			Key:    key,
+			URL:    s.store.URL(key),
+			Width:  v.Width,
+			Height: v.Height,
+		})
+	}
+
+	photo, err := aggregate.NewPhoto(
+		cmd.PhotoID,
+		cmd.MemberID,
+		string(result.SourceFormat),
+		result.Width,
+		result.Height,
+		result.Blurhash,
+		variants,
+	)
+	if err != nil {
+		s.deleteBlobs(ctx, variants)
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, photo); err != nil {
+		s.deleteBlobs(ctx, variants)
+		return nil, err
+	}
+
+	return photo, nil
+}
+
+// DeletePhoto removes a member's photo and its stored variants.
+func (s *MediaService) DeletePhoto(ctx context.Context, cmd commands.DeletePhoto) error {
+	photo, err := s.repo.GetByID(ctx, cmd.PhotoID)
+	if err != nil {
+		return err
+	}
+	if !photo.OwnedBy(cmd.MemberID) {
+		return aggregate.ErrPhotoNotFound
+	}
+
+	if err := photo.Delete(); err != nil {
+		return err
+	}
+
+	if err := s.repo.Save(ctx, photo); err != nil {
+		return err
+	}
+
+	s.deleteBlobs(ctx, photo.Variants())
+	return nil
+}
+
+func (s *MediaService) GetPhoto(ctx context.Context, photoID string) (*aggregate.Photo, error) {
+	return s.repo.GetByID(ctx, photoID)
+}
+
+func (s *MediaService) ListPhotos(ctx context.Context, memberID string) ([]*aggregate.Photo, error) {
+	return s.repo.ListByMember(ctx, memberID)
+}
+
+func (s *MediaService) deleteBlobs(ctx context.Context, variants []aggregate.Variant) {
+	for _, v := range variants {
+		if err := s.store.Delete(ctx, v.Key); err != nil {
+			log.Printf("failed to delete blob %s: %v", v.Key, err)
+		}
+	}
+}
+
+func variantKey(memberID, photoID, variant string) string {
+	return fmt.Sprintf("photos/%s/%s/%s.jpg", memberID, photoID, variant)
+}
@@ -0,0 +1,155 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/domain/aggregate"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/domain/commands"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/domain/events"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/infrastructure/imaging"
+)
+
+// memRepo keeps photos in memory and records the events stored with them.
+// createErr makes Create fail, as when a concurrent upload took the last
+// free slot.
+type memRepo struct {
+	photos    []*aggregate.Photo
+	events    []events.Event
+	createErr error
+}
+
+func (r *memRepo) Create(_ context.Context, photo *aggregate.Photo) error {
+	if r.createErr != nil {
+		return r.createErr
+	}
+	return r.Save(context.Background(), photo)
+}
+
+func (r *memRepo) Save(_ context.Context, photo *aggregate.Photo) error {
+	r.photos = append(r.photos, photo)
+	r.events = append(r.events, photo.Changes()...)
+	photo.ClearChanges()
+	return nil
+}
+
+func (r *memRepo) GetByID(_ context.Context, id string) (*aggregate.Photo, error) {
+	for _, p := range r.photos {
+		if p.ID() == id {
+			return p, nil
+		}
+	}
+	return nil, aggregate.ErrPhotoNotFound
+}
+
+func (r *memRepo) ListByMember(_ context.Context, memberID string) ([]*aggregate.Photo, error) {
+	var photos []*aggregate.Photo
+	for _, p := range r.photos {
+		if p.MemberID() == memberID {
+			photos = append(photos, p)
+		}
+	}
+	return photos, nil
+}
+
+// memStore is a BlobStore keeping blobs in memory.
+type memStore map[string][]byte
+
+func (s memStore) Put(_ context.Context, key string, data []byte, _ string) error {
+	s[key] = data
+	return nil
+}
+
+func (s memStore) Delete(_ context.Context, key string) error {
+	delete(s, key)
+	return nil
+}
+
+func (s memStore) URL(key string) string { return "https://media.example.com/" + key }
+
+func testJPEG(t *testing.T) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, 400, 300))
+	for x := 0; x < 400; x++ {
+		for y := 0; y < 300; y++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func upload(photoID string, data []byte) commands.UploadPhoto {
+	return commands.UploadPhoto{PhotoID: photoID, MemberID: "member-1", Data: data}
+}
+
+func TestUploadPhotoStoresPhotoWithEvent(t *testing.T) {
+	repo := &memRepo{}
+	store := memStore{}
+	service := NewMediaService(repo, store, imaging.NewProcessor(imaging.DefaultVariants))
+
+	photo, err := service.UploadPhoto(context.Background(), upload("photo-1", testJPEG(t)))
+	if err != nil {
+		t.Fatalf("UploadPhoto: %v", err)
+	}
+
+	if len(store) != len(imaging.DefaultVariants) {
+		t.Errorf("stored %d blobs, want %d", len(store), len(imaging.DefaultVariants))
+	}
+	if len(repo.events) != 1 {
+		t.Fatalf("stored %d events, want 1", len(repo.events))
+	}
+	uploaded, ok := repo.events[0].(events.PhotoUploaded)
+	if !ok || uploaded.PhotoID != photo.ID() || uploaded.MemberID != "member-1" {
+		t.Errorf("stored event %#v, want PhotoUploaded for %s", repo.events[0], photo.ID())
+	}
+}
+
+func TestUploadPhotoRejectsMemberAtLimit(t *testing.T) {
+	repo := &memRepo{}
+	store := memStore{}
+	service := NewMediaService(repo, store, imaging.NewProcessor(imaging.DefaultVariants))
+	data := testJPEG(t)
+
+	for i := 0; i < aggregate.MaxPhotosPerMember; i++ {
+		if _, err := service.UploadPhoto(context.Background(), upload(string(rune('a'+i)), data)); err != nil {
+			t.Fatalf("upload %d: %v", i, err)
+		}
+	}
+	stored := len(store)
+
+	_, err := service.UploadPhoto(context.Background(), upload("one-too-many", data))
+	if !errors.Is(err, aggregate.ErrPhotoLimitReached) {
+		t.Fatalf("err = %v, want ErrPhotoLimitReached", err)
+	}
+	if len(store) != stored {
+		t.Errorf("blobs changed from %d to %d", stored, len(store))
+	}
+}
+
+// A concurrent upload can take the last slot between the service's check
+// and the insert; the repository refuses it and the blobs are removed.
+func TestUploadPhotoRemovesBlobsWhenRepositoryRefuses(t *testing.T) {
+	repo := &memRepo{createErr: aggregate.ErrPhotoLimitReached}
+	store := memStore{}
+	service := NewMediaService(repo, store, imaging.NewProcessor(imaging.DefaultVariants))
+
+	_, err := service.UploadPhoto(context.Background(), upload("photo-1", testJPEG(t)))
+	if !errors.Is(err, aggregate.ErrPhotoLimitReached) {
+		t.Fatalf("err = %v, want ErrPhotoLimitReached", err)
+	}
+	if len(store) != 0 {
+		t.Errorf("%d blobs left behind", len(store))
+	}
+	if len(repo.events) != 0 {
+		t.Errorf("%d events stored", len(repo.events))
+	}
+}
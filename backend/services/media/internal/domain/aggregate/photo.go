@@ -0,0 +1,174 @@
+package aggregate
+
+import (
+	"errors"
+	"time"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/domain/events"
+)
+
+var (
+	ErrPhotoNotFound     = errors.New("photo not found")
+	ErrNoVariants        = errors.New("photo has no variants")
+	ErrPhotoDeleted      = errors.New("photo has been deleted")
+	ErrPhotoLimitReached = errors.New("member has reached the maximum number of photos")
+)
+
+// MaxPhotosPerMember mirrors the member service's profile photo limit, so
+// uploads beyond it are rejected before any processing happens.
+const MaxPhotosPerMember = 9
+
+// PrimaryVariant is the rendition whose URL is attached to the member profile.
+const PrimaryVariant = "large"
+
+// Variant is a stored rendition of a photo.
+type Variant struct {
+	Name   string `json:"name"`
+	Key    string `json:"key"`
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+type Photo struct {
+	id          string
+	memberID    string
+	contentType string
+	width       int
+	height      int
+	blurhash    string
+	variants    []Variant
+	createdAt   time.Time
+	deletedAt   time.Time
+
+	changes []events.Event
+}
+
+func NewPhoto(id, memberID, contentType string, width, height int, blurhash string, variants []Variant) (*Photo, error) {
+	if len(variants) == 0 {
+		return nil, ErrNoVariants
+	}
+
+	p := &Photo{
+		id:          id,
+		memberID:    memberID,
+		contentType: contentType,
+		width:       width,
+		height:      height,
+		blurhash:    blurhash,
+		variants:    variants,
+		createdAt:   time.Now(),
+		changes:     make([]events.Event, 0),
+	}
+
+	p.raise(events.PhotoUploaded{
+		PhotoID:   id,
+		MemberID:  memberID,
+		URL:       p.PrimaryURL(),
+		Blurhash:  blurhash,
+		Timestamp: p.createdAt,
+	})
+
+	return p, nil
+}
+
+// RestorePhoto rebuilds a photo from persisted state without raising events.
+func RestorePhoto(id, memberID, contentType string, width, height int, blurhash string, variants []Variant, createdAt, deletedAt time.Time) *Photo {
+	return &Photo{
+		id:          id,
+		memberID:    memberID,
+		contentType: contentType,
+		width:       width,
+		height:      height,
+		blurhash:    blurhash,
+		variants:    variants,
+		createdAt:   createdAt,
+		deletedAt:   deletedAt,
+		changes:     make([]events.Event, 0),
+	}
+}
+
+func (p *Photo) ID() string {
+	return p.id
+}
+
+func (p *Photo) MemberID() string {
+	return p.memberID
+}
+
+func (p *Photo) ContentType() string {
+	return p.contentType
+}
+
+func (p *Photo) Width() int {
+	return p.width
+}
+
+func (p *Photo) Height() int {
+	return p.height
+}
+
+func (p *Photo) Blurhash() string {
+	return p.blurhash
+}
+
+func (p *Photo) Variants() []Variant {
+	return p.variants
+}
+
+func (p *Photo) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *Photo) DeletedAt() time.Time {
+	return p.deletedAt
+}
+
+func (p *Photo) IsDeleted() bool {
+	return !p.deletedAt.IsZero()
+}
+
+// PrimaryURL returns the URL of the primary variant, falling back to the
+// first stored variant.
+func (p *Photo) PrimaryURL() string {
+	for _, v := range p.variants {
+		if v.Name == PrimaryVariant {
+			return v.URL
+		}
+	}
+	return p.variants[0].URL
+}
+
+// OwnedBy reports whether the photo belongs to the given member.
+func (p *Photo) OwnedBy(memberID string) bool {
+	return p.memberID == memberID
+}
+
+func (p *Photo) Delete() error {
+	if p.IsDeleted() {
+		return ErrPhotoDeleted
+	}
+
+	p.deletedAt = time.Now()
+
+	p.raise(events.PhotoDeleted{
+		PhotoID:   p.id,
+		MemberID:  p.memberID,
+		URL:       p.PrimaryURL(),
+		Timestamp: p.deletedAt,
+	})
+
+	return nil
+}
+
+func (p *Photo) raise(event events.Event) {
+	p.changes = append(p.changes, event)
+}
+
+func (p *Photo) Changes() []events.Event {
+	return p.changes
+}
+
+func (p *Photo) ClearChanges() {
+	p.changes = make([]events.Event, 0)
+}
@@ -0,0 +1,20 @@
+package commands
+
+type Command interface {
+	CommandType() string
+}
+
+type UploadPhoto struct {
+	PhotoID  string
+	MemberID string
+	Data     []byte
+}
+
+func (c UploadPhoto) CommandType() string { return "media.upload_photo" }
+
+type DeletePhoto struct {
+	PhotoID  string
+	MemberID string
+}
+
+func (c DeletePhoto) CommandType() string { return "media.delete_photo" }
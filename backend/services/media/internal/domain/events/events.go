@@ -0,0 +1,32 @@
+package events
+
+import "time"
+
+type Event interface {
+	EventType() string
+	AggregateID() string
+	OccurredAt() time.Time
+}
+
+type PhotoUploaded struct {
+	PhotoID   string
+	MemberID  string
+	URL       string
+	Blurhash  string
+	Timestamp time.Time
+}
+
+func (e PhotoUploaded) EventType() string     { return "media.photo_uploaded" }
+func (e PhotoUploaded) AggregateID() string   { return e.PhotoID }
+func (e PhotoUploaded) OccurredAt() time.Time { return e.Timestamp }
+
+type PhotoDeleted struct {
+	PhotoID   string
+	MemberID  string
+	URL       string
+	Timestamp time.Time
+}
+
+func (e PhotoDeleted) EventType() string     { return "media.photo_deleted" }
+func (e PhotoDeleted) AggregateID() string   { return e.PhotoID }
+func (e PhotoDeleted) OccurredAt() time.Time { return e.Timestamp }
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/domain/aggregate"
+)
+
+// PhotoRepository stores photos together with the events they raised, which
+// are published from the outbox once stored.
+type PhotoRepository interface {
+	// Create stores a new photo, or fails with
+	// aggregate.ErrPhotoLimitReached when its member already has
+	// aggregate.MaxPhotosPerMember photos.
+	Create(ctx context.Context, photo *aggregate.Photo) error
+	Save(ctx context.Context, photo *aggregate.Photo) error
+	GetByID(ctx context.Context, id string) (*aggregate.Photo, error)
+	ListByMember(ctx context.Context, memberID string) ([]*aggregate.Photo, error)
+}
@@ -0,0 +1,44 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	ErrImageTooLarge     = errors.New("image dimensions are too large")
+	ErrImageTooSmall     = errors.New("image dimensions are too small")
+	ErrCorruptImage      = errors.New("image could not be decoded")
+)
+
+// Format is an image format recognised by its leading magic bytes.
+type Format string
+
+const (
+	FormatJPEG Format = "image/jpeg"
+	FormatPNG  Format = "image/png"
+	FormatWebP Format = "image/webp"
+)
+
+var (
+	jpegMagic = []byte{0xFF, 0xD8, 0xFF}
+	pngMagic  = []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1A, '\n'}
+	riffMagic = []byte("RIFF")
+	webpMagic = []byte("WEBP")
+)
+
+// DetectFormat identifies the image format from the file signature. The
+// client-supplied filename and Content-Type are never trusted.
+func DetectFormat(data []byte) (Format, error) {
+	switch {
+	case bytes.HasPrefix(data, jpegMagic):
+		return FormatJPEG, nil
+	case bytes.HasPrefix(data, pngMagic):
+		return FormatPNG, nil
+	case len(data) >= 12 && bytes.Equal(data[0:4], riffMagic) && bytes.Equal(data[8:12], webpMagic):
+		return FormatWebP, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
@@ -0,0 +1,129 @@
+package imaging
+
+import (
+	"encoding/binary"
+	"image"
+	"image/draw"
+)
+
+const (
+	exifOrientationTag = 0x0112
+
+	markerSOI  = 0xD8
+	markerAPP1 = 0xE1
+	markerSOS  = 0xDA
+)
+
+// jpegOrientation reads the EXIF orientation tag from a JPEG file. It returns
+// 1 (upright) when the file carries no usable EXIF data.
+//
+// Re-encoding discards all EXIF metadata, including GPS coordinates, so the
+// orientation has to be read up front and baked into the pixels.
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != markerSOI {
+		return 1
+	}
+
+	pos := 2
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			return 1
+		}
+		marker := data[pos+1]
+		if marker == markerSOS {
+			return 1
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2 : pos+4]))
+		if length < 2 || pos+2+length > len(data) {
+			return 1
+		}
+		segment := data[pos+4 : pos+2+length]
+		if marker == markerAPP1 && len(segment) > 6 && string(segment[:6]) == "Exif\x00\x00" {
+			return tiffOrientation(segment[6:])
+		}
+		pos += 2 + length
+	}
+	return 1
+}
+
+// tiffOrientation looks up the orientation tag in IFD0 of a TIFF header.
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	ifd := int(order.Uint32(tiff[4:8]))
+	if ifd+2 > len(tiff) {
+		return 1
+	}
+	entries := int(order.Uint16(tiff[ifd : ifd+2]))
+	for i := 0; i < entries; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:entry+2]) != exifOrientationTag {
+			continue
+		}
+		orientation := int(order.Uint16(tiff[entry+8 : entry+10]))
+		if orientation < 1 || orientation > 8 {
+			return 1
+		}
+		return orientation
+	}
+	return 1
+}
+
+// applyOrientation transforms img so that it displays upright for the given
+// EXIF orientation value.
+func applyOrientation(img image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return img
+	}
+
+	b := img.Bounds()
+	src := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(src, src.Bounds(), img, b.Min, draw.Src)
+
+	w, h := b.Dx(), b.Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // mirrored horizontally
+				dx, dy = w-1-x, y
+			case 3: // rotated 180°
+				dx, dy = w-1-x, h-1-y
+			case 4: // mirrored vertically
+				dx, dy = x, h-1-y
+			case 5: // transposed
+				dx, dy = y, x
+			case 6: // rotated 90° clockwise
+				dx, dy = h-1-y, x
+			case 7: // transversed
+				dx, dy = h-1-y, w-1-x
+			case 8: // rotated 90° counter-clockwise
+				dx, dy = y, w-1-x
+			}
+			dst.SetRGBA(dx, dy, src.RGBAAt(x, y))
+		}
+	}
+
+	return dst
+}
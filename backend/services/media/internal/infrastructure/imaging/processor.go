@@ -0,0 +1,183 @@
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	_ "image/png"
+
+	"github.com/buckket/go-blurhash"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	// MaxPixels guards against decompression bombs; DecodeConfig is checked
+	// before any pixel data is allocated.
+	MaxPixels = 40_000_000
+	// MinDimension is the smallest accepted width or height.
+	MinDimension = 200
+
+	jpegQuality    = 85
+	blurhashX      = 4
+	blurhashY      = 3
+	blurhashSample = 64
+)
+
+// VariantSpec describes a rendition generated for every uploaded photo.
+// Square variants are center-cropped; the others fit within MaxSize
+// without upscaling.
+type VariantSpec struct {
+	Name    string
+	MaxSize int
+	Square  bool
+}
+
+// DefaultVariants are the renditions served to the apps.
+var DefaultVariants = []VariantSpec{
+	{Name: "thumbnail", MaxSize: 160, Square: true},
+	{Name: "small", MaxSize: 480},
+	{Name: "medium", MaxSize: 960},
+	{Name: "large", MaxSize: 1600},
+}
+
+// EncodedVariant is a resized, re-encoded rendition of the upload.
+type EncodedVariant struct {
+	Name        string
+	ContentType string
+	Width       int
+	Height      int
+	Data        []byte
+}
+
+// Result is the outcome of processing an upload.
+type Result struct {
+	SourceFormat Format
+	Width        int
+	Height       int
+	Blurhash     string
+	Variants     []EncodedVariant
+}
+
+// Processor validates, normalises and resizes uploaded images.
+type Processor struct {
+	variants []VariantSpec
+}
+
+// NewProcessor creates a processor producing the given variants.
+func NewProcessor(variants []VariantSpec) *Processor {
+	return &Processor{variants: variants}
+}
+
+// Process decodes the upload, applies the EXIF orientation and produces all
+// variants as baseline JPEGs. Every variant is encoded from decoded pixels,
+// so no metadata from the original file (EXIF, GPS, XMP, ICC comments)
+// survives.
+func (p *Processor) Process(data []byte) (*Result, error) {
+	format, err := DetectFormat(data)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrCorruptImage
+	}
+	if cfg.Width*cfg.Height > MaxPixels {
+		return nil, ErrImageTooLarge
+	}
+	if cfg.Width < MinDimension || cfg.Height < MinDimension {
+		return nil, ErrImageTooSmall
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrCorruptImage
+	}
+	if format == FormatJPEG {
+		img = applyOrientation(img, jpegOrientation(data))
+	}
+	img = flatten(img)
+
+	bounds := img.Bounds()
+	result := &Result{
+		SourceFormat: format,
+		Width:        bounds.Dx(),
+		Height:       bounds.Dy(),
+	}
+
+	hash, err := blurhash.Encode(blurhashX, blurhashY, fit(img, blurhashSample))
+	if err != nil {
+		return nil, fmt.Errorf("encode blurhash: %w", err)
+	}
+	result.Blurhash = hash
+
+	for _, spec := range p.variants {
+		var resized image.Image
+		if spec.Square {
+			resized = fit(cropSquare(img), spec.MaxSize)
+		} else {
+			resized = fit(img, spec.MaxSize)
+		}
+
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, resized, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return nil, fmt.Errorf("encode %s variant: %w", spec.Name, err)
+		}
+
+		rb := resized.Bounds()
+		result.Variants = append(result.Variants, EncodedVariant{
+			Name:        spec.Name,
+			ContentType: string(FormatJPEG),
+			Width:       rb.Dx(),
+			Height:      rb.Dy(),
+			Data:        buf.Bytes(),
+		})
+	}
+
+	return result, nil
+}
+
+// flatten draws img onto an opaque white canvas, since JPEG has no alpha.
+func flatten(img image.Image) image.Image {
+	b := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), img, b.Min, draw.Over)
+	return dst
+}
+
+// cropSquare returns the centered square region of img.
+func cropSquare(img image.Image) image.Image {
+	b := img.Bounds()
+	size := min(b.Dx(), b.Dy())
+	x0 := b.Min.X + (b.Dx()-size)/2
+	y0 := b.Min.Y + (b.Dy()-size)/2
+
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.Draw(dst, dst.Bounds(), img, image.Point{X: x0, Y: y0}, draw.Src)
+	return dst
+}
+
+// fit scales img down so that its longest edge is at most maxSize.
+func fit(img image.Image, maxSize int) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= maxSize && h <= maxSize {
+		return img
+	}
+
+	if w >= h {
+		h = max(1, h*maxSize/w)
+		w = maxSize
+	} else {
+		w = max(1, w*maxSize/h)
+		h = maxSize
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, b, draw.Src, nil)
+	return dst
+}
@@ -0,0 +1,223 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/domain/aggregate"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/domain/events"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/domain/repository"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/correlation"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/telemetry"
+)
+
+// PostgresPhotoRepository implements repository.PhotoRepository using PostgreSQL.
+type PostgresPhotoRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresPhotoRepository creates a new PostgreSQL-backed photo repository.
+func NewPostgresPhotoRepository(db *sql.DB) repository.PhotoRepository {
+	return &PostgresPhotoRepository{db: db}
+}
+
+// Create inserts a new photo and its events. Uploads for the same member
+// are serialized on an advisory lock, so concurrent uploads cannot both
+// pass the photo limit.
+func (r *PostgresPhotoRepository) Create(ctx context.Context, photo *aggregate.Photo) error {
+	return r.inTx(ctx, photo, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, photo.MemberID()); err != nil {
+			return fmt.Errorf("lock member photos: %w", err)
+		}
+
+		var count int
+		err := tx.QueryRowContext(ctx, `
+			SELECT COUNT(*) FROM photos WHERE member_id = $1 AND deleted_at IS NULL
+		`, photo.MemberID()).Scan(&count)
+		if err != nil {
+			return fmt.Errorf("count photos: %w", err)
+		}
+		if count >= aggregate.MaxPhotosPerMember {
+			return aggregate.ErrPhotoLimitReached
+		}
+
+		return upsertPhoto(ctx, tx, photo)
+	})
+}
+
+// Save updates the photo and stores its events. Deletion is recorded as a
+// soft delete so the photo's history remains available for moderation.
+func (r *PostgresPhotoRepository) Save(ctx context.Context, photo *aggregate.Photo) error {
+	return r.inTx(ctx, photo, func(tx *sql.Tx) error {
+		return upsertPhoto(ctx, tx, photo)
+	})
+}
+
+// inTx runs write and appends the photo's pending events to the outbox in
+// one transaction, then clears them from the photo.
+func (r *PostgresPhotoRepository) inTx(ctx context.Context, photo *aggregate.Photo, write func(tx *sql.Tx) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := write(tx); err != nil {
+		return err
+	}
+	if err := appendEvents(ctx, tx, photo.Changes()); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	photo.ClearChanges()
+	return nil
+}
+
+func upsertPhoto(ctx context.Context, tx *sql.Tx, photo *aggregate.Photo) error {
+	variants, err := json.Marshal(photo.Variants())
+	if err != nil {
+		return fmt.Errorf("marshal variants: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO photos (id, member_id, content_type, width, height, blurhash, variants, created_at, deleted_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (id) DO UPDATE SET
+			deleted_at = EXCLUDED.deleted_at
+	`,
+		photo.ID(),
+		photo.MemberID(),
+		photo.ContentType(),
+		photo.Width(),
+		photo.Height(),
+		photo.Blurhash(),
+		variants,
+		photo.CreatedAt(),
+		nullTime(photo.DeletedAt()),
+	)
+	if err != nil {
+		return fmt.Errorf("upsert photo: %w", err)
+	}
+
+	return nil
+}
+
+// appendEvents writes events to the outbox with the request's correlation
+// ID and trace, for the relay to publish.
+func appendEvents(ctx context.Context, tx *sql.Tx, changes []events.Event) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	md := correlation.FromContext(ctx)
+	md.TraceParent = telemetry.TraceParent(ctx)
+	metadata, err := json.Marshal(md)
+	if err != nil {
+		return fmt.Errorf("marshal metadata: %w", err)
+	}
+
+	for _, event := range changes {
+		eventData, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("marshal event: %w", err)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO outbox (aggregate_id, event_type, event_data, metadata, created_at)
+			VALUES ($1, $2, $3, $4, $5)
+		`, event.AggregateID(), event.EventType(), eventData, metadata, event.OccurredAt())
+		if err != nil {
+			return fmt.Errorf("insert event: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetByID retrieves a photo that has not been deleted.
+func (r *PostgresPhotoRepository) GetByID(ctx context.Context, id string) (*aggregate.Photo, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT id, member_id, content_type, width, height, blurhash, variants, created_at, deleted_at
+		FROM photos
+		WHERE id = $1 AND deleted_at IS NULL
+	`, id)
+
+	photo, err := scanPhoto(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, aggregate.ErrPhotoNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query photo: %w", err)
+	}
+
+	return photo, nil
+}
+
+// ListByMember retrieves a member's photos, oldest first.
+func (r *PostgresPhotoRepository) ListByMember(ctx context.Context, memberID string) ([]*aggregate.Photo, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, member_id, content_type, width, height, blurhash, variants, created_at, deleted_at
+		FROM photos
+		WHERE member_id = $1 AND deleted_at IS NULL
+		ORDER BY created_at ASC
+	`, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("query photos: %w", err)
+	}
+	defer rows.Close()
+
+	var photos []*aggregate.Photo
+	for rows.Next() {
+		photo, err := scanPhoto(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan photo: %w", err)
+		}
+		photos = append(photos, photo)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate photos: %w", err)
+	}
+
+	return photos, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPhoto(s scanner) (*aggregate.Photo, error) {
+	var (
+		id, memberID, contentType, blurhash string
+		width, height                       int
+		variantsData                        []byte
+		createdAt                           time.Time
+		deletedAt                           sql.NullTime
+	)
+	if err := s.Scan(&id, &memberID, &contentType, &width, &height, &blurhash, &variantsData, &createdAt, &deletedAt); err != nil {
+		return nil, err
+	}
+
+	var variants []aggregate.Variant
+	if err := json.Unmarshal(variantsData, &variants); err != nil {
+		return nil, fmt.Errorf("unmarshal variants: %w", err)
+	}
+
+	return aggregate.RestorePhoto(id, memberID, contentType, width, height, blurhash, variants, createdAt, deletedAt.Time), nil
+}
+
+// nullTime returns sql.NullTime for optional time fields.
+func nullTime(t time.Time) sql.NullTime {
+	if t.IsZero() {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: t, Valid: true}
+}
@@ -0,0 +1,100 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalStore keeps blobs on the local filesystem and serves them over HTTP.
+// It is intended for development and single-node deployments.
+type LocalStore struct {
+	root      string
+	publicURL string
+}
+
+// NewLocalStore creates a store rooted at dir. Blob URLs are built by
+// appending the key to publicURL, which should point at Handler.
+func NewLocalStore(dir, publicURL string) (*LocalStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create storage directory: %w", err)
+	}
+	return &LocalStore{
+		root:      dir,
+		publicURL: strings.TrimSuffix(publicURL, "/"),
+	}, nil
+}
+
+// Put writes the blob atomically by renaming a temporary file into place.
+func (s *LocalStore) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create blob directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("create temp file: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write blob: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close blob: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("move blob into place: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the blob. Deleting a missing blob is not an error.
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("delete blob: %w", err)
+	}
+	return nil
+}
+
+// URL returns the public URL of the blob.
+func (s *LocalStore) URL(key string) string {
+	return s.publicURL + "/" + key
+}
+
+// Handler serves stored blobs. Mount it under the path prefix of publicURL
+// with http.StripPrefix. Directory listings are not served.
+func (s *LocalStore) Handler() http.Handler {
+	files := http.FileServer(http.Dir(s.root))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/") {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		files.ServeHTTP(w, r)
+	})
+}
+
+// path resolves key inside the storage root, rejecting keys that would
+// escape it.
+func (s *LocalStore) path(key string) (string, error) {
+	clean := filepath.Clean("/" + key)
+	if clean == "/" {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(s.root, clean), nil
+}
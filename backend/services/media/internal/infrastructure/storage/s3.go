@@ -0,0 +1,95 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3Config configures an S3-compatible object store (AWS S3, MinIO, R2, ...).
+type S3Config struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	UseSSL    bool
+	// PublicURL is the base URL blobs are served from, typically a CDN in
+	// front of the bucket. Defaults to the path-style bucket URL.
+	PublicURL string
+}
+
+// S3Store keeps blobs in an S3-compatible bucket.
+type S3Store struct {
+	client    *minio.Client
+	bucket    string
+	publicURL string
+}
+
+// NewS3Store creates a store for the configured bucket, creating the bucket
+// if it does not exist yet.
+func NewS3Store(ctx context.Context, cfg S3Config) (*S3Store, error) {
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
+		Secure: cfg.UseSSL,
+		Region: cfg.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create s3 client: %w", err)
+	}
+
+	exists, err := client.BucketExists(ctx, cfg.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("check bucket: %w", err)
+	}
+	if !exists {
+		if err := client.MakeBucket(ctx, cfg.Bucket, minio.MakeBucketOptions{Region: cfg.Region}); err != nil {
+			return nil, fmt.Errorf("create bucket: %w", err)
+		}
+	}
+
+	publicURL := cfg.PublicURL
+	if publicURL == "" {
+		scheme := "http"
+		if cfg.UseSSL {
+			scheme = "https"
+		}
+		publicURL = fmt.Sprintf("%s://%s/%s", scheme, cfg.Endpoint, cfg.Bucket)
+	}
+
+	return &S3Store{
+		client:    client,
+		bucket:    cfg.Bucket,
+		publicURL: strings.TrimSuffix(publicURL, "/"),
+	}, nil
+}
+
+// Put uploads the blob. Keys embed the photo ID and are never rewritten, so
+// blobs are marked immutable for caching.
+func (s *S3Store) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		ContentType:  contentType,
+		CacheControl: "public, max-age=31536000, immutable",
+	})
+	if err != nil {
+		return fmt.Errorf("put object: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the blob. Deleting a missing blob is not an error.
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	if err := s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("remove object: %w", err)
+	}
+	return nil
+}
+
+// URL returns the public URL of the blob.
+func (s *S3Store) URL(key string) string {
+	return s.publicURL + "/" + key
+}
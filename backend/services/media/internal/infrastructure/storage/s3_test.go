@@ -0,0 +1,188 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeS3 is a local stand-in for an S3-compatible store. It keeps buckets
+// and objects in memory and answers the path-style requests the store makes,
+// without checking signatures.
+type fakeS3 struct {
+	mu      sync.Mutex
+	buckets map[string]bool
+	objects map[string]fakeObject
+}
+
+type fakeObject struct {
+	data   []byte
+	header http.Header
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{buckets: map[string]bool{}, objects: map[string]fakeObject{}}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if key == "" {
+		switch r.Method {
+		case http.MethodHead:
+			if !f.buckets[bucket] {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		case http.MethodPut:
+			f.buckets[bucket] = true
+		default:
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+		return
+	}
+
+	if !f.buckets[bucket] {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	switch r.Method {
+	case http.MethodPut:
+		data, err := readPayload(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.objects[r.URL.Path] = fakeObject{data: data, header: r.Header.Clone()}
+		w.Header().Set("ETag", `"etag"`)
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+// readPayload returns the request body, decoding the aws-chunked encoding
+// clients use to sign streamed uploads.
+func readPayload(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return io.ReadAll(r.Body)
+	}
+
+	var data []byte
+	body := bufio.NewReader(r.Body)
+	for {
+		line, err := body.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		sizeHex, _, _ := strings.Cut(strings.TrimSpace(line), ";")
+		size, err := strconv.ParseInt(sizeHex, 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return data, nil
+		}
+		chunk := make([]byte, size+2)
+		if _, err := io.ReadFull(body, chunk); err != nil {
+			return nil, err
+		}
+		data = append(data, chunk[:size]...)
+	}
+}
+
+func (f *fakeS3) object(path string) (fakeObject, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	obj, ok := f.objects[path]
+	return obj, ok
+}
+
+func newTestS3Store(t *testing.T, fake *fakeS3, publicURL string) *S3Store {
+	t.Helper()
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	store, err := NewS3Store(context.Background(), S3Config{
+		Endpoint:  strings.TrimPrefix(server.URL, "http://"),
+		Region:    "us-east-1",
+		Bucket:    "media",
+		AccessKey: "access",
+		SecretKey: "secret",
+		PublicURL: publicURL,
+	})
+	if err != nil {
+		t.Fatalf("NewS3Store: %v", err)
+	}
+	return store
+}
+
+func TestS3StoreCreatesMissingBucket(t *testing.T) {
+	fake := newFakeS3()
+	newTestS3Store(t, fake, "")
+
+	if !fake.buckets["media"] {
+		t.Fatal("bucket was not created")
+	}
+}
+
+func TestS3StorePutAndDelete(t *testing.T) {
+	fake := newFakeS3()
+	store := newTestS3Store(t, fake, "")
+	ctx := context.Background()
+	data := []byte("jpeg bytes")
+
+	if err := store.Put(ctx, "photos/m1/p1/large.jpg", data, "image/jpeg"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	obj, ok := fake.object("/media/photos/m1/p1/large.jpg")
+	if !ok {
+		t.Fatal("object was not stored")
+	}
+	if !bytes.Equal(obj.data, data) {
+		t.Errorf("stored %q, want %q", obj.data, data)
+	}
+	if got := obj.header.Get("Content-Type"); got != "image/jpeg" {
+		t.Errorf("Content-Type = %q, want image/jpeg", got)
+	}
+	if got := obj.header.Get("Cache-Control"); got != "public, max-age=31536000, immutable" {
+		t.Errorf("Cache-Control = %q", got)
+	}
+
+	if err := store.Delete(ctx, "photos/m1/p1/large.jpg"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, ok := fake.object("/media/photos/m1/p1/large.jpg"); ok {
+		t.Error("object was not deleted")
+	}
+
+	// Deleting a missing blob is not an error
+	if err := store.Delete(ctx, "photos/m1/p1/large.jpg"); err != nil {
+		t.Errorf("Delete missing: %v", err)
+	}
+}
+
+func TestS3StoreURL(t *testing.T) {
+	fake := newFakeS3()
+
+	store := newTestS3Store(t, fake, "https://cdn.example.com/media/")
+	if got, want := store.URL("photos/a.jpg"), "https://cdn.example.com/media/photos/a.jpg"; got != want {
+		t.Errorf("URL = %q, want %q", got, want)
+	}
+
+	store = newTestS3Store(t, fake, "")
+	if !strings.HasPrefix(store.URL("photos/a.jpg"), "http://127.0.0.1:") ||
+		!strings.HasSuffix(store.URL("photos/a.jpg"), "/media/photos/a.jpg") {
+		t.Errorf("URL = %q, want the path-style bucket URL", store.URL("photos/a.jpg"))
+	}
+}
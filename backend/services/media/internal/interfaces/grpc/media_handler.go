@@ -0,0 +1,160 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	mediav1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/media/v1"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/application"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/domain/aggregate"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/domain/commands"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/media/internal/infrastructure/imaging"
+)
+
+// MaxUploadSize is the largest accepted upload in bytes.
+const MaxUploadSize = 10 << 20
+
+// MediaHandler implements the gRPC MediaServiceServer interface.
+type MediaHandler struct {
+	mediav1.UnimplementedMediaServiceServer
+	service *application.MediaService
+}
+
+// NewMediaHandler creates a new gRPC handler for the media service.
+func NewMediaHandler(service *application.MediaService) *MediaHandler {
+	return &MediaHandler{service: service}
+}
+
+// UploadPhoto handles photo upload requests.
+func (h *MediaHandler) UploadPhoto(ctx context.Context, req *mediav1.UploadPhotoRequest) (*mediav1.UploadPhotoResponse, error) {
+	if req.MemberId == "" {
+		return nil, status.Error(codes.InvalidArgument, "member_id is required")
+	}
+	if len(req.Data) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "data is required")
+	}
+	if len(req.Data) > MaxUploadSize {
+		return nil, status.Error(codes.InvalidArgument, "photo exceeds the maximum upload size")
+	}
+
+	cmd := commands.UploadPhoto{
+		PhotoID:  uuid.New().String(),
+		MemberID: req.MemberId,
+		Data:     req.Data,
+	}
+
+	photo, err := h.service.UploadPhoto(ctx, cmd)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &mediav1.UploadPhotoResponse{
+		Photo: toProtoPhoto(photo),
+	}, nil
+}
+
+// GetPhoto retrieves a photo by ID.
+func (h *MediaHandler) GetPhoto(ctx context.Context, req *mediav1.GetPhotoRequest) (*mediav1.GetPhotoResponse, error) {
+	if req.PhotoId == "" {
+		return nil, status.Error(codes.InvalidArgument, "photo_id is required")
+	}
+
+	photo, err := h.service.GetPhoto(ctx, req.PhotoId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &mediav1.GetPhotoResponse{
+		Photo: toProtoPhoto(photo),
+	}, nil
+}
+
+// ListPhotos retrieves all photos of a member.
+func (h *MediaHandler) ListPhotos(ctx context.Context, req *mediav1.ListPhotosRequest) (*mediav1.ListPhotosResponse, error) {
+	if req.MemberId == "" {
+		return nil, status.Error(codes.InvalidArgument, "member_id is required")
+	}
+
+	photos, err := h.service.ListPhotos(ctx, req.MemberId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &mediav1.ListPhotosResponse{
+		Photos: make([]*mediav1.Photo, len(photos)),
+	}
+	for i, p := range photos {
+		resp.Photos[i] = toProtoPhoto(p)
+	}
+
+	return resp, nil
+}
+
+// DeletePhoto deletes a member's photo.
+func (h *MediaHandler) DeletePhoto(ctx context.Context, req *mediav1.DeletePhotoRequest) (*mediav1.DeletePhotoResponse, error) {
+	if req.MemberId == "" {
+		return nil, status.Error(codes.InvalidArgument, "member_id is required")
+	}
+	if req.PhotoId == "" {
+		return nil, status.Error(codes.InvalidArgument, "photo_id is required")
+	}
+
+	cmd := commands.DeletePhoto{
+		PhotoID:  req.PhotoId,
+		MemberID: req.MemberId,
+	}
+
+	if err := h.service.DeletePhoto(ctx, cmd); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &mediav1.DeletePhotoResponse{}, nil
+}
+
+// toProtoPhoto converts a domain photo to a protobuf photo.
+func toProtoPhoto(p *aggregate.Photo) *mediav1.Photo {
+	variants := make([]*mediav1.PhotoVariant, len(p.Variants()))
+	for i, v := range p.Variants() {
+		variants[i] = &mediav1.PhotoVariant{
+			Name:   v.Name,
+			Url:    v.URL,
+			Width:  int32(v.Width),
+			Height: int32(v.Height),
+		}
+	}
+
+	return &mediav1.Photo{
+		Id:          p.ID(),
+		MemberId:    p.MemberID(),
+		ContentType: p.ContentType(),
+		Width:       int32(p.Width()),
+		Height:      int32(p.Height()),
+		Blurhash:    p.Blurhash(),
+		Variants:    variants,
+		CreatedAt:   timestamppb.New(p.CreatedAt()),
+	}
+}
+
+// toGRPCError converts domain errors to gRPC status errors.
+func toGRPCError(err error) error {
+	switch {
+	case errors.Is(err, aggregate.ErrPhotoNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, aggregate.ErrPhotoDeleted):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, aggregate.ErrPhotoLimitReached):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, imaging.ErrUnsupportedFormat),
+		errors.Is(err, imaging.ErrImageTooLarge),
+		errors.Is(err, imaging.ErrImageTooSmall),
+		errors.Is(err, imaging.ErrCorruptImage):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
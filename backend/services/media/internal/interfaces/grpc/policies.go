@@ -0,0 +1,29 @@
+package grpc
+
+import (
+	mediav1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/media/v1"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/interceptors"
+)
+
+// Services that call the media service, as named in SERVICE_TOKENS.
+// Admin is used by operators, for example through grpcurl.
+const (
+	CallerGateway = "gateway"
+	CallerAdmin   = "admin"
+)
+
+// Policies says which services may call each method. The gateway uploads,
+// lists and deletes photos for the signed-in member only, so it must
+// forward the member's access token; operators may act on any member.
+var Policies = interceptors.Policies{
+	mediav1.MediaService_UploadPhoto_FullMethodName: member(),
+	mediav1.MediaService_ListPhotos_FullMethodName:  member(),
+	mediav1.MediaService_DeletePhoto_FullMethodName: member(),
+
+	// Photos are public once uploaded
+	mediav1.MediaService_GetPhoto_FullMethodName: {Callers: []string{CallerGateway, CallerAdmin}},
+}
+
+func member() interceptors.Policy {
+	return interceptors.Policy{Callers: []string{CallerGateway, CallerAdmin}, Subject: "member_id", Operators: []string{CallerAdmin}}
+}
@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	mediav1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/media/v1"
+)
+
+// TestPoliciesBindMember fails when a MediaService method is missing a
+// policy, or acts on a member without binding it to the signed-in member.
+func TestPoliciesBindMember(t *testing.T) {
+	service := mediav1.MediaService_ServiceDesc
+	for _, method := range service.Methods {
+		fullMethod := "/" + service.ServiceName + "/" + method.MethodName
+		policy, ok := Policies[fullMethod]
+		if !ok {
+			t.Errorf("%s has no policy", fullMethod)
+			continue
+		}
+
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service.ServiceName + "." + method.MethodName))
+		if err != nil {
+			t.Fatal(err)
+		}
+		input := desc.(protoreflect.MethodDescriptor).Input()
+		if input.Fields().ByName("member_id") != nil && policy.Subject != "member_id" {
+			t.Errorf("%s takes a member_id the caller chooses", fullMethod)
+		}
+	}
+}
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_photos_member;
+DROP TABLE IF EXISTS photos;
@@ -0,0 +1,17 @@
+-- Photos processed by the media service. Variants hold the stored
+-- renditions (name, storage key, public URL and dimensions).
+CREATE TABLE IF NOT EXISTS photos (
+    id VARCHAR(36) PRIMARY KEY,
+    member_id VARCHAR(36) NOT NULL,
+    content_type VARCHAR(50) NOT NULL,
+    width INT NOT NULL,
+    height INT NOT NULL,
+    blurhash VARCHAR(100) NOT NULL,
+    variants JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+-- Index for listing a member's photos
+CREATE INDEX idx_photos_member ON photos(member_id, created_at)
+    WHERE deleted_at IS NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_outbox_unpublished;
+DROP TABLE IF EXISTS outbox;
@@ -0,0 +1,15 @@
+-- Photo events waiting to be published. They are written in the same
+-- transaction as the photo and marked published once the broker accepted
+-- them.
+CREATE TABLE IF NOT EXISTS outbox (
+    id BIGSERIAL PRIMARY KEY,
+    aggregate_id VARCHAR(36) NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    event_data JSONB NOT NULL,
+    metadata JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMPTZ
+);
+
+-- Index for efficient polling of unpublished events
+CREATE INDEX idx_outbox_unpublished ON outbox(id) WHERE published_at IS NULL;
@@ -0,0 +1,83 @@
+// Command experiment-report analyses an experiment from the exposures and
+// matches the member service recorded. For each variant it reports the
+// members exposed, the share of them that matched within the window after
+// their first exposure, their matches per member and, against the
+// control, the lift in match rate with its p-value.
+//
+//	DATABASE_URL=postgres://... go run ./cmd/experiment-report -experiment discovery_ranking
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	_ "github.com/lib/pq"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/experiments"
+)
+
+func main() {
+	key := flag.String("experiment", "", "key of the experiment to analyse")
+	window := flag.Duration("window", 7*24*time.Hour, "how long after their first exposure a member's matches count")
+	databaseURL := flag.String("database-url", os.Getenv("DATABASE_URL"), "member service database, defaults to $DATABASE_URL")
+	flag.Parse()
+
+	if *key == "" || *databaseURL == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	db, err := sql.Open("postgres", *databaseURL)
+	if err != nil {
+		log.Fatalf("open database: %v", err)
+	}
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	store := experiments.NewPostgresStore(db)
+	results, err := store.Results(ctx, *key, *window)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+
+	// The definition orders the variants with the control first; a deleted
+	// experiment is still reported, with its variants ordered by name
+	all, err := store.ListExperiments(ctx)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	status := "deleted"
+	for _, experiment := range all {
+		if experiment.Key == *key {
+			status = experiment.Status
+			results = experiments.InVariantOrder(results, experiment)
+		}
+	}
+	if len(results) == 0 {
+		log.Fatalf("no exposures recorded for experiment %q", *key)
+	}
+
+	fmt.Printf("experiment %s (%s), matches within %s of first exposure\n\n", *key, status, *window)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VARIANT\tMEMBERS\tMATCHED\tMATCH RATE\tMATCHES/MEMBER\tLIFT\tP-VALUE")
+	control := results[0]
+	for i, result := range results {
+		lift, pValue := "", ""
+		if i > 0 {
+			comparison := experiments.Compare(control, result)
+			lift = fmt.Sprintf("%+.1f%%", comparison.Lift*100)
+			pValue = fmt.Sprintf("%.3f", comparison.PValue)
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t%.1f%%\t%.2f\t%s\t%s\n", result.Variant, result.Members, result.Converted,
+			result.ConversionRate()*100, result.MatchesPerMember(), lift, pValue)
+	}
+	_ = w.Flush()
+}
@@ -0,0 +1,275 @@
+package application
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/aggregate"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/commands"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/repository"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/auth"
+)
+
+var ErrUnknownRole = errors.New("unknown role, roles are support and admin")
+
+const (
+	defaultAuditPageSize = 50
+	maxAuditPageSize     = 200
+
+	defaultMemberSearchPageSize = 50
+	maxMemberSearchPageSize     = 200
+)
+
+// MemberQuery is a member search as support tools make it. Zero fields do
+// not narrow the search.
+type MemberQuery struct {
+	// Query is a member ID, or part of an email address or display name.
+	Query    string
+	Statuses []string
+	Genders  []string
+	// CreatedAfter is inclusive, CreatedBefore exclusive.
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	// MinAge and MaxAge are inclusive bounds in years.
+	MinAge int
+	MaxAge int
+	Sort   repository.MemberSort
+}
+
+// MemberSearchPage is a page of member search results.
+type MemberSearchPage struct {
+	Members []repository.MemberSummary
+	// NextPageToken continues the search; empty on the last page.
+	NextPageToken string
+}
+
+// memberPageToken is the decoded form of a search page token: the sort it
+// was issued for and the last member of the page.
+type memberPageToken struct {
+	Sort        repository.MemberSort `json:"s"`
+	CreatedAt   time.Time             `json:"c,omitzero"`
+	Email       string                `json:"e,omitempty"`
+	DisplayName string                `json:"n,omitempty"`
+	ID          string                `json:"i"`
+}
+
+// SearchMembers returns a page of the members matching the query. Page
+// tokens are opaque to callers and only continue a search with the same
+// sort.
+func (s *MemberService) SearchMembers(ctx context.Context, q MemberQuery, pageSize int, pageToken string) (*MemberSearchPage, error) {
+	if pageSize <= 0 {
+		pageSize = defaultMemberSearchPageSize
+	}
+	pageSize = min(pageSize, maxMemberSearchPageSize)
+
+	search := repository.MemberSearch{
+		Statuses:      q.Statuses,
+		Genders:       q.Genders,
+		CreatedFrom:   q.CreatedAfter,
+		CreatedBefore: q.CreatedBefore,
+		Sort:          q.Sort,
+	}
+	if search.Sort == "" {
+		search.Sort = repository.SortNewest
+	}
+	if text := strings.TrimSpace(q.Query); text != "" {
+		if id, err := uuid.Parse(text); err == nil {
+			// IDs are stored in canonical form, which uuid.Parse also
+			// accepts braced, URN and unhyphenated IDs in
+			search.ID = id.String()
+		} else {
+			search.Text = text
+		}
+	}
+
+	// A member is n years old from their nth birthday until the day
+	// before their next one
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	if q.MinAge > 0 {
+		search.BornOnOrBefore = today.AddDate(-q.MinAge, 0, 0)
+	}
+	if q.MaxAge > 0 {
+		search.BornAfter = today.AddDate(-q.MaxAge-1, 0, 0)
+	}
+
+	var after *repository.MemberCursor
+	if pageToken != "" {
+		cursor, err := decodeMemberPageToken(pageToken, search.Sort)
+		if err != nil {
+			return nil, err
+		}
+		after = cursor
+	}
+
+	// Fetch one extra member to learn whether there is a next page
+	members, err := s.repo.SearchMembers(ctx, search, after, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &MemberSearchPage{Members: members}
+	if len(members) > pageSize {
+		page.Members = members[:pageSize]
+		page.NextPageToken = encodeMemberPageToken(search.Sort, page.Members[pageSize-1].Cursor())
+	}
+	return page, nil
+}
+
+func encodeMemberPageToken(sort repository.MemberSort, cursor repository.MemberCursor) string {
+	token := memberPageToken{Sort: sort, ID: cursor.ID}
+	switch sort {
+	case repository.SortEmail:
+		token.Email = cursor.Email
+	case repository.SortDisplayName:
+		token.DisplayName = cursor.DisplayName
+	default:
+		token.CreatedAt = cursor.CreatedAt
+	}
+	raw, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeMemberPageToken(pageToken string, sort repository.MemberSort) (*repository.MemberCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	var token memberPageToken
+	if err := json.Unmarshal(raw, &token); err != nil || token.Sort != sort || token.ID == "" {
+		return nil, ErrInvalidPageToken
+	}
+	return &repository.MemberCursor{
+		CreatedAt:   token.CreatedAt,
+		Email:       token.Email,
+		DisplayName: token.DisplayName,
+		ID:          token.ID,
+	}, nil
+}
+
+// ReinstateMember lifts a member's suspension.
+func (s *MemberService) ReinstateMember(ctx context.Context, cmd commands.ReinstateMember) (*aggregate.Member, error) {
+	member, err := s.repo.GetByID(ctx, cmd.MemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := member.Reinstate(cmd.Reason); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Save(ctx, member); err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+// RevokeSessions signs the member out on every device and returns when.
+func (s *MemberService) RevokeSessions(ctx context.Context, cmd commands.RevokeSessions) (time.Time, error) {
+	member, err := s.repo.GetByID(ctx, cmd.MemberID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	member.RevokeSessions(cmd.Reason)
+
+	if err := s.repo.Save(ctx, member); err != nil {
+		return time.Time{}, err
+	}
+	return member.SessionsRevokedAt(), nil
+}
+
+// ListSessionRevocations returns the members signed out everywhere since
+// the given time, so their older tokens can be rejected.
+func (s *MemberService) ListSessionRevocations(ctx context.Context, since time.Time) ([]repository.SessionRevocation, error) {
+	return s.repo.ListSessionRevocations(ctx, since)
+}
+
+// MemberRoles returns the member's staff roles.
+func (s *MemberService) MemberRoles(ctx context.Context, memberID string) ([]string, error) {
+	return s.roles.Roles(ctx, memberID)
+}
+
+// SetMemberRoles replaces the member's staff roles. When they change the
+// member is signed out, since their tokens carry the old roles.
+func (s *MemberService) SetMemberRoles(ctx context.Context, cmd commands.SetMemberRoles) ([]string, error) {
+	roles := slices.Clone(cmd.Roles)
+	slices.Sort(roles)
+	roles = slices.Compact(roles)
+	for _, role := range roles {
+		if !auth.ValidRole(role) {
+			return nil, ErrUnknownRole
+		}
+	}
+
+	member, err := s.repo.GetByID(ctx, cmd.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.roles.Roles(ctx, cmd.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Equal(current, roles) {
+		return roles, nil
+	}
+
+	if err := s.roles.SetRoles(ctx, cmd.MemberID, roles, cmd.GrantedBy); err != nil {
+		return nil, err
+	}
+	member.RevokeSessions("roles changed")
+	if err := s.repo.Save(ctx, member); err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+// AuditLogPage is a page of the audit log, newest first.
+type AuditLogPage struct {
+	Entries []repository.AuditEntry
+	// NextPageToken continues with older entries; empty on the last page.
+	NextPageToken string
+}
+
+// RecordAudit appends an administrative call to the audit log.
+func (s *MemberService) RecordAudit(ctx context.Context, entry repository.AuditEntry) error {
+	return s.auditLog.Record(ctx, entry)
+}
+
+// ListAuditLog returns a page of the audit log. The page token is the ID
+// of the last entry on the previous page.
+func (s *MemberService) ListAuditLog(ctx context.Context, filter repository.AuditFilter, pageSize int, pageToken string) (*AuditLogPage, error) {
+	if pageSize <= 0 {
+		pageSize = defaultAuditPageSize
+	}
+	pageSize = min(pageSize, maxAuditPageSize)
+
+	var beforeID int64
+	if pageToken != "" {
+		id, err := strconv.ParseInt(pageToken, 10, 64)
+		if err != nil || id <= 0 {
+			return nil, ErrInvalidPageToken
+		}
+		beforeID = id
+	}
+
+	// Fetch one extra entry to learn whether there is a next page
+	entries, err := s.auditLog.List(ctx, filter, beforeID, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &AuditLogPage{Entries: entries}
+	if len(entries) > pageSize {
+		page.Entries = entries[:pageSize]
+		page.NextPageToken = strconv.FormatInt(page.Entries[pageSize-1].ID, 10)
+	}
+	return page, nil
+}
@@ -0,0 +1,52 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/repository"
+)
+
+// searchRecorder is a MemberRepository that records the searches made.
+type searchRecorder struct {
+	repository.MemberRepository
+	searches []repository.MemberSearch
+}
+
+func (r *searchRecorder) SearchMembers(_ context.Context, search repository.MemberSearch, _ *repository.MemberCursor, _ int) ([]repository.MemberSummary, error) {
+	r.searches = append(r.searches, search)
+	return nil, nil
+}
+
+func TestSearchMembersByID(t *testing.T) {
+	const id = "8c1f2a3b-4d5e-4f60-8a7b-9c0d1e2f3a4b"
+	tests := map[string]string{
+		"canonical":  id,
+		"upper case": "8C1F2A3B-4D5E-4F60-8A7B-9C0D1E2F3A4B",
+		"urn":        "urn:uuid:" + id,
+		"braced":     "{" + id + "}",
+		"no hyphens": "8c1f2a3b4d5e4f608a7b9c0d1e2f3a4b",
+		"padded":     "  " + id + " ",
+		"not an ID":  "Sam",
+	}
+	for name, query := range tests {
+		t.Run(name, func(t *testing.T) {
+			repo := &searchRecorder{}
+			service := NewMemberService(repo, nil, nil, nil, nil, nil)
+			if _, err := service.SearchMembers(context.Background(), MemberQuery{Query: query}, 10, ""); err != nil {
+				t.Fatalf("SearchMembers: %v", err)
+			}
+
+			search := repo.searches[0]
+			if name == "not an ID" {
+				if search.ID != "" || search.Text != query {
+					t.Errorf("search ID %q, text %q; want text %q", search.ID, search.Text, query)
+				}
+				return
+			}
+			if search.ID != id || search.Text != "" {
+				t.Errorf("search ID %q, text %q; want ID %q", search.ID, search.Text, id)
+			}
+		})
+	}
+}
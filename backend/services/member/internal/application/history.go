@@ -0,0 +1,191 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/aggregate"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/events"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/eventstore"
+)
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+const (
+	defaultHistoryPageSize = 50
+	maxHistoryPageSize     = 200
+)
+
+// HistoryItem is one event in a member's timeline, described for support
+// staff. Personal details are redacted: email addresses are masked, only
+// the birth year is shown and photos are referred to by ID.
+type HistoryItem struct {
+	Version    int
+	EventType  string
+	OccurredAt time.Time
+	Summary    string
+	Details    []HistoryDetail
+	Metadata   eventstore.Metadata
+}
+
+// HistoryDetail is a labelled value of a history item, in display order.
+type HistoryDetail struct {
+	Name  string
+	Value string
+}
+
+// MemberHistory is a page of a member's timeline, oldest first.
+type MemberHistory struct {
+	Items []HistoryItem
+	// NextPageToken continues the timeline; empty on the last page.
+	NextPageToken string
+}
+
+// GetMemberHistory returns a page of the member's timeline. The page token
+// is the version of the last event on the previous page.
+func (s *MemberService) GetMemberHistory(ctx context.Context, memberID string, pageSize int, pageToken string) (*MemberHistory, error) {
+	if pageSize <= 0 {
+		pageSize = defaultHistoryPageSize
+	}
+	pageSize = min(pageSize, maxHistoryPageSize)
+
+	afterVersion := 0
+	if pageToken != "" {
+		v, err := strconv.Atoi(pageToken)
+		if err != nil || v < 0 {
+			return nil, ErrInvalidPageToken
+		}
+		afterVersion = v
+	}
+
+	// Fetch one extra entry to learn whether there is a next page
+	entries, err := s.repo.GetHistory(ctx, memberID, afterVersion, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 && afterVersion == 0 {
+		return nil, aggregate.ErrMemberNotFound
+	}
+
+	history := &MemberHistory{}
+	if len(entries) > pageSize {
+		entries = entries[:pageSize]
+		history.NextPageToken = strconv.Itoa(entries[len(entries)-1].Version)
+	}
+
+	history.Items = make([]HistoryItem, len(entries))
+	for i, entry := range entries {
+		summary, details := describeEvent(entry.Event)
+		history.Items[i] = HistoryItem{
+			Version:    entry.Version,
+			EventType:  entry.Event.EventType(),
+			OccurredAt: entry.RecordedAt,
+			Summary:    summary,
+			Details:    details,
+			Metadata:   entry.Metadata,
+		}
+	}
+
+	return history, nil
+}
+
+// GetMemberAt returns the member as they were at the given time.
+func (s *MemberService) GetMemberAt(ctx context.Context, memberID string, at time.Time) (*aggregate.Member, error) {
+	return s.repo.GetByIDAt(ctx, memberID, at)
+}
+
+// describeEvent summarises an event for the timeline.
+func describeEvent(event events.Event) (string, []HistoryDetail) {
+	switch e := event.(type) {
+	case events.MemberRegistered:
+		return "Registered", []HistoryDetail{
+			{"email", maskEmail(e.Email)},
+		}
+	case events.ProfileUpdated:
+		return "Profile updated", []HistoryDetail{
+			{"display name", e.DisplayName},
+			{"bio", e.Bio},
+			{"birth year", strconv.Itoa(e.BirthDate.Year())},
+			{"gender", e.Gender},
+		}
+	case events.EmailVerified:
+		return "Email address verified", []HistoryDetail{
+			{"email", maskEmail(e.Email)},
+		}
+	case events.MemberActivated:
+		return "Activated", nil
+	case events.MemberSuspended:
+		return "Suspended", []HistoryDetail{
+			{"reason", e.Reason},
+		}
+	case events.MemberReinstated:
+		return "Reinstated", []HistoryDetail{
+			{"reason", e.Reason},
+			{"status", e.Status},
+		}
+	case events.SessionsRevoked:
+		return "Signed out everywhere", []HistoryDetail{
+			{"reason", e.Reason},
+		}
+	case events.PhotoAdded:
+		return "Photo added", []HistoryDetail{
+			{"photo", e.PhotoID},
+		}
+	case events.PhotoRemoved:
+		return "Photo removed", []HistoryDetail{
+			{"photo", e.PhotoID},
+		}
+	case events.PhotosReordered:
+		return "Photos reordered", []HistoryDetail{
+			{"photos", strconv.Itoa(len(e.URLs))},
+		}
+	case events.MemberBlocked:
+		return "Blocked a member", []HistoryDetail{
+			{"member", e.BlockedMemberID},
+		}
+	case events.MemberUnblocked:
+		return "Unblocked a member", []HistoryDetail{
+			{"member", e.BlockedMemberID},
+		}
+	case events.PreferencesUpdated:
+		return "Preferences updated", []HistoryDetail{
+			{"age", fmt.Sprintf("%d-%d", e.MinAge, e.MaxAge)},
+			{"genders", strings.Join(e.Genders, ", ")},
+			{"max distance", fmt.Sprintf("%d km", e.MaxDistanceKm)},
+			{"dealbreakers", strings.Join(e.Dealbreakers, ", ")},
+		}
+	case events.InterestsUpdated:
+		return "Interests updated", []HistoryDetail{
+			{"interests", strings.Join(e.InterestIDs, ", ")},
+		}
+	case events.PromptAnswered:
+		return "Prompt answered", []HistoryDetail{
+			{"prompt", e.PromptID},
+			{"answer", e.Answer},
+		}
+	case events.PromptRemoved:
+		return "Prompt removed", []HistoryDetail{
+			{"prompt", e.PromptID},
+		}
+	case events.PromptsReordered:
+		return "Prompts reordered", []HistoryDetail{
+			{"prompts", strings.Join(e.PromptIDs, ", ")},
+		}
+	default:
+		return event.EventType(), nil
+	}
+}
+
+// maskEmail keeps the first character of the local part and the domain,
+// which is enough to recognise an address a member quotes to support.
+func maskEmail(email string) string {
+	local, domain, ok := strings.Cut(email, "@")
+	if !ok || local == "" {
+		return "***"
+	}
+	return local[:1] + "***@" + domain
+}
@@ -3,23 +3,36 @@ package application
 import (
 	"context"
 	"errors"
+	"fmt"
 
+	"go.opentelemetry.io/otel"
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/aggregate"
 	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/commands"
 	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/repository"
 	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/valueobject"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/logging"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/telemetry"
 )
 
 var (
-	ErrMemberAlreadyExists  = errors.New("member with this email already exists")
-	ErrInvalidCredentials   = errors.New("invalid email or password")
+	ErrMemberAlreadyExists      = errors.New("member with this email already exists")
+	ErrInvalidCredentials       = errors.New("invalid email or password")
+	ErrContentRejected          = errors.New("text contains content that is not allowed on profiles")
+	ErrInvalidVerificationToken = errors.New("email verification link is invalid or has expired")
+	ErrEmailAlreadyVerified     = errors.New("email address is already verified")
 )
 
+var tracer = otel.Tracer("github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/application")
+
 type MemberService struct {
 	repo       repository.MemberRepository
+	roles      repository.RoleRepository
+	auditLog   repository.AuditLog
 	eventStore EventStore
+	moderator  TextModerator
+	verifier   EmailVerifier
 }
 
 type EventStore interface {
@@ -27,10 +40,31 @@ type EventStore interface {
 	Load(ctx context.Context, aggregateID string) ([]any, error)
 }
 
-func NewMemberService(repo repository.MemberRepository, eventStore EventStore) *MemberService {
+// TextModerator screens text members write for their profile, such as the
+// bio and prompt answers, before it is shown to others. Text that is not
+// allowed comes back with a reason, which is logged but not shown to the
+// member.
+type TextModerator interface {
+	Review(ctx context.Context, memberID, text string) (allowed bool, reason string, err error)
+}
+
+// EmailVerifier sends members a link to confirm their email address and
+// checks the token from that link, returning who it was issued to.
+type EmailVerifier interface {
+	Send(ctx context.Context, memberID, email string) error
+	Verify(token string) (memberID, email string, err error)
+}
+
+// NewMemberService creates the member service. The moderator is optional;
+// without one, profile text is accepted as is.
+func NewMemberService(repo repository.MemberRepository, roles repository.RoleRepository, auditLog repository.AuditLog, eventStore EventStore, moderator TextModerator, verifier EmailVerifier) *MemberService {
 	return &MemberService{
 		repo:       repo,
+		roles:      roles,
+		auditLog:   auditLog,
 		eventStore: eventStore,
+		moderator:  moderator,
+		verifier:   verifier,
 	}
 }
 
@@ -51,7 +85,9 @@ func (s *MemberService) RegisterMember(ctx context.Context, cmd commands.Registe
 	}
 
 	// Hash password
+	_, span := tracer.Start(ctx, "bcrypt.GenerateFromPassword")
 	passwordHash, err := bcrypt.GenerateFromPassword([]byte(cmd.Password), bcrypt.DefaultCost)
+	telemetry.End(span, err)
 	if err != nil {
 		return nil, err
 	}
@@ -60,6 +96,53 @@ func (s *MemberService) RegisterMember(ctx context.Context, cmd commands.Registe
 		return nil, err
 	}
 
+	// The member can ask for a new link, so a failed send does not fail
+	// the registration
+	if err := s.verifier.Send(ctx, member.ID(), member.Email().String()); err != nil {
+		logging.FromContext(ctx).Warn("failed to send email verification", "member_id", member.ID(), "error", err)
+	}
+
+	return member, nil
+}
+
+// SendEmailVerification sends the member a new email verification link.
+func (s *MemberService) SendEmailVerification(ctx context.Context, cmd commands.SendEmailVerification) error {
+	member, err := s.repo.GetByID(ctx, cmd.MemberID)
+	if err != nil {
+		return err
+	}
+	if member.EmailVerified() {
+		return ErrEmailAlreadyVerified
+	}
+
+	return s.verifier.Send(ctx, member.ID(), member.Email().String())
+}
+
+// VerifyEmail confirms the email address of the member a verification
+// link was sent to. Links sent to an address the member has since changed
+// are rejected.
+func (s *MemberService) VerifyEmail(ctx context.Context, cmd commands.VerifyEmail) (*aggregate.Member, error) {
+	memberID, email, err := s.verifier.Verify(cmd.Token)
+	if err != nil {
+		return nil, ErrInvalidVerificationToken
+	}
+
+	member, err := s.repo.GetByID(ctx, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if member.Email().String() != email {
+		return nil, ErrInvalidVerificationToken
+	}
+
+	if err := member.VerifyEmail(); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Save(ctx, member); err != nil {
+		return nil, err
+	}
+
 	return member, nil
 }
 
@@ -78,10 +161,18 @@ func (s *MemberService) AuthenticateMember(ctx context.Context, email, password
 		return nil, err
 	}
 
-	if err := bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(password)); err != nil {
+	// A mismatch is an expected outcome, so it is not recorded as a span error
+	_, span := tracer.Start(ctx, "bcrypt.CompareHashAndPassword")
+	err = bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(password))
+	span.End()
+	if err != nil {
 		return nil, ErrInvalidCredentials
 	}
 
+	if member.Status() == aggregate.MemberStatusSuspended {
+		return nil, aggregate.ErrMemberSuspended
+	}
+
 	return member, nil
 }
 
@@ -101,6 +192,10 @@ func (s *MemberService) UpdateProfile(ctx context.Context, cmd commands.UpdatePr
 		return err
 	}
 
+	if err := s.reviewText(ctx, cmd.MemberID, profile.Bio); err != nil {
+		return err
+	}
+
 	if err := member.UpdateProfile(profile); err != nil {
 		return err
 	}
@@ -108,6 +203,136 @@ func (s *MemberService) UpdateProfile(ctx context.Context, cmd commands.UpdatePr
 	return s.repo.Save(ctx, member)
 }
 
+func (s *MemberService) AnswerPrompt(ctx context.Context, cmd commands.AnswerPrompt) error {
+	member, err := s.repo.GetByID(ctx, cmd.MemberID)
+	if err != nil {
+		return err
+	}
+
+	answer, err := valueobject.NewPromptAnswer(valueobject.PromptID(cmd.PromptID), cmd.Answer)
+	if err != nil {
+		return err
+	}
+
+	if err := s.reviewText(ctx, cmd.MemberID, answer.Answer); err != nil {
+		return err
+	}
+
+	if err := member.AnswerPrompt(answer); err != nil {
+		return err
+	}
+
+	return s.repo.Save(ctx, member)
+}
+
+func (s *MemberService) RemovePrompt(ctx context.Context, cmd commands.RemovePrompt) error {
+	member, err := s.repo.GetByID(ctx, cmd.MemberID)
+	if err != nil {
+		return err
+	}
+
+	if err := member.RemovePrompt(valueobject.PromptID(cmd.PromptID)); err != nil {
+		return err
+	}
+
+	return s.repo.Save(ctx, member)
+}
+
+func (s *MemberService) ReorderPrompts(ctx context.Context, cmd commands.ReorderPrompts) error {
+	member, err := s.repo.GetByID(ctx, cmd.MemberID)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]valueobject.PromptID, len(cmd.PromptIDs))
+	for i, id := range cmd.PromptIDs {
+		ids[i] = valueobject.PromptID(id)
+	}
+
+	if err := member.ReorderPrompts(ids); err != nil {
+		return err
+	}
+
+	return s.repo.Save(ctx, member)
+}
+
+// ListPrompts returns the prompt catalogue members pick from.
+func (s *MemberService) ListPrompts() []valueobject.Prompt {
+	return valueobject.Prompts()
+}
+
+// reviewText runs profile text past the moderator, if one is configured.
+func (s *MemberService) reviewText(ctx context.Context, memberID, text string) error {
+	if s.moderator == nil {
+		return nil
+	}
+
+	allowed, reason, err := s.moderator.Review(ctx, memberID, text)
+	if err != nil {
+		return fmt.Errorf("review text: %w", err)
+	}
+	if !allowed {
+		logging.FromContext(ctx).Info("rejected profile text", "member_id", memberID, "reason", reason)
+		return ErrContentRejected
+	}
+	return nil
+}
+
+// ListInterests returns the interests catalogue members pick from.
+func (s *MemberService) ListInterests() []valueobject.Interest {
+	return valueobject.Interests()
+}
+
+func (s *MemberService) SetInterests(ctx context.Context, cmd commands.SetInterests) error {
+	member, err := s.repo.GetByID(ctx, cmd.MemberID)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]valueobject.InterestID, len(cmd.InterestIDs))
+	for i, id := range cmd.InterestIDs {
+		ids[i] = valueobject.InterestID(id)
+	}
+
+	interests, err := valueobject.NewInterests(ids)
+	if err != nil {
+		return err
+	}
+
+	if err := member.SetInterests(interests); err != nil {
+		return err
+	}
+
+	return s.repo.Save(ctx, member)
+}
+
+func (s *MemberService) UpdatePreferences(ctx context.Context, cmd commands.UpdatePreferences) error {
+	member, err := s.repo.GetByID(ctx, cmd.MemberID)
+	if err != nil {
+		return err
+	}
+
+	genders := make([]valueobject.Gender, len(cmd.Genders))
+	for i, g := range cmd.Genders {
+		genders[i] = valueobject.Gender(g)
+	}
+	dealbreakers := make([]valueobject.Dealbreaker, len(cmd.Dealbreakers))
+	for i, d := range cmd.Dealbreakers {
+		dealbreakers[i] = valueobject.Dealbreaker(d)
+	}
+
+	prefs, err := valueobject.NewPreferences(cmd.MinAge, cmd.MaxAge, genders, cmd.MaxDistanceKm, dealbreakers)
+	if err != nil {
+		return err
+	}
+
+	if err := member.UpdatePreferences(prefs); err != nil {
+		return err
+	}
+
+	return s.repo.Save(ctx, member)
+}
+
 func (s *MemberService) ActivateMember(ctx context.Context, cmd commands.ActivateMember) error {
 	member, err := s.repo.GetByID(ctx, cmd.MemberID)
 	if err != nil {
@@ -121,6 +346,118 @@ func (s *MemberService) ActivateMember(ctx context.Context, cmd commands.Activat
 	return s.repo.Save(ctx, member)
 }
 
+func (s *MemberService) AddPhoto(ctx context.Context, cmd commands.AddPhoto) error {
+	member, err := s.repo.GetByID(ctx, cmd.MemberID)
+	if err != nil {
+		return err
+	}
+
+	if err := member.AddPhoto(cmd.PhotoID, valueobject.PhotoURL(cmd.URL)); err != nil {
+		return err
+	}
+
+	return s.repo.Save(ctx, member)
+}
+
+func (s *MemberService) RemovePhoto(ctx context.Context, cmd commands.RemovePhoto) error {
+	member, err := s.repo.GetByID(ctx, cmd.MemberID)
+	if err != nil {
+		return err
+	}
+
+	if err := member.RemovePhoto(cmd.PhotoID, valueobject.PhotoURL(cmd.URL)); err != nil {
+		return err
+	}
+
+	return s.repo.Save(ctx, member)
+}
+
+func (s *MemberService) ReorderPhotos(ctx context.Context, cmd commands.ReorderPhotos) error {
+	member, err := s.repo.GetByID(ctx, cmd.MemberID)
+	if err != nil {
+		return err
+	}
+
+	urls := make([]valueobject.PhotoURL, len(cmd.URLs))
+	for i, url := range cmd.URLs {
+		urls[i] = valueobject.PhotoURL(url)
+	}
+
+	if err := member.ReorderPhotos(urls); err != nil {
+		return err
+	}
+
+	return s.repo.Save(ctx, member)
+}
+
+func (s *MemberService) SetPrimaryPhoto(ctx context.Context, cmd commands.SetPrimaryPhoto) error {
+	member, err := s.repo.GetByID(ctx, cmd.MemberID)
+	if err != nil {
+		return err
+	}
+
+	if err := member.SetPrimaryPhoto(valueobject.PhotoURL(cmd.URL)); err != nil {
+		return err
+	}
+
+	return s.repo.Save(ctx, member)
+}
+
+func (s *MemberService) SuspendMember(ctx context.Context, cmd commands.SuspendMember) error {
+	member, err := s.repo.GetByID(ctx, cmd.MemberID)
+	if err != nil {
+		return err
+	}
+
+	if member.Status() == aggregate.MemberStatusSuspended {
+		return nil
+	}
+	if err := member.Suspend(cmd.Reason); err != nil {
+		return err
+	}
+	// Suspended members cannot sign in, and must not stay signed in either
+	member.RevokeSessions(cmd.Reason)
+
+	return s.repo.Save(ctx, member)
+}
+
+func (s *MemberService) BlockMember(ctx context.Context, cmd commands.BlockMember) error {
+	member, err := s.repo.GetByID(ctx, cmd.MemberID)
+	if err != nil {
+		return err
+	}
+
+	// The blocked member must exist, so typos do not leave dangling blocks.
+	if _, err := s.repo.GetByID(ctx, cmd.BlockedMemberID); err != nil {
+		return err
+	}
+
+	if err := member.Block(cmd.BlockedMemberID); err != nil {
+		return err
+	}
+
+	return s.repo.Save(ctx, member)
+}
+
+func (s *MemberService) UnblockMember(ctx context.Context, cmd commands.UnblockMember) error {
+	member, err := s.repo.GetByID(ctx, cmd.MemberID)
+	if err != nil {
+		return err
+	}
+
+	if err := member.Unblock(cmd.BlockedMemberID); err != nil {
+		return err
+	}
+
+	return s.repo.Save(ctx, member)
+}
+
+// IsBlocked reports whether either member has blocked the other. Discovery,
+// matching and messaging must not bring such members together.
+func (s *MemberService) IsBlocked(ctx context.Context, memberID, otherMemberID string) (bool, error) {
+	return s.repo.IsBlocked(ctx, memberID, otherMemberID)
+}
+
 func (s *MemberService) GetMember(ctx context.Context, memberID string) (*aggregate.Member, error) {
 	return s.repo.GetByID(ctx, memberID)
 }
@@ -0,0 +1,63 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// valid returns a configuration that passes Validate in env.
+func valid(env string) *Config {
+	return &Config{
+		Environment:        env,
+		JWTSecret:          strings.Repeat("s", 32),
+		SMTPAddr:           "smtp:25",
+		ServiceTokens:      "gateway=g,admin=a",
+		GRPCDefaultTimeout: 10 * time.Second,
+		GRPCMaxTimeout:     30 * time.Second,
+	}
+}
+
+func TestValidateReportsEveryProblem(t *testing.T) {
+	cfg := valid("staging")
+	cfg.JWTSecret = "short"
+	cfg.SMTPAddr = ""
+	cfg.GRPCDefaultTimeout = time.Minute
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate accepted an invalid configuration")
+	}
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok || len(joined.Unwrap()) != 3 {
+		t.Fatalf("Validate = %v, want 3 joined errors", err)
+	}
+	for _, name := range []string{"JWT_SECRET", "SMTP_ADDR", "GRPC_DEFAULT_TIMEOUT"} {
+		if !strings.Contains(err.Error(), name) {
+			t.Errorf("%s is not reported in %q", name, err)
+		}
+	}
+}
+
+func TestValidateRejectsDevelopmentAdminToken(t *testing.T) {
+	tokens := "gateway=g, admin=dev-admin-token"
+	for env, wantErr := range map[string]bool{
+		"development": false,
+		"staging":     true,
+		"production":  true,
+	} {
+		cfg := valid(env)
+		cfg.ServiceTokens = tokens
+		err := cfg.Validate()
+		if (err != nil) != wantErr {
+			t.Errorf("%s: Validate = %v, want error %v", env, err, wantErr)
+		}
+		if err != nil && !strings.Contains(err.Error(), "SERVICE_TOKENS") {
+			t.Errorf("%s: %v does not name SERVICE_TOKENS", env, err)
+		}
+	}
+
+	if err := valid("production").Validate(); err != nil {
+		t.Errorf("Validate with replaced tokens = %v", err)
+	}
+}
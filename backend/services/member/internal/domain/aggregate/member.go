@@ -12,17 +12,32 @@ var (
 	ErrMemberNotFound     = errors.New("member not found")
 	ErrInvalidEmail       = errors.New("invalid email address")
 	ErrProfileIncomplete  = errors.New("profile is incomplete")
+	ErrPhotoNotFound      = errors.New("photo not found on profile")
+	ErrInvalidPhotoOrder  = errors.New("photo order must list every profile photo exactly once")
+	ErrCannotBlockSelf    = errors.New("members cannot block themselves")
+	ErrMemberSuspended    = errors.New("member is suspended")
+	ErrMemberNotSuspended = errors.New("member is not suspended")
 )
 
 type Member struct {
 	id        string
 	email     valueobject.Email
 	profile   valueobject.Profile
+	prompts   valueobject.ProfilePrompts
+	prefs     valueobject.Preferences
 	status    MemberStatus
+	blocked   []string
 	createdAt time.Time
 	updatedAt time.Time
 	version   int
 
+	// prefsSet records whether the member chose their preferences, rather
+	// than keeping the defaults.
+	prefsSet      bool
+	emailVerified bool
+	// sessionsRevokedAt invalidates the member's tokens issued before it.
+	sessionsRevokedAt time.Time
+
 	changes []events.Event
 }
 
@@ -38,6 +53,7 @@ func NewMember(id string, email valueobject.Email) (*Member, error) {
 	m := &Member{
 		id:        id,
 		email:     email,
+		prefs:     valueobject.DefaultPreferences(),
 		status:    MemberStatusPending,
 		createdAt: time.Now(),
 		updatedAt: time.Now(),
@@ -74,7 +90,41 @@ func (m *Member) Profile() valueobject.Profile {
 	return m.profile
 }
 
+// Prompts returns the member's answered prompts in display order.
+func (m *Member) Prompts() valueobject.ProfilePrompts {
+	return m.prompts
+}
+
+// Preferences returns who the member wants to see in discovery.
+func (m *Member) Preferences() valueobject.Preferences {
+	return m.prefs
+}
+
+// EmailVerified reports whether the member confirmed their email address.
+func (m *Member) EmailVerified() bool {
+	return m.emailVerified
+}
+
+// BlockedMembers returns the IDs of the members this member has blocked.
+func (m *Member) BlockedMembers() []string {
+	return m.blocked
+}
+
+func (m *Member) HasBlocked(memberID string) bool {
+	for _, id := range m.blocked {
+		if id == memberID {
+			return true
+		}
+	}
+	return false
+}
+
+// UpdateProfile replaces the member's basic profile details. Photos and
+// interests have their own operations and are carried over unchanged.
 func (m *Member) UpdateProfile(profile valueobject.Profile) error {
+	profile.Photos = m.profile.Photos
+	profile.Interests = m.profile.Interests
+
 	m.profile = profile
 	m.updatedAt = time.Now()
 
@@ -83,16 +133,272 @@ func (m *Member) UpdateProfile(profile valueobject.Profile) error {
 		DisplayName: profile.DisplayName,
 		Bio:         profile.Bio,
 		BirthDate:   profile.BirthDate,
+		Gender:      string(profile.Gender),
 		Timestamp:   m.updatedAt,
 	})
 
+	m.completeOnboarding()
+	return nil
+}
+
+// AnswerPrompt adds an answered prompt to the profile, or replaces the
+// answer when the prompt is already on it.
+func (m *Member) AnswerPrompt(answer valueobject.PromptAnswer) error {
+	prompts, err := m.prompts.Answer(answer)
+	if err != nil {
+		return err
+	}
+
+	m.prompts = prompts
+	m.updatedAt = time.Now()
+
+	m.raise(events.PromptAnswered{
+		MemberID:  m.id,
+		PromptID:  string(answer.PromptID),
+		Answer:    answer.Answer,
+		Timestamp: m.updatedAt,
+	})
+
+	m.completeOnboarding()
+	return nil
+}
+
+// RemovePrompt removes an answered prompt from the profile.
+func (m *Member) RemovePrompt(promptID valueobject.PromptID) error {
+	prompts, err := m.prompts.Remove(promptID)
+	if err != nil {
+		return err
+	}
+
+	m.prompts = prompts
+	m.updatedAt = time.Now()
+
+	m.raise(events.PromptRemoved{
+		MemberID:  m.id,
+		PromptID:  string(promptID),
+		Timestamp: m.updatedAt,
+	})
+
+	return nil
+}
+
+// ReorderPrompts sets the display order of the answered prompts.
+func (m *Member) ReorderPrompts(promptIDs []valueobject.PromptID) error {
+	prompts, err := m.prompts.Reorder(promptIDs)
+	if err != nil {
+		return err
+	}
+
+	m.prompts = prompts
+	m.updatedAt = time.Now()
+
+	ids := make([]string, len(promptIDs))
+	for i, id := range promptIDs {
+		ids[i] = string(id)
+	}
+
+	m.raise(events.PromptsReordered{
+		MemberID:  m.id,
+		PromptIDs: ids,
+		Timestamp: m.updatedAt,
+	})
+
+	return nil
+}
+
+// UpdatePreferences replaces the member's discovery preferences.
+func (m *Member) UpdatePreferences(prefs valueobject.Preferences) error {
+	m.prefs = prefs
+	m.prefsSet = true
+	m.updatedAt = time.Now()
+
+	genders := make([]string, len(prefs.Genders))
+	for i, g := range prefs.Genders {
+		genders[i] = string(g)
+	}
+	dealbreakers := make([]string, len(prefs.Dealbreakers))
+	for i, d := range prefs.Dealbreakers {
+		dealbreakers[i] = string(d)
+	}
+
+	m.raise(events.PreferencesUpdated{
+		MemberID:      m.id,
+		MinAge:        prefs.MinAge,
+		MaxAge:        prefs.MaxAge,
+		Genders:       genders,
+		MaxDistanceKm: prefs.MaxDistanceKm,
+		Dealbreakers:  dealbreakers,
+		Timestamp:     m.updatedAt,
+	})
+
+	m.completeOnboarding()
+	return nil
+}
+
+// SetInterests replaces the interests on the member's profile. The IDs must
+// already be validated against the catalogue.
+func (m *Member) SetInterests(interests []valueobject.InterestID) error {
+	m.profile.Interests = interests
+	m.updatedAt = time.Now()
+
+	ids := make([]string, len(interests))
+	for i, id := range interests {
+		ids[i] = string(id)
+	}
+
+	m.raise(events.InterestsUpdated{
+		MemberID:    m.id,
+		InterestIDs: ids,
+		Timestamp:   m.updatedAt,
+	})
+
+	return nil
+}
+
+// AddPhoto attaches a processed photo to the end of the member's profile.
+// Adding a photo that is already attached is a no-op, so a media event the
+// outbox publishes twice is harmless.
+func (m *Member) AddPhoto(photoID string, url valueobject.PhotoURL) error {
+	if m.hasPhoto(url) {
+		return nil
+	}
+	if len(m.profile.Photos) >= valueobject.MaxPhotos {
+		return valueobject.ErrTooManyPhotos
+	}
+
+	m.profile.Photos = append(m.profile.Photos, url)
+	m.updatedAt = time.Now()
+
+	m.raise(events.PhotoAdded{
+		MemberID:  m.id,
+		PhotoID:   photoID,
+		URL:       string(url),
+		Timestamp: m.updatedAt,
+	})
+
+	m.completeOnboarding()
+	return nil
+}
+
+// RemovePhoto detaches a photo from the member's profile. Removing a photo
+// that is not attached is a no-op.
+func (m *Member) RemovePhoto(photoID string, url valueobject.PhotoURL) error {
+	if !m.hasPhoto(url) {
+		return nil
+	}
+
+	m.profile.Photos = removePhoto(m.profile.Photos, url)
+	m.updatedAt = time.Now()
+
+	m.raise(events.PhotoRemoved{
+		MemberID:  m.id,
+		PhotoID:   photoID,
+		URL:       string(url),
+		Timestamp: m.updatedAt,
+	})
+
+	return nil
+}
+
+// ReorderPhotos sets the display order of the profile photos. The first
+// photo is the primary photo. The order must contain every current photo
+// exactly once.
+func (m *Member) ReorderPhotos(urls []valueobject.PhotoURL) error {
+	if len(urls) != len(m.profile.Photos) {
+		return ErrInvalidPhotoOrder
+	}
+	seen := make(map[valueobject.PhotoURL]bool, len(urls))
+	for _, url := range urls {
+		if seen[url] || !m.hasPhoto(url) {
+			return ErrInvalidPhotoOrder
+		}
+		seen[url] = true
+	}
+
+	m.reorderPhotos(urls)
+	return nil
+}
+
+// SetPrimaryPhoto moves the given photo to the front of the profile,
+// keeping the relative order of the others.
+func (m *Member) SetPrimaryPhoto(url valueobject.PhotoURL) error {
+	if !m.hasPhoto(url) {
+		return ErrPhotoNotFound
+	}
+	if m.profile.PrimaryPhoto() == url {
+		return nil
+	}
+
+	m.reorderPhotos(append([]valueobject.PhotoURL{url}, removePhoto(m.profile.Photos, url)...))
+	return nil
+}
+
+func (m *Member) reorderPhotos(urls []valueobject.PhotoURL) {
+	m.profile.Photos = urls
+	m.updatedAt = time.Now()
+
+	raw := make([]string, len(urls))
+	for i, url := range urls {
+		raw[i] = string(url)
+	}
+
+	m.raise(events.PhotosReordered{
+		MemberID:  m.id,
+		URLs:      raw,
+		Timestamp: m.updatedAt,
+	})
+}
+
+func (m *Member) hasPhoto(url valueobject.PhotoURL) bool {
+	for _, p := range m.profile.Photos {
+		if p == url {
+			return true
+		}
+	}
+	return false
+}
+
+func removePhoto(photos []valueobject.PhotoURL, url valueobject.PhotoURL) []valueobject.PhotoURL {
+	result := make([]valueobject.PhotoURL, 0, len(photos))
+	for _, p := range photos {
+		if p != url {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// VerifyEmail marks the member's email address as confirmed. Verifying a
+// verified address is a no-op.
+func (m *Member) VerifyEmail() error {
+	if m.emailVerified {
+		return nil
+	}
+
+	m.emailVerified = true
+	m.updatedAt = time.Now()
+
+	m.raise(events.EmailVerified{
+		MemberID:  m.id,
+		Email:     m.email.String(),
+		Timestamp: m.updatedAt,
+	})
+
+	m.completeOnboarding()
 	return nil
 }
 
+// Activate makes the member discoverable. Members are activated
+// automatically when they finish onboarding; activating by hand also
+// requires a complete onboarding. Suspended members are brought back with
+// Reinstate.
 func (m *Member) Activate() error {
 	if m.status == MemberStatusActive {
 		return nil
 	}
+	if !m.Onboarding().IsComplete() {
+		return ErrProfileIncomplete
+	}
 
 	m.status = MemberStatusActive
 	m.updatedAt = time.Now()
@@ -105,6 +411,116 @@ func (m *Member) Activate() error {
 	return nil
 }
 
+// Suspend bars the member from the platform, typically as the outcome of a
+// moderation case. Suspending a suspended member is a no-op.
+func (m *Member) Suspend(reason string) error {
+	if m.status == MemberStatusSuspended {
+		return nil
+	}
+
+	m.status = MemberStatusSuspended
+	m.updatedAt = time.Now()
+
+	m.raise(events.MemberSuspended{
+		MemberID:  m.id,
+		Reason:    reason,
+		Timestamp: m.updatedAt,
+	})
+
+	return nil
+}
+
+// Reinstate lifts a suspension. The member becomes active again, or
+// pending when they had not finished onboarding before.
+func (m *Member) Reinstate(reason string) error {
+	if m.status != MemberStatusSuspended {
+		return ErrMemberNotSuspended
+	}
+
+	m.status = MemberStatusPending
+	if m.Onboarding().IsComplete() {
+		m.status = MemberStatusActive
+	}
+	m.updatedAt = time.Now()
+
+	m.raise(events.MemberReinstated{
+		MemberID:  m.id,
+		Reason:    reason,
+		Status:    string(m.status),
+		Timestamp: m.updatedAt,
+	})
+
+	return nil
+}
+
+// RevokeSessions signs the member out on every device: tokens issued
+// before now are no longer accepted.
+func (m *Member) RevokeSessions(reason string) {
+	m.sessionsRevokedAt = time.Now()
+
+	m.raise(events.SessionsRevoked{
+		MemberID:  m.id,
+		Reason:    reason,
+		Timestamp: m.sessionsRevokedAt,
+	})
+}
+
+// SessionsRevokedAt is when the member was last signed out everywhere, or
+// the zero time if never.
+func (m *Member) SessionsRevokedAt() time.Time {
+	return m.sessionsRevokedAt
+}
+
+// Block hides the other member from this member and vice versa. Blocking an
+// already blocked member is a no-op.
+func (m *Member) Block(memberID string) error {
+	if memberID == m.id {
+		return ErrCannotBlockSelf
+	}
+	if m.HasBlocked(memberID) {
+		return nil
+	}
+
+	m.blocked = append(m.blocked, memberID)
+	m.updatedAt = time.Now()
+
+	m.raise(events.MemberBlocked{
+		MemberID:        m.id,
+		BlockedMemberID: memberID,
+		Timestamp:       m.updatedAt,
+	})
+
+	return nil
+}
+
+// Unblock lifts a block. Unblocking a member that is not blocked is a no-op.
+func (m *Member) Unblock(memberID string) error {
+	if !m.HasBlocked(memberID) {
+		return nil
+	}
+
+	m.blocked = removeMemberID(m.blocked, memberID)
+	m.updatedAt = time.Now()
+
+	m.raise(events.MemberUnblocked{
+		MemberID:        m.id,
+		BlockedMemberID: memberID,
+		Timestamp:       m.updatedAt,
+	})
+
+	return nil
+}
+
+func removeMemberID(ids []string, id string) []string {
+	result := make([]string, 0, len(ids))
+	for _, existing := range ids {
+		if existing != id {
+			result = append(result, existing)
+		}
+	}
+	return result
+}
+
 func (m *Member) raise(event events.Event) {
 	m.changes = append(m.changes, event)
 }
@@ -122,6 +538,7 @@ func (m *Member) Apply(event events.Event) {
 	case events.MemberRegistered:
 		m.id = e.MemberID
 		m.email = valueobject.Email(e.Email)
+		m.prefs = valueobject.DefaultPreferences()
 		m.status = MemberStatusPending
 		m.createdAt = e.Timestamp
 	case events.ProfileUpdated:
@@ -129,11 +546,91 @@ func (m *Member) Apply(event events.Event) {
 			DisplayName: e.DisplayName,
 			Bio:         e.Bio,
 			BirthDate:   e.BirthDate,
+			Gender:      valueobject.Gender(e.Gender),
+			Interests:   m.profile.Interests,
+			Photos:      m.profile.Photos,
+		}
+		m.updatedAt = e.Timestamp
+	// Prompt events were validated when raised, so replaying them cannot
+	// fail; the checks only guard against a corrupted stream.
+	case events.PromptAnswered:
+		answer := valueobject.PromptAnswer{PromptID: valueobject.PromptID(e.PromptID), Answer: e.Answer}
+		if prompts, err := m.prompts.Answer(answer); err == nil {
+			m.prompts = prompts
+		}
+		m.updatedAt = e.Timestamp
+	case events.PromptRemoved:
+		if prompts, err := m.prompts.Remove(valueobject.PromptID(e.PromptID)); err == nil {
+			m.prompts = prompts
+		}
+		m.updatedAt = e.Timestamp
+	case events.PromptsReordered:
+		ids := make([]valueobject.PromptID, len(e.PromptIDs))
+		for i, id := range e.PromptIDs {
+			ids[i] = valueobject.PromptID(id)
+		}
+		if prompts, err := m.prompts.Reorder(ids); err == nil {
+			m.prompts = prompts
+		}
+		m.updatedAt = e.Timestamp
+	case events.InterestsUpdated:
+		interests := make([]valueobject.InterestID, len(e.InterestIDs))
+		for i, id := range e.InterestIDs {
+			interests[i] = valueobject.InterestID(id)
 		}
+		m.profile.Interests = interests
+		m.updatedAt = e.Timestamp
+	case events.PreferencesUpdated:
+		genders := make([]valueobject.Gender, len(e.Genders))
+		for i, g := range e.Genders {
+			genders[i] = valueobject.Gender(g)
+		}
+		dealbreakers := make([]valueobject.Dealbreaker, len(e.Dealbreakers))
+		for i, d := range e.Dealbreakers {
+			dealbreakers[i] = valueobject.Dealbreaker(d)
+		}
+		m.prefs = valueobject.Preferences{
+			MinAge:        e.MinAge,
+			MaxAge:        e.MaxAge,
+			Genders:       genders,
+			MaxDistanceKm: e.MaxDistanceKm,
+			Dealbreakers:  dealbreakers,
+		}
+		m.prefsSet = true
+		m.updatedAt = e.Timestamp
+	case events.EmailVerified:
+		m.emailVerified = true
 		m.updatedAt = e.Timestamp
 	case events.MemberActivated:
 		m.status = MemberStatusActive
 		m.updatedAt = e.Timestamp
+	case events.MemberSuspended:
+		m.status = MemberStatusSuspended
+		m.updatedAt = e.Timestamp
+	case events.MemberReinstated:
+		m.status = MemberStatus(e.Status)
+		m.updatedAt = e.Timestamp
+	case events.SessionsRevoked:
+		m.sessionsRevokedAt = e.Timestamp
+	case events.MemberBlocked:
+		m.blocked = append(m.blocked, e.BlockedMemberID)
+		m.updatedAt = e.Timestamp
+	case events.MemberUnblocked:
+		m.blocked = removeMemberID(m.blocked, e.BlockedMemberID)
+		m.updatedAt = e.Timestamp
+	case events.PhotoAdded:
+		m.profile.Photos = append(m.profile.Photos, valueobject.PhotoURL(e.URL))
+		m.updatedAt = e.Timestamp
+	case events.PhotoRemoved:
+		m.profile.Photos = removePhoto(m.profile.Photos, valueobject.PhotoURL(e.URL))
+		m.updatedAt = e.Timestamp
+	case events.PhotosReordered:
+		photos := make([]valueobject.PhotoURL, len(e.URLs))
+		for i, url := range e.URLs {
+			photos[i] = valueobject.PhotoURL(url)
+		}
+		m.profile.Photos = photos
+		m.updatedAt = e.Timestamp
 	}
 	m.version++
 }
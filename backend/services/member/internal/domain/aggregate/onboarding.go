@@ -0,0 +1,125 @@
+package aggregate
+
+import "github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/valueobject"
+
+// OnboardingStep is a step a new member goes through before they become
+// discoverable. The steps are taken in the order of onboardingSteps; the
+// app shows the screen for the first step that is not done yet.
+type OnboardingStep string
+
+const (
+	OnboardingStepProfile     OnboardingStep = "profile"
+	OnboardingStepPhotos      OnboardingStep = "photos"
+	OnboardingStepPrompts     OnboardingStep = "prompts"
+	OnboardingStepPreferences OnboardingStep = "preferences"
+	OnboardingStepVerifyEmail OnboardingStep = "verify_email"
+	OnboardingStepComplete    OnboardingStep = "complete"
+)
+
+// MinOnboardingPhotos is the number of photos a member needs before they
+// are shown in discovery.
+const MinOnboardingPhotos = 2
+
+var onboardingSteps = []OnboardingStep{
+	OnboardingStepProfile,
+	OnboardingStepPhotos,
+	OnboardingStepPrompts,
+	OnboardingStepPreferences,
+	OnboardingStepVerifyEmail,
+}
+
+// Onboarding is a member's progress through onboarding.
+type Onboarding struct {
+	// NextStep is the first step that is not done, or
+	// OnboardingStepComplete once all steps are.
+	NextStep OnboardingStep
+	// CompletedSteps lists the steps that are done, in onboarding order.
+	CompletedSteps []OnboardingStep
+	// Completeness is the profile completeness score from 0 to 100.
+	Completeness int
+}
+
+// IsComplete reports whether every onboarding step is done.
+func (o Onboarding) IsComplete() bool {
+	return o.NextStep == OnboardingStepComplete
+}
+
+// Onboarding derives the member's onboarding progress from their profile.
+// Steps can be undone, for example by removing photos, which moves the next
+// step back; an active member stays active.
+func (m *Member) Onboarding() Onboarding {
+	o := Onboarding{
+		NextStep:       OnboardingStepComplete,
+		CompletedSteps: make([]OnboardingStep, 0, len(onboardingSteps)),
+		Completeness:   m.Completeness(),
+	}
+	for _, step := range onboardingSteps {
+		if m.stepDone(step) {
+			o.CompletedSteps = append(o.CompletedSteps, step)
+		} else if o.NextStep == OnboardingStepComplete {
+			o.NextStep = step
+		}
+	}
+	return o
+}
+
+func (m *Member) stepDone(step OnboardingStep) bool {
+	switch step {
+	case OnboardingStepProfile:
+		return m.profile.DisplayName != ""
+	case OnboardingStepPhotos:
+		return len(m.profile.Photos) >= MinOnboardingPhotos
+	case OnboardingStepPrompts:
+		return len(m.prompts) > 0
+	case OnboardingStepPreferences:
+		return m.prefsSet
+	case OnboardingStepVerifyEmail:
+		return m.emailVerified
+	}
+	return false
+}
+
+// Weights of the profile parts in the completeness score. They add up to
+// 100. Photos and prompts count in proportion up to completenessPhotos and
+// valueobject.MaxPrompts respectively.
+const (
+	weightProfile       = 10
+	weightPhotos        = 30
+	weightBio           = 15
+	weightPrompts       = 20
+	weightPreferences   = 10
+	weightEmailVerified = 15
+
+	completenessPhotos = 3
+)
+
+// Completeness scores how complete the member's profile is, from 0 to 100.
+// Unlike onboarding, which only asks for the minimum, the score rewards a
+// bio and more photos and prompts.
+func (m *Member) Completeness() int {
+	score := 0
+	if m.profile.DisplayName != "" {
+		score += weightProfile
+	}
+	score += weightPhotos * min(len(m.profile.Photos), completenessPhotos) / completenessPhotos
+	if m.profile.Bio != "" {
+		score += weightBio
+	}
+	score += weightPrompts * len(m.prompts) / valueobject.MaxPrompts
+	if m.prefsSet {
+		score += weightPreferences
+	}
+	if m.emailVerified {
+		score += weightEmailVerified
+	}
+	return score
+}
+
+// completeOnboarding activates a pending member once the last onboarding
+// step is done, making them discoverable.
+func (m *Member) completeOnboarding() {
+	if m.status != MemberStatusPending || !m.Onboarding().IsComplete() {
+		return
+	}
+	_ = m.Activate()
+}
@@ -0,0 +1,94 @@
+package aggregate
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/valueobject"
+)
+
+func newTestMember(t *testing.T) *Member {
+	t.Helper()
+	email, err := valueobject.NewEmail("member@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := NewMember("11111111-2222-3333-4444-555555555555", email)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+// onboard takes m through every onboarding step but verifying the email.
+func onboard(t *testing.T, m *Member) {
+	t.Helper()
+	profile, err := valueobject.NewProfile("Sam", "", time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC), valueobject.GenderOther)
+	if err != nil {
+		t.Fatal(err)
+	}
+	answer, err := valueobject.NewPromptAnswer("perfect_sunday", "A long walk")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	steps := []func() error{
+		func() error { return m.UpdateProfile(profile) },
+		func() error { return m.AddPhoto("photo-1", "https://media.example.com/1.jpg") },
+		func() error { return m.AddPhoto("photo-2", "https://media.example.com/2.jpg") },
+		func() error { return m.AnswerPrompt(answer) },
+		func() error { return m.UpdatePreferences(valueobject.DefaultPreferences()) },
+	}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// ActivateMember used to activate any pending member. It now refuses
+// members who have not finished onboarding, which the RPC reports as
+// FAILED_PRECONDITION.
+func TestActivateRequiresCompleteOnboarding(t *testing.T) {
+	m := newTestMember(t)
+	onboard(t, m)
+
+	if err := m.Activate(); !errors.Is(err, ErrProfileIncomplete) {
+		t.Fatalf("Activate = %v, want ErrProfileIncomplete", err)
+	}
+	if m.Status() != MemberStatusPending {
+		t.Errorf("status = %s, want pending", m.Status())
+	}
+}
+
+func TestFinishingOnboardingActivates(t *testing.T) {
+	m := newTestMember(t)
+	onboard(t, m)
+
+	if err := m.VerifyEmail(); err != nil {
+		t.Fatal(err)
+	}
+	if m.Status() != MemberStatusActive {
+		t.Fatalf("status = %s, want active", m.Status())
+	}
+	if err := m.Activate(); err != nil {
+		t.Errorf("Activate on an active member = %v", err)
+	}
+}
+
+// Suspended members are brought back with Reinstate, which does not need
+// a complete onboarding: they return to pending instead.
+func TestReinstateWithIncompleteOnboarding(t *testing.T) {
+	m := newTestMember(t)
+	if err := m.Suspend("spam"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.Reinstate("appeal"); err != nil {
+		t.Fatalf("Reinstate = %v", err)
+	}
+	if m.Status() != MemberStatusPending {
+		t.Errorf("status = %s, want pending", m.Status())
+	}
+}
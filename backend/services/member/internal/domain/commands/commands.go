@@ -36,3 +36,123 @@ type SuspendMember struct {
 }
 
 func (c SuspendMember) CommandType() string { return "member.suspend" }
+
+type ReinstateMember struct {
+	MemberID string
+	Reason   string
+}
+
+func (c ReinstateMember) CommandType() string { return "member.reinstate" }
+
+type RevokeSessions struct {
+	MemberID string
+	Reason   string
+}
+
+func (c RevokeSessions) CommandType() string { return "member.revoke_sessions" }
+
+// SetMemberRoles replaces a member's staff roles. GrantedBy is the member
+// making the change, empty for operators.
+type SetMemberRoles struct {
+	MemberID  string
+	Roles     []string
+	GrantedBy string
+}
+
+func (c SetMemberRoles) CommandType() string { return "member.set_roles" }
+
+type AddPhoto struct {
+	MemberID string
+	PhotoID  string
+	URL      string
+}
+
+func (c AddPhoto) CommandType() string { return "member.add_photo" }
+
+type RemovePhoto struct {
+	MemberID string
+	PhotoID  string
+	URL      string
+}
+
+func (c RemovePhoto) CommandType() string { return "member.remove_photo" }
+
+type ReorderPhotos struct {
+	MemberID string
+	URLs     []string
+}
+
+func (c ReorderPhotos) CommandType() string { return "member.reorder_photos" }
+
+type SetPrimaryPhoto struct {
+	MemberID string
+	URL      string
+}
+
+func (c SetPrimaryPhoto) CommandType() string { return "member.set_primary_photo" }
+
+type BlockMember struct {
+	MemberID        string
+	BlockedMemberID string
+}
+
+func (c BlockMember) CommandType() string { return "member.block" }
+
+type UnblockMember struct {
+	MemberID        string
+	BlockedMemberID string
+}
+
+func (c UnblockMember) CommandType() string { return "member.unblock" }
+
+type UpdatePreferences struct {
+	MemberID      string
+	MinAge        int
+	MaxAge        int
+	Genders       []string
+	MaxDistanceKm int
+	Dealbreakers  []string
+}
+
+func (c UpdatePreferences) CommandType() string { return "member.update_preferences" }
+
+type SetInterests struct {
+	MemberID    string
+	InterestIDs []string
+}
+
+func (c SetInterests) CommandType() string { return "member.set_interests" }
+
+type AnswerPrompt struct {
+	MemberID string
+	PromptID string
+	Answer   string
+}
+
+func (c AnswerPrompt) CommandType() string { return "member.answer_prompt" }
+
+type RemovePrompt struct {
+	MemberID string
+	PromptID string
+}
+
+func (c RemovePrompt) CommandType() string { return "member.remove_prompt" }
+
+type ReorderPrompts struct {
+	MemberID  string
+	PromptIDs []string
+}
+
+func (c ReorderPrompts) CommandType() string { return "member.reorder_prompts" }
+
+type SendEmailVerification struct {
+	MemberID string
+}
+
+func (c SendEmailVerification) CommandType() string { return "member.send_email_verification" }
+
+type VerifyEmail struct {
+	Token string
+}
+
+func (c VerifyEmail) CommandType() string { return "member.verify_email" }
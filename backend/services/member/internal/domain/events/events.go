@@ -23,6 +23,7 @@ type ProfileUpdated struct {
 	DisplayName string
 	Bio         string
 	BirthDate   time.Time
+	Gender      string
 	Timestamp   time.Time
 }
 
@@ -39,6 +40,18 @@ func (e MemberActivated) EventType() string    { return "member.activated" }
 func (e MemberActivated) AggregateID() string  { return e.MemberID }
 func (e MemberActivated) OccurredAt() time.Time { return e.Timestamp }
 
+// EmailVerified is raised when the member confirms the address they
+// registered with.
+type EmailVerified struct {
+	MemberID  string
+	Email     string
+	Timestamp time.Time
+}
+
+func (e EmailVerified) EventType() string    { return "member.email_verified" }
+func (e EmailVerified) AggregateID() string  { return e.MemberID }
+func (e EmailVerified) OccurredAt() time.Time { return e.Timestamp }
+
 type MemberSuspended struct {
 	MemberID  string
 	Reason    string
@@ -48,3 +61,136 @@ type MemberSuspended struct {
 func (e MemberSuspended) EventType() string    { return "member.suspended" }
 func (e MemberSuspended) AggregateID() string  { return e.MemberID }
 func (e MemberSuspended) OccurredAt() time.Time { return e.Timestamp }
+
+// MemberReinstated is raised when a suspension is lifted. Status is the
+// member's status afterwards: active, or pending when they had not
+// finished onboarding.
+type MemberReinstated struct {
+	MemberID  string
+	Reason    string
+	Status    string
+	Timestamp time.Time
+}
+
+func (e MemberReinstated) EventType() string    { return "member.reinstated" }
+func (e MemberReinstated) AggregateID() string  { return e.MemberID }
+func (e MemberReinstated) OccurredAt() time.Time { return e.Timestamp }
+
+// SessionsRevoked is raised when the member is signed out everywhere.
+// Tokens issued before Timestamp are no longer accepted.
+type SessionsRevoked struct {
+	MemberID  string
+	Reason    string
+	Timestamp time.Time
+}
+
+func (e SessionsRevoked) EventType() string    { return "member.sessions_revoked" }
+func (e SessionsRevoked) AggregateID() string  { return e.MemberID }
+func (e SessionsRevoked) OccurredAt() time.Time { return e.Timestamp }
+
+type PhotoAdded struct {
+	MemberID  string
+	PhotoID   string
+	URL       string
+	Timestamp time.Time
+}
+
+func (e PhotoAdded) EventType() string    { return "member.photo_added" }
+func (e PhotoAdded) AggregateID() string  { return e.MemberID }
+func (e PhotoAdded) OccurredAt() time.Time { return e.Timestamp }
+
+type PhotoRemoved struct {
+	MemberID  string
+	PhotoID   string
+	URL       string
+	Timestamp time.Time
+}
+
+func (e PhotoRemoved) EventType() string    { return "member.photo_removed" }
+func (e PhotoRemoved) AggregateID() string  { return e.MemberID }
+func (e PhotoRemoved) OccurredAt() time.Time { return e.Timestamp }
+
+type PhotosReordered struct {
+	MemberID  string
+	URLs      []string
+	Timestamp time.Time
+}
+
+func (e PhotosReordered) EventType() string    { return "member.photos_reordered" }
+func (e PhotosReordered) AggregateID() string  { return e.MemberID }
+func (e PhotosReordered) OccurredAt() time.Time { return e.Timestamp }
+
+type MemberBlocked struct {
+	MemberID        string
+	BlockedMemberID string
+	Timestamp       time.Time
+}
+
+func (e MemberBlocked) EventType() string    { return "member.blocked" }
+func (e MemberBlocked) AggregateID() string  { return e.MemberID }
+func (e MemberBlocked) OccurredAt() time.Time { return e.Timestamp }
+
+type MemberUnblocked struct {
+	MemberID        string
+	BlockedMemberID string
+	Timestamp       time.Time
+}
+
+func (e MemberUnblocked) EventType() string    { return "member.unblocked" }
+func (e MemberUnblocked) AggregateID() string  { return e.MemberID }
+func (e MemberUnblocked) OccurredAt() time.Time { return e.Timestamp }
+
+type PreferencesUpdated struct {
+	MemberID      string
+	MinAge        int
+	MaxAge        int
+	Genders       []string
+	MaxDistanceKm int
+	Dealbreakers  []string
+	Timestamp     time.Time
+}
+
+func (e PreferencesUpdated) EventType() string    { return "member.preferences_updated" }
+func (e PreferencesUpdated) AggregateID() string  { return e.MemberID }
+func (e PreferencesUpdated) OccurredAt() time.Time { return e.Timestamp }
+
+type InterestsUpdated struct {
+	MemberID    string
+	InterestIDs []string
+	Timestamp   time.Time
+}
+
+func (e InterestsUpdated) EventType() string    { return "member.interests_updated" }
+func (e InterestsUpdated) AggregateID() string  { return e.MemberID }
+func (e InterestsUpdated) OccurredAt() time.Time { return e.Timestamp }
+
+type PromptAnswered struct {
+	MemberID  string
+	PromptID  string
+	Answer    string
+	Timestamp time.Time
+}
+
+func (e PromptAnswered) EventType() string    { return "member.prompt_answered" }
+func (e PromptAnswered) AggregateID() string  { return e.MemberID }
+func (e PromptAnswered) OccurredAt() time.Time { return e.Timestamp }
+
+type PromptRemoved struct {
+	MemberID  string
+	PromptID  string
+	Timestamp time.Time
+}
+
+func (e PromptRemoved) EventType() string    { return "member.prompt_removed" }
+func (e PromptRemoved) AggregateID() string  { return e.MemberID }
+func (e PromptRemoved) OccurredAt() time.Time { return e.Timestamp }
+
+type PromptsReordered struct {
+	MemberID  string
+	PromptIDs []string
+	Timestamp time.Time
+}
+
+func (e PromptsReordered) EventType() string    { return "member.prompts_reordered" }
+func (e PromptsReordered) AggregateID() string  { return e.MemberID }
+func (e PromptsReordered) OccurredAt() time.Time { return e.Timestamp }
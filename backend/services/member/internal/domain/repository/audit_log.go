@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// AuditLog stores a record of every administrative call. Entries are only
+// ever added.
+type AuditLog interface {
+	Record(ctx context.Context, entry AuditEntry) error
+	// List returns up to limit entries matching the filter with an ID below
+	// beforeID, newest first. A beforeID of zero starts at the newest entry.
+	List(ctx context.Context, filter AuditFilter, beforeID int64, limit int) ([]AuditEntry, error)
+}
+
+// AuditEntry is one administrative call.
+type AuditEntry struct {
+	ID         int64
+	OccurredAt time.Time
+	// ActorID is the signed-in member who made the call, empty for
+	// operators.
+	ActorID string
+	// Caller is the service the call came from.
+	Caller   string
+	Method   string
+	MemberID string
+	// Request is the request message as JSON.
+	Request   []byte
+	Code      string
+	RequestID string
+}
+
+// AuditFilter narrows the audit log to calls made by or on a member.
+type AuditFilter struct {
+	MemberID string
+	ActorID  string
+}
@@ -2,14 +2,106 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/aggregate"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/events"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/eventstore"
 )
 
 type MemberRepository interface {
 	Save(ctx context.Context, member *aggregate.Member) error
 	SaveWithPassword(ctx context.Context, member *aggregate.Member, passwordHash string) error
 	GetByID(ctx context.Context, id string) (*aggregate.Member, error)
+	// GetByIDAt rehydrates the member from the events recorded up to and
+	// including the given time.
+	GetByIDAt(ctx context.Context, id string, at time.Time) (*aggregate.Member, error)
 	GetByEmail(ctx context.Context, email string) (*aggregate.Member, error)
 	GetPasswordHash(ctx context.Context, memberID string) (string, error)
+	// IsBlocked reports whether either member has blocked the other.
+	IsBlocked(ctx context.Context, memberID, otherMemberID string) (bool, error)
+	// GetHistory returns up to limit events of the member after the given
+	// version, oldest first.
+	GetHistory(ctx context.Context, memberID string, afterVersion, limit int) ([]HistoryEntry, error)
+	// ListSessionRevocations returns the members signed out everywhere at
+	// or after the given time, oldest first.
+	ListSessionRevocations(ctx context.Context, since time.Time) ([]SessionRevocation, error)
+	// SearchMembers returns up to limit members of the read model matching
+	// the search, in its sort order, after the given cursor when there is
+	// one.
+	SearchMembers(ctx context.Context, search MemberSearch, after *MemberCursor, limit int) ([]MemberSummary, error)
+}
+
+// MemberSort is the order of member search results.
+type MemberSort string
+
+const (
+	SortNewest      MemberSort = "newest"
+	SortOldest      MemberSort = "oldest"
+	SortEmail       MemberSort = "email"
+	SortDisplayName MemberSort = "display_name"
+)
+
+// MemberSearch filters the members read model. Zero fields do not narrow
+// the search.
+type MemberSearch struct {
+	// ID matches the member ID exactly.
+	ID string
+	// Text matches part of the email address or display name, ignoring
+	// case.
+	Text     string
+	Statuses []string
+	Genders  []string
+	// CreatedFrom is inclusive, CreatedBefore exclusive.
+	CreatedFrom   time.Time
+	CreatedBefore time.Time
+	// BornAfter is exclusive, BornOnOrBefore inclusive. Members without a
+	// birth date do not match either.
+	BornAfter      time.Time
+	BornOnOrBefore time.Time
+	Sort           MemberSort
+}
+
+// MemberCursor is the position of the last member of a page: the key of
+// the search's sort and the member ID.
+type MemberCursor struct {
+	CreatedAt   time.Time
+	Email       string
+	DisplayName string
+	ID          string
+}
+
+// MemberSummary is a member as listed by a search, read from the read model
+// with their staff roles.
+type MemberSummary struct {
+	ID                string
+	Email             string
+	DisplayName       string
+	Gender            string
+	BirthDate         time.Time
+	Status            string
+	EmailVerified     bool
+	CreatedAt         time.Time
+	SessionsRevokedAt time.Time
+	Roles             []string
+}
+
+// Cursor returns the position of the member in search results.
+func (m MemberSummary) Cursor() MemberCursor {
+	return MemberCursor{CreatedAt: m.CreatedAt, Email: m.Email, DisplayName: m.DisplayName, ID: m.ID}
+}
+
+// SessionRevocation is when a member was last signed out everywhere.
+type SessionRevocation struct {
+	MemberID  string
+	RevokedAt time.Time
+}
+
+// HistoryEntry is a stored member event with what was recorded about its
+// cause.
+type HistoryEntry struct {
+	Event      events.Event
+	Version    int
+	RecordedAt time.Time
+	Metadata   eventstore.Metadata
 }
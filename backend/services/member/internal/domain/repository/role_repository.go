@@ -0,0 +1,12 @@
+package repository
+
+import "context"
+
+// RoleRepository stores the staff roles granted to members.
+type RoleRepository interface {
+	// Roles returns the member's roles, sorted; none for ordinary members.
+	Roles(ctx context.Context, memberID string) ([]string, error)
+	// SetRoles replaces the member's roles, recording who granted the new
+	// ones.
+	SetRoles(ctx context.Context, memberID string, roles []string, grantedBy string) error
+}
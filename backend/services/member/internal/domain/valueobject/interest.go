@@ -0,0 +1,81 @@
+package valueobject
+
+import "errors"
+
+var (
+	ErrUnknownInterest  = errors.New("unknown interest")
+	ErrTooManyInterests = errors.New("profile has too many interests")
+)
+
+// MaxInterests is the maximum number of interests on a profile.
+const MaxInterests = 10
+
+// InterestID identifies an entry in the interests catalogue. IDs are stable;
+// names may be reworded without touching stored profiles.
+type InterestID string
+
+// Interest is an entry in the interests catalogue.
+type Interest struct {
+	ID       InterestID
+	Category string
+	Names    map[Locale]string
+}
+
+// Name returns the interest's name in the given locale.
+func (i Interest) Name(locale Locale) string {
+	if name, ok := i.Names[locale]; ok {
+		return name
+	}
+	return i.Names[DefaultLocale]
+}
+
+// CategoryName returns the name of the interest's category in the given locale.
+func (i Interest) CategoryName(locale Locale) string {
+	names := interestCategoryNames[i.Category]
+	if name, ok := names[locale]; ok {
+		return name
+	}
+	return names[DefaultLocale]
+}
+
+// NewInterests validates interest IDs against the catalogue, dropping
+// duplicates while keeping the member's order.
+func NewInterests(ids []InterestID) ([]InterestID, error) {
+	interests := make([]InterestID, 0, len(ids))
+	seen := make(map[InterestID]bool, len(ids))
+	for _, id := range ids {
+		if _, ok := LookupInterest(id); !ok {
+			return nil, ErrUnknownInterest
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		interests = append(interests, id)
+	}
+
+	if len(interests) > MaxInterests {
+		return nil, ErrTooManyInterests
+	}
+
+	return interests, nil
+}
+
+// Interests returns the whole catalogue in display order.
+func Interests() []Interest {
+	return interestCatalogue
+}
+
+// LookupInterest finds a catalogue entry by ID.
+func LookupInterest(id InterestID) (Interest, bool) {
+	i, ok := interestsByID[id]
+	return i, ok
+}
+
+var interestsByID = func() map[InterestID]Interest {
+	byID := make(map[InterestID]Interest, len(interestCatalogue))
+	for _, i := range interestCatalogue {
+		byID[i.ID] = i
+	}
+	return byID
+}()
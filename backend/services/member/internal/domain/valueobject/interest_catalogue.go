@@ -0,0 +1,66 @@
+package valueobject
+
+// interestCatalogue is the curated list of interests members can pick from,
+// grouped by category. Remove entries only together with a migration that
+// strips them from stored profiles.
+var interestCatalogue = []Interest{
+	// sports
+	{ID: "running", Category: "sports", Names: map[Locale]string{LocaleDutch: "Hardlopen", LocaleEnglish: "Running"}},
+	{ID: "cycling", Category: "sports", Names: map[Locale]string{LocaleDutch: "Fietsen", LocaleEnglish: "Cycling"}},
+	{ID: "football", Category: "sports", Names: map[Locale]string{LocaleDutch: "Voetbal", LocaleEnglish: "Football"}},
+	{ID: "tennis", Category: "sports", Names: map[Locale]string{LocaleDutch: "Tennis", LocaleEnglish: "Tennis"}},
+	{ID: "hockey", Category: "sports", Names: map[Locale]string{LocaleDutch: "Hockey", LocaleEnglish: "Hockey"}},
+	{ID: "swimming", Category: "sports", Names: map[Locale]string{LocaleDutch: "Zwemmen", LocaleEnglish: "Swimming"}},
+	{ID: "gym", Category: "sports", Names: map[Locale]string{LocaleDutch: "Fitness", LocaleEnglish: "Gym"}},
+	{ID: "yoga", Category: "sports", Names: map[Locale]string{LocaleDutch: "Yoga", LocaleEnglish: "Yoga"}},
+	{ID: "skating", Category: "sports", Names: map[Locale]string{LocaleDutch: "Schaatsen", LocaleEnglish: "Ice skating"}},
+	{ID: "sailing", Category: "sports", Names: map[Locale]string{LocaleDutch: "Zeilen", LocaleEnglish: "Sailing"}},
+	// outdoors
+	{ID: "hiking", Category: "outdoors", Names: map[Locale]string{LocaleDutch: "Wandelen", LocaleEnglish: "Hiking"}},
+	{ID: "camping", Category: "outdoors", Names: map[Locale]string{LocaleDutch: "Kamperen", LocaleEnglish: "Camping"}},
+	{ID: "climbing", Category: "outdoors", Names: map[Locale]string{LocaleDutch: "Klimmen", LocaleEnglish: "Climbing"}},
+	{ID: "gardening", Category: "outdoors", Names: map[Locale]string{LocaleDutch: "Tuinieren", LocaleEnglish: "Gardening"}},
+	{ID: "beach", Category: "outdoors", Names: map[Locale]string{LocaleDutch: "Strand", LocaleEnglish: "Beach"}},
+	// food_drink
+	{ID: "cooking", Category: "food_drink", Names: map[Locale]string{LocaleDutch: "Koken", LocaleEnglish: "Cooking"}},
+	{ID: "baking", Category: "food_drink", Names: map[Locale]string{LocaleDutch: "Bakken", LocaleEnglish: "Baking"}},
+	{ID: "wine", Category: "food_drink", Names: map[Locale]string{LocaleDutch: "Wijn", LocaleEnglish: "Wine"}},
+	{ID: "craft_beer", Category: "food_drink", Names: map[Locale]string{LocaleDutch: "Speciaalbier", LocaleEnglish: "Craft beer"}},
+	{ID: "coffee", Category: "food_drink", Names: map[Locale]string{LocaleDutch: "Koffie", LocaleEnglish: "Coffee"}},
+	{ID: "vegetarian", Category: "food_drink", Names: map[Locale]string{LocaleDutch: "Vegetarisch", LocaleEnglish: "Vegetarian"}},
+	{ID: "eating_out", Category: "food_drink", Names: map[Locale]string{LocaleDutch: "Uit eten", LocaleEnglish: "Eating out"}},
+	// arts_culture
+	{ID: "museums", Category: "arts_culture", Names: map[Locale]string{LocaleDutch: "Musea", LocaleEnglish: "Museums"}},
+	{ID: "theatre", Category: "arts_culture", Names: map[Locale]string{LocaleDutch: "Theater", LocaleEnglish: "Theatre"}},
+	{ID: "photography", Category: "arts_culture", Names: map[Locale]string{LocaleDutch: "Fotografie", LocaleEnglish: "Photography"}},
+	{ID: "painting", Category: "arts_culture", Names: map[Locale]string{LocaleDutch: "Schilderen", LocaleEnglish: "Painting"}},
+	{ID: "reading", Category: "arts_culture", Names: map[Locale]string{LocaleDutch: "Lezen", LocaleEnglish: "Reading"}},
+	{ID: "writing", Category: "arts_culture", Names: map[Locale]string{LocaleDutch: "Schrijven", LocaleEnglish: "Writing"}},
+	{ID: "film", Category: "arts_culture", Names: map[Locale]string{LocaleDutch: "Film", LocaleEnglish: "Film"}},
+	// music
+	{ID: "concerts", Category: "music", Names: map[Locale]string{LocaleDutch: "Concerten", LocaleEnglish: "Concerts"}},
+	{ID: "festivals", Category: "music", Names: map[Locale]string{LocaleDutch: "Festivals", LocaleEnglish: "Festivals"}},
+	{ID: "playing_music", Category: "music", Names: map[Locale]string{LocaleDutch: "Muziek maken", LocaleEnglish: "Playing music"}},
+	{ID: "singing", Category: "music", Names: map[Locale]string{LocaleDutch: "Zingen", LocaleEnglish: "Singing"}},
+	{ID: "dancing", Category: "music", Names: map[Locale]string{LocaleDutch: "Dansen", LocaleEnglish: "Dancing"}},
+	// lifestyle
+	{ID: "travel", Category: "lifestyle", Names: map[Locale]string{LocaleDutch: "Reizen", LocaleEnglish: "Travel"}},
+	{ID: "city_trips", Category: "lifestyle", Names: map[Locale]string{LocaleDutch: "Stedentrips", LocaleEnglish: "City trips"}},
+	{ID: "dogs", Category: "lifestyle", Names: map[Locale]string{LocaleDutch: "Honden", LocaleEnglish: "Dogs"}},
+	{ID: "cats", Category: "lifestyle", Names: map[Locale]string{LocaleDutch: "Katten", LocaleEnglish: "Cats"}},
+	{ID: "gaming", Category: "lifestyle", Names: map[Locale]string{LocaleDutch: "Gamen", LocaleEnglish: "Gaming"}},
+	{ID: "board_games", Category: "lifestyle", Names: map[Locale]string{LocaleDutch: "Bordspellen", LocaleEnglish: "Board games"}},
+	{ID: "volunteering", Category: "lifestyle", Names: map[Locale]string{LocaleDutch: "Vrijwilligerswerk", LocaleEnglish: "Volunteering"}},
+	{ID: "languages", Category: "lifestyle", Names: map[Locale]string{LocaleDutch: "Talen", LocaleEnglish: "Languages"}},
+	{ID: "sustainability", Category: "lifestyle", Names: map[Locale]string{LocaleDutch: "Duurzaamheid", LocaleEnglish: "Sustainability"}},
+	{ID: "podcasts", Category: "lifestyle", Names: map[Locale]string{LocaleDutch: "Podcasts", LocaleEnglish: "Podcasts"}},
+}
+
+var interestCategoryNames = map[string]map[Locale]string{
+	"sports":       {LocaleDutch: "Sport", LocaleEnglish: "Sports"},
+	"outdoors":     {LocaleDutch: "Buiten", LocaleEnglish: "Outdoors"},
+	"food_drink":   {LocaleDutch: "Eten & drinken", LocaleEnglish: "Food & drink"},
+	"arts_culture": {LocaleDutch: "Kunst & cultuur", LocaleEnglish: "Arts & culture"},
+	"music":        {LocaleDutch: "Muziek", LocaleEnglish: "Music"},
+	"lifestyle":    {LocaleDutch: "Levensstijl", LocaleEnglish: "Lifestyle"},
+}
@@ -0,0 +1,25 @@
+package valueobject
+
+// Locale selects the language of catalogue names.
+type Locale string
+
+const (
+	LocaleDutch   Locale = "nl"
+	LocaleEnglish Locale = "en"
+
+	DefaultLocale = LocaleEnglish
+)
+
+// ParseLocale returns the supported locale for a language tag such as
+// "nl-NL", falling back to the default locale.
+func ParseLocale(tag string) Locale {
+	if len(tag) >= 2 {
+		switch Locale(tag[:2]) {
+		case LocaleDutch:
+			return LocaleDutch
+		case LocaleEnglish:
+			return LocaleEnglish
+		}
+	}
+	return DefaultLocale
+}
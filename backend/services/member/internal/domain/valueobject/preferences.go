@@ -0,0 +1,142 @@
+package valueobject
+
+import "errors"
+
+var (
+	ErrInvalidAgeRange    = errors.New("age range must be between 18 and 99 with min not above max")
+	ErrNoGenderInterest   = errors.New("at least one gender of interest is required")
+	ErrInvalidGender      = errors.New("invalid gender")
+	ErrInvalidMaxDistance = errors.New("max distance must be between 1 and 500 km")
+	ErrInvalidDealbreaker = errors.New("invalid dealbreaker")
+)
+
+const (
+	MinAge = 18
+	MaxAge = 99
+
+	MaxDistanceKm     = 500
+	DefaultDistanceKm = 50
+)
+
+// Dealbreaker marks a preference as a hard filter. Preferences that are not
+// dealbreakers only rank candidates, so members just outside them can still
+// be shown when few candidates match.
+type Dealbreaker string
+
+const (
+	DealbreakerAge      Dealbreaker = "age"
+	DealbreakerGender   Dealbreaker = "gender"
+	DealbreakerDistance Dealbreaker = "distance"
+)
+
+// Preferences describes who a member wants to see in discovery.
+type Preferences struct {
+	MinAge        int
+	MaxAge        int
+	Genders       []Gender
+	MaxDistanceKm int
+	Dealbreakers  []Dealbreaker
+}
+
+func NewPreferences(minAge, maxAge int, genders []Gender, maxDistanceKm int, dealbreakers []Dealbreaker) (Preferences, error) {
+	if minAge < MinAge || maxAge > MaxAge || minAge > maxAge {
+		return Preferences{}, ErrInvalidAgeRange
+	}
+	if maxDistanceKm < 1 || maxDistanceKm > MaxDistanceKm {
+		return Preferences{}, ErrInvalidMaxDistance
+	}
+
+	uniqueGenders := make([]Gender, 0, len(genders))
+	for _, g := range genders {
+		if !g.IsValid() {
+			return Preferences{}, ErrInvalidGender
+		}
+		if !containsGender(uniqueGenders, g) {
+			uniqueGenders = append(uniqueGenders, g)
+		}
+	}
+	if len(uniqueGenders) == 0 {
+		return Preferences{}, ErrNoGenderInterest
+	}
+
+	uniqueDealbreakers := make([]Dealbreaker, 0, len(dealbreakers))
+	for _, d := range dealbreakers {
+		if !d.IsValid() {
+			return Preferences{}, ErrInvalidDealbreaker
+		}
+		if !containsDealbreaker(uniqueDealbreakers, d) {
+			uniqueDealbreakers = append(uniqueDealbreakers, d)
+		}
+	}
+
+	return Preferences{
+		MinAge:        minAge,
+		MaxAge:        maxAge,
+		Genders:       uniqueGenders,
+		MaxDistanceKm: maxDistanceKm,
+		Dealbreakers:  uniqueDealbreakers,
+	}, nil
+}
+
+// DefaultPreferences are used until a member sets their own: every adult
+// age, every gender, within the default distance and without dealbreakers.
+func DefaultPreferences() Preferences {
+	return Preferences{
+		MinAge:        MinAge,
+		MaxAge:        MaxAge,
+		Genders:       []Gender{GenderMale, GenderFemale, GenderOther},
+		MaxDistanceKm: DefaultDistanceKm,
+		Dealbreakers:  make([]Dealbreaker, 0),
+	}
+}
+
+// InterestedIn reports whether the given gender is one the member wants to see.
+func (p Preferences) InterestedIn(g Gender) bool {
+	return containsGender(p.Genders, g)
+}
+
+// AcceptsAge reports whether the given age falls within the age range.
+func (p Preferences) AcceptsAge(age int) bool {
+	return age >= p.MinAge && age <= p.MaxAge
+}
+
+// IsDealbreaker reports whether the given preference is a hard filter.
+func (p Preferences) IsDealbreaker(d Dealbreaker) bool {
+	return containsDealbreaker(p.Dealbreakers, d)
+}
+
+func (g Gender) IsValid() bool {
+	switch g {
+	case GenderMale, GenderFemale, GenderOther:
+		return true
+	default:
+		return false
+	}
+}
+
+func (d Dealbreaker) IsValid() bool {
+	switch d {
+	case DealbreakerAge, DealbreakerGender, DealbreakerDistance:
+		return true
+	default:
+		return false
+	}
+}
+
+func containsGender(genders []Gender, g Gender) bool {
+	for _, existing := range genders {
+		if existing == g {
+			return true
+		}
+	}
+	return false
+}
+
+func containsDealbreaker(dealbreakers []Dealbreaker, d Dealbreaker) bool {
+	for _, existing := range dealbreakers {
+		if existing == d {
+			return true
+		}
+	}
+	return false
+}
@@ -11,14 +11,18 @@ var (
 	ErrBioTooLong          = errors.New("bio must be at most 500 characters")
 	ErrInvalidBirthDate    = errors.New("invalid birth date")
 	ErrTooYoung            = errors.New("must be at least 18 years old")
+	ErrTooManyPhotos       = errors.New("profile has the maximum number of photos")
 )
 
+// MaxPhotos is the maximum number of photos on a profile.
+const MaxPhotos = 9
+
 type Profile struct {
 	DisplayName string
 	Bio         string
 	BirthDate   time.Time
 	Gender      Gender
-	Interests   []string
+	Interests   []InterestID
 	Photos      []PhotoURL
 }
 
@@ -53,7 +57,7 @@ func NewProfile(displayName, bio string, birthDate time.Time, gender Gender) (Pr
 		Bio:         bio,
 		BirthDate:   birthDate,
 		Gender:      gender,
-		Interests:   make([]string, 0),
+		Interests:   make([]InterestID, 0),
 		Photos:      make([]PhotoURL, 0),
 	}, nil
 }
@@ -70,3 +74,12 @@ func calculateAge(birthDate time.Time) int {
 func (p Profile) Age() int {
 	return calculateAge(p.BirthDate)
 }
+
+// PrimaryPhoto returns the photo shown first on the profile, or an empty
+// URL when the profile has no photos.
+func (p Profile) PrimaryPhoto() PhotoURL {
+	if len(p.Photos) == 0 {
+		return ""
+	}
+	return p.Photos[0]
+}
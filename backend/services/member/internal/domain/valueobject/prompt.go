@@ -0,0 +1,160 @@
+package valueobject
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	ErrUnknownPrompt      = errors.New("unknown prompt")
+	ErrAnswerRequired     = errors.New("prompt answer is required")
+	ErrAnswerTooLong      = errors.New("prompt answer must be at most 250 characters")
+	ErrTooManyPrompts     = errors.New("profile has the maximum number of prompts")
+	ErrPromptNotAnswered  = errors.New("prompt is not answered on the profile")
+	ErrLastPrompt         = errors.New("profile must keep at least one prompt")
+	ErrInvalidPromptOrder = errors.New("prompt order must list every answered prompt exactly once")
+)
+
+const (
+	// MaxPrompts is the maximum number of answered prompts on a profile.
+	MaxPrompts = 3
+
+	// MaxAnswerLength is the maximum length of a prompt answer in characters.
+	MaxAnswerLength = 250
+)
+
+// PromptID identifies an entry in the prompt catalogue.
+type PromptID string
+
+// Prompt is a question from the prompt catalogue.
+type Prompt struct {
+	ID        PromptID
+	Questions map[Locale]string
+}
+
+// Question returns the prompt's question in the given locale.
+func (p Prompt) Question(locale Locale) string {
+	if question, ok := p.Questions[locale]; ok {
+		return question
+	}
+	return p.Questions[DefaultLocale]
+}
+
+// Prompts returns the whole prompt catalogue in display order.
+func Prompts() []Prompt {
+	return promptCatalogue
+}
+
+// LookupPrompt finds a catalogue entry by ID.
+func LookupPrompt(id PromptID) (Prompt, bool) {
+	p, ok := promptsByID[id]
+	return p, ok
+}
+
+var promptsByID = func() map[PromptID]Prompt {
+	byID := make(map[PromptID]Prompt, len(promptCatalogue))
+	for _, p := range promptCatalogue {
+		byID[p.ID] = p
+	}
+	return byID
+}()
+
+// PromptAnswer is a member's answer to a catalogue prompt.
+type PromptAnswer struct {
+	PromptID PromptID
+	Answer   string
+}
+
+func NewPromptAnswer(promptID PromptID, answer string) (PromptAnswer, error) {
+	if _, ok := LookupPrompt(promptID); !ok {
+		return PromptAnswer{}, ErrUnknownPrompt
+	}
+
+	answer = strings.TrimSpace(answer)
+	if answer == "" {
+		return PromptAnswer{}, ErrAnswerRequired
+	}
+	if utf8.RuneCountInString(answer) > MaxAnswerLength {
+		return PromptAnswer{}, ErrAnswerTooLong
+	}
+
+	return PromptAnswer{PromptID: promptID, Answer: answer}, nil
+}
+
+// ProfilePrompts are the answered prompts on a profile, in display order.
+// A profile starts without prompts; once answered it keeps between one and
+// MaxPrompts of them. Operations return a new value and leave the receiver
+// unchanged.
+type ProfilePrompts []PromptAnswer
+
+// Has reports whether the prompt is answered.
+func (p ProfilePrompts) Has(promptID PromptID) bool {
+	return p.indexOf(promptID) >= 0
+}
+
+// Answer adds the answer at the end, or replaces the answer in place when
+// the prompt is already answered.
+func (p ProfilePrompts) Answer(answer PromptAnswer) (ProfilePrompts, error) {
+	if i := p.indexOf(answer.PromptID); i >= 0 {
+		result := append(ProfilePrompts{}, p...)
+		result[i] = answer
+		return result, nil
+	}
+	if len(p) >= MaxPrompts {
+		return nil, ErrTooManyPrompts
+	}
+	return append(append(ProfilePrompts{}, p...), answer), nil
+}
+
+// Remove drops an answered prompt. The last prompt cannot be removed; it can
+// only be replaced by answering another prompt first.
+func (p ProfilePrompts) Remove(promptID PromptID) (ProfilePrompts, error) {
+	i := p.indexOf(promptID)
+	if i < 0 {
+		return nil, ErrPromptNotAnswered
+	}
+	if len(p) == 1 {
+		return nil, ErrLastPrompt
+	}
+
+	result := make(ProfilePrompts, 0, len(p)-1)
+	result = append(result, p[:i]...)
+	return append(result, p[i+1:]...), nil
+}
+
+// Reorder sets the display order. The order must contain every answered
+// prompt exactly once.
+func (p ProfilePrompts) Reorder(promptIDs []PromptID) (ProfilePrompts, error) {
+	if len(promptIDs) != len(p) {
+		return nil, ErrInvalidPromptOrder
+	}
+
+	result := make(ProfilePrompts, 0, len(p))
+	for _, id := range promptIDs {
+		i := p.indexOf(id)
+		if i < 0 || result.Has(id) {
+			return nil, ErrInvalidPromptOrder
+		}
+		result = append(result, p[i])
+	}
+	return result, nil
+}
+
+// IDs returns the answered prompt IDs in display order.
+func (p ProfilePrompts) IDs() []PromptID {
+	ids := make([]PromptID, len(p))
+	for i, a := range p {
+		ids[i] = a.PromptID
+	}
+	return ids
+}
+
+func (p ProfilePrompts) indexOf(promptID PromptID) int {
+	for i, a := range p {
+		if a.PromptID == promptID {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,54 @@
+package valueobject
+
+// promptCatalogue is the curated list of prompts members can answer. Remove
+// entries only together with a migration that strips them from profiles.
+var promptCatalogue = []Prompt{
+	{ID: "perfect_sunday", Questions: map[Locale]string{
+		LocaleDutch:   "Mijn perfecte zondag",
+		LocaleEnglish: "My perfect Sunday",
+	}},
+	{ID: "simple_pleasures", Questions: map[Locale]string{
+		LocaleDutch:   "Ik word blij van",
+		LocaleEnglish: "My simple pleasures",
+	}},
+	{ID: "looking_for", Questions: map[Locale]string{
+		LocaleDutch:   "Ik zoek iemand die",
+		LocaleEnglish: "I'm looking for someone who",
+	}},
+	{ID: "green_flag", Questions: map[Locale]string{
+		LocaleDutch:   "Een green flag voor mij is",
+		LocaleEnglish: "A green flag for me is",
+	}},
+	{ID: "dealbreaker", Questions: map[Locale]string{
+		LocaleDutch:   "Het houdt op als",
+		LocaleEnglish: "It's a dealbreaker if",
+	}},
+	{ID: "first_date", Questions: map[Locale]string{
+		LocaleDutch:   "Mijn ideale eerste date",
+		LocaleEnglish: "My ideal first date",
+	}},
+	{ID: "unpopular_opinion", Questions: map[Locale]string{
+		LocaleDutch:   "Mijn niet zo populaire mening",
+		LocaleEnglish: "My unpopular opinion",
+	}},
+	{ID: "best_travel_story", Questions: map[Locale]string{
+		LocaleDutch:   "Mijn beste reisverhaal",
+		LocaleEnglish: "My best travel story",
+	}},
+	{ID: "geek_out", Questions: map[Locale]string{
+		LocaleDutch:   "Ik kan urenlang praten over",
+		LocaleEnglish: "I geek out on",
+	}},
+	{ID: "two_truths", Questions: map[Locale]string{
+		LocaleDutch:   "Twee waarheden en een leugen",
+		LocaleEnglish: "Two truths and a lie",
+	}},
+	{ID: "typical_dutch", Questions: map[Locale]string{
+		LocaleDutch:   "Het meest Nederlandse aan mij",
+		LocaleEnglish: "The most Dutch thing about me",
+	}},
+	{ID: "win_me_over", Questions: map[Locale]string{
+		LocaleDutch:   "Je wint mijn hart met",
+		LocaleEnglish: "You'll win me over with",
+	}},
+}
@@ -0,0 +1,57 @@
+package contentfilter
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var (
+	emailPattern = regexp.MustCompile(`(?i)[a-z0-9._%+-]+@[a-z0-9.-]+\.[a-z]{2,}`)
+	urlPattern   = regexp.MustCompile(`(?i)\b(https?://|www\.)\S+|\b[a-z0-9-]+\.(com|nl|net|org|be|io|me)\b`)
+	// Nine or more digits, optionally separated by spaces, dots or dashes,
+	// catches Dutch and international phone numbers.
+	phonePattern = regexp.MustCompile(`\+?\d(?:[\s.-]?\d){8,}`)
+)
+
+// Filter rejects profile text that shares contact details, which would move
+// conversations off the platform before a match, or that contains words
+// from a blocklist.
+type Filter struct {
+	blocklist map[string]bool
+}
+
+// New creates a filter. Blocklist entries match whole words, ignoring case.
+func New(blocklist []string) *Filter {
+	words := make(map[string]bool, len(blocklist))
+	for _, w := range blocklist {
+		if w = strings.ToLower(strings.TrimSpace(w)); w != "" {
+			words[w] = true
+		}
+	}
+	return &Filter{blocklist: words}
+}
+
+// Review implements application.TextModerator.
+func (f *Filter) Review(ctx context.Context, memberID, text string) (bool, string, error) {
+	switch {
+	case emailPattern.MatchString(text):
+		return false, "contains an email address", nil
+	case urlPattern.MatchString(text):
+		return false, "contains a link", nil
+	case phonePattern.MatchString(text):
+		return false, "contains a phone number", nil
+	}
+
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, w := range words {
+		if f.blocklist[w] {
+			return false, "contains a blocked word", nil
+		}
+	}
+
+	return true, "", nil
+}
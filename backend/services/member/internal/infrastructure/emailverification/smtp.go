@@ -0,0 +1,106 @@
+package emailverification
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+)
+
+// SMTPSender emails verification links through an SMTP server, upgrading
+// the connection with STARTTLS when the server offers it.
+type SMTPSender struct {
+	addr string
+	host string
+	from *mail.Address
+	auth smtp.Auth
+}
+
+// NewSMTPSender creates a sender for the server at addr (host:port). The
+// username and password are optional; net/smtp only sends them over TLS or
+// to localhost.
+func NewSMTPSender(addr, from, username, password string) (*SMTPSender, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("parse smtp address: %w", err)
+	}
+	sender, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("parse sender address: %w", err)
+	}
+
+	s := &SMTPSender{addr: addr, host: host, from: sender}
+	if username != "" {
+		s.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return s, nil
+}
+
+// SendVerificationLink implements Sender.
+func (s *SMTPSender) SendVerificationLink(ctx context.Context, email, link string) error {
+	to, err := mail.ParseAddress(email)
+	if err != nil {
+		return fmt.Errorf("parse recipient address: %w", err)
+	}
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("connect to smtp server: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("start smtp session: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("starttls: %w", err)
+		}
+	}
+	if s.auth != nil {
+		if err := client.Auth(s.auth); err != nil {
+			return fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+
+	if err := client.Mail(s.from.Address); err != nil {
+		return fmt.Errorf("smtp mail from: %w", err)
+	}
+	if err := client.Rcpt(to.Address); err != nil {
+		return fmt.Errorf("smtp rcpt to: %w", err)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+	if _, err := w.Write(verificationMessage(s.from, to, link)); err != nil {
+		return fmt.Errorf("write message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("send message: %w", err)
+	}
+	return client.Quit()
+}
+
+func verificationMessage(from, to *mail.Address, link string) []byte {
+	var b strings.Builder
+	b.WriteString("From: " + from.String() + "\r\n")
+	b.WriteString("To: " + to.String() + "\r\n")
+	b.WriteString("Subject: Confirm your email address\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString("Open this link to confirm your email address for Zoekdeware:\r\n\r\n")
+	b.WriteString(link + "\r\n\r\n")
+	b.WriteString("If you did not sign up, you can ignore this email.\r\n")
+	return []byte(b.String())
+}
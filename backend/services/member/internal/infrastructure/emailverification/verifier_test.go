@@ -0,0 +1,142 @@
+package emailverification
+
+import (
+	"context"
+	"net"
+	"net/textproto"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeSMTP accepts one message on a local port and returns what it got.
+func fakeSMTP(t *testing.T) (string, <-chan []string) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { lis.Close() })
+
+	received := make(chan []string, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		tp := textproto.NewConn(conn)
+
+		var lines []string
+		_ = tp.PrintfLine("220 localhost ESMTP")
+		for {
+			line, err := tp.ReadLine()
+			if err != nil {
+				return
+			}
+			lines = append(lines, line)
+			switch cmd := strings.ToUpper(strings.Fields(line + " ")[0]); cmd {
+			case "EHLO", "HELO":
+				_ = tp.PrintfLine("250 localhost")
+			case "DATA":
+				_ = tp.PrintfLine("354 go ahead")
+				body, err := tp.ReadDotLines()
+				if err != nil {
+					return
+				}
+				lines = append(lines, body...)
+				_ = tp.PrintfLine("250 queued")
+			case "QUIT":
+				_ = tp.PrintfLine("221 bye")
+				received <- lines
+				return
+			default:
+				_ = tp.PrintfLine("250 ok")
+			}
+		}
+	}()
+	return lis.Addr().String(), received
+}
+
+func TestSMTPSenderSendsLink(t *testing.T) {
+	addr, received := fakeSMTP(t)
+	sender, err := NewSMTPSender(addr, "Zoekdeware <noreply@zoekdeware.nl>", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	link := "https://zoekdeware.nl/verify-email?token=abc.def"
+	if err := sender.SendVerificationLink(ctx, "member@example.com", link); err != nil {
+		t.Fatalf("SendVerificationLink: %v", err)
+	}
+
+	lines := strings.Join(<-received, "\n")
+	for _, want := range []string{
+		"MAIL FROM:<noreply@zoekdeware.nl>",
+		"RCPT TO:<member@example.com>",
+		"To: <member@example.com>",
+		"Subject: Confirm your email address",
+		link,
+	} {
+		if !strings.Contains(lines, want) {
+			t.Errorf("session does not contain %q:\n%s", want, lines)
+		}
+	}
+}
+
+// Addresses are parsed, so a recipient cannot inject headers.
+func TestSMTPSenderRejectsInvalidRecipient(t *testing.T) {
+	sender, err := NewSMTPSender("127.0.0.1:1", "noreply@zoekdeware.nl", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sender.SendVerificationLink(context.Background(), "a@example.com\r\nBcc: b@example.com", "https://x/?token=t")
+	if err == nil {
+		t.Fatal("SendVerificationLink accepted a recipient with a header")
+	}
+}
+
+func TestRedactToken(t *testing.T) {
+	got := redactToken("https://zoekdeware.nl/verify-email?token=abc.def")
+	u, err := url.Parse(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(got, "abc.def") || u.Query().Get("token") != "REDACTED" {
+		t.Errorf("redactToken = %q", got)
+	}
+}
+
+func TestVerifyLink(t *testing.T) {
+	var sent string
+	v := New("secret", "https://zoekdeware.nl/verify-email", time.Hour, senderFunc(func(_ context.Context, _, link string) error {
+		sent = link
+		return nil
+	}))
+	if err := v.Send(context.Background(), "member-1", "member@example.com"); err != nil {
+		t.Fatal(err)
+	}
+
+	u, err := url.Parse(sent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	memberID, email, err := v.Verify(u.Query().Get("token"))
+	if err != nil || memberID != "member-1" || email != "member@example.com" {
+		t.Errorf("Verify = %q, %q, %v", memberID, email, err)
+	}
+
+	other := New("other-secret", "https://zoekdeware.nl/verify-email", time.Hour, nil)
+	if _, _, err := other.Verify(u.Query().Get("token")); err != ErrInvalidToken {
+		t.Errorf("Verify with another secret = %v, want ErrInvalidToken", err)
+	}
+}
+
+type senderFunc func(ctx context.Context, email, link string) error
+
+func (f senderFunc) SendVerificationLink(ctx context.Context, email, link string) error {
+	return f(ctx, email, link)
+}
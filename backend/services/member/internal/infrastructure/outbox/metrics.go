@@ -0,0 +1,18 @@
+package outbox
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	pendingEvents = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "member_outbox_pending_events",
+		Help: "Stored events not yet published to the message broker.",
+	})
+
+	lagSeconds = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "member_outbox_lag_seconds",
+		Help: "Age of the oldest unpublished event, or zero when none are pending.",
+	})
+)
@@ -0,0 +1,187 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/eventstore"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/messaging"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/telemetry"
+)
+
+// Relay publishes events from the event store to the message broker. Events
+// are written in the same transaction as the aggregate change and marked
+// published once the broker accepted them, so every event is delivered at
+// least once even if the service stops in between.
+type Relay struct {
+	db        *sql.DB
+	publisher messaging.Publisher
+	interval  time.Duration
+	batchSize int
+}
+
+// NewRelay creates a relay polling the event store every interval.
+func NewRelay(db *sql.DB, publisher messaging.Publisher, interval time.Duration) *Relay {
+	return &Relay{
+		db:        db,
+		publisher: publisher,
+		interval:  interval,
+		batchSize: 100,
+	}
+}
+
+// Run publishes pending events until ctx is cancelled.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			n, err := r.publishBatch(ctx)
+			if err != nil {
+				log.Printf("outbox: %v", err)
+				break
+			}
+			if n < r.batchSize {
+				break
+			}
+		}
+		if err := r.recordLag(ctx); err != nil {
+			log.Printf("outbox: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// publishBatch publishes the oldest unpublished events in order. Rows are
+// locked so several replicas can run a relay without publishing the same
+// event concurrently.
+func (r *Relay) publishBatch(ctx context.Context) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id, aggregate_id, event_type, event_data, metadata, version
+		FROM events
+		WHERE published_at IS NULL
+		ORDER BY id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`, r.batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("query unpublished events: %w", err)
+	}
+
+	type pending struct {
+		id          int64
+		message     messaging.Message
+		topic       string
+		traceParent string
+	}
+
+	var batch []pending
+	for rows.Next() {
+		var (
+			id          int64
+			aggregateID string
+			eventType   string
+			eventData   []byte
+			rawMetadata []byte
+			version     int
+		)
+		if err := rows.Scan(&id, &aggregateID, &eventType, &eventData, &rawMetadata, &version); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("scan event: %w", err)
+		}
+
+		// A request's events share its correlation ID. Unreadable metadata
+		// only loses the trace, so the event is still published.
+		var metadata eventstore.Metadata
+		if len(rawMetadata) > 0 {
+			if err := json.Unmarshal(rawMetadata, &metadata); err != nil {
+				log.Printf("outbox: event %s-%d has invalid metadata: %v", aggregateID, version, err)
+			}
+		}
+
+		batch = append(batch, pending{
+			id:          id,
+			topic:       eventType,
+			traceParent: metadata.TraceParent,
+			message: messaging.Message{
+				ID:      fmt.Sprintf("%s-%d", aggregateID, version),
+				Type:    eventType,
+				Payload: eventData,
+				Metadata: messaging.MessageMetadata{
+					CorrelationID: metadata.CorrelationID,
+				},
+			},
+		})
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("iterate events: %w", err)
+	}
+
+	// Stop at the first failure so events of an aggregate stay in order.
+	published := make([]int64, 0, len(batch))
+	for _, p := range batch {
+		// Publish as part of the trace of the request that stored the event
+		publishCtx := telemetry.ContextWithTraceParent(ctx, p.traceParent)
+		if err := r.publisher.Publish(publishCtx, p.topic, p.message); err != nil {
+			log.Printf("outbox: publish %s: %v", p.message.ID, err)
+			break
+		}
+		published = append(published, p.id)
+	}
+
+	if len(published) > 0 {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE events SET published_at = NOW() WHERE id = ANY($1)
+		`, pq.Array(published))
+		if err != nil {
+			return 0, fmt.Errorf("mark events published: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return len(published), nil
+}
+
+// recordLag updates the outbox gauges from the unpublished events.
+func (r *Relay) recordLag(ctx context.Context) error {
+	var (
+		pending int
+		oldest  sql.NullTime
+	)
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*), MIN(created_at) FROM events WHERE published_at IS NULL
+	`).Scan(&pending, &oldest)
+	if err != nil {
+		return fmt.Errorf("query outbox lag: %w", err)
+	}
+
+	pendingEvents.Set(float64(pending))
+	if oldest.Valid {
+		lagSeconds.Set(time.Since(oldest.Time).Seconds())
+	} else {
+		lagSeconds.Set(0)
+	}
+	return nil
+}
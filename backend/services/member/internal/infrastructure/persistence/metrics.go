@@ -0,0 +1,11 @@
+package persistence
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var eventsAppended = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "member_events_appended_total",
+	Help: "Events committed to the member event store, by event type.",
+}, []string{"event_type"})
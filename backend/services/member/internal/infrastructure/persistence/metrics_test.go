@@ -0,0 +1,75 @@
+package persistence
+
+import (
+	"bufio"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+
+	// Registers the outbox metrics, which the member service exposes
+	// beside the repository's
+	_ "github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/infrastructure/outbox"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/metrics"
+)
+
+func TestMetricsEndpointExposesMemberMetrics(t *testing.T) {
+	// Opening does not connect, so pool stats are available without a
+	// database, as main registers them
+	db, err := sql.Open("postgres", "postgres://localhost/member?sslmode=disable")
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer db.Close()
+	prometheus.MustRegister(collectors.NewDBStatsCollector(db, "member"))
+
+	eventsAppended.WithLabelValues("member.registered").Inc()
+
+	families := scrape(t, metrics.Handler())
+	for _, name := range []string{
+		"member_events_appended_total",
+		"member_outbox_pending_events",
+		"member_outbox_lag_seconds",
+		"go_sql_open_connections",
+		"go_sql_wait_duration_seconds_total",
+	} {
+		if !families[name] {
+			t.Errorf("metric %s not exposed", name)
+		}
+	}
+}
+
+// scrape fetches /metrics from h over HTTP and returns the metric families
+// it exposes.
+func scrape(t *testing.T, h http.Handler) map[string]bool {
+	t.Helper()
+
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/metrics")
+	if err != nil {
+		t.Fatalf("scrape: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("scrape returned status %d", resp.StatusCode)
+	}
+
+	families := make(map[string]bool)
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		// Every family is introduced by "# TYPE <name> <type>"
+		if fields := strings.Fields(scanner.Text()); len(fields) == 4 && fields[1] == "TYPE" {
+			families[fields[2]] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("read scrape: %v", err)
+	}
+	return families
+}
@@ -0,0 +1,71 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/repository"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/telemetry"
+)
+
+// PostgresAuditLog implements repository.AuditLog using the
+// admin_audit_log table, which rejects updates and deletes.
+type PostgresAuditLog struct {
+	db *sql.DB
+}
+
+// NewPostgresAuditLog creates a new PostgreSQL-backed audit log.
+func NewPostgresAuditLog(db *sql.DB) repository.AuditLog {
+	return &PostgresAuditLog{db: db}
+}
+
+// Record appends an entry. The database assigns its ID and, when
+// OccurredAt is zero, its time.
+func (l *PostgresAuditLog) Record(ctx context.Context, entry repository.AuditEntry) (err error) {
+	ctx, span := startRepositorySpan(ctx, "PostgresAuditLog", "Record", "INSERT", "admin_audit_log")
+	defer func() { telemetry.End(span, err) }()
+
+	request := entry.Request
+	if len(request) == 0 {
+		request = []byte("{}")
+	}
+	_, err = l.db.ExecContext(ctx, `
+		INSERT INTO admin_audit_log (occurred_at, actor_id, caller, method, member_id, request, code, request_id)
+		VALUES (COALESCE($1, NOW()), $2, $3, $4, $5, $6, $7, $8)
+	`, nullTime(entry.OccurredAt), entry.ActorID, entry.Caller, entry.Method, entry.MemberID, request, entry.Code, entry.RequestID)
+	if err != nil {
+		return fmt.Errorf("insert audit entry: %w", err)
+	}
+	return nil
+}
+
+// List returns a page of entries, newest first.
+func (l *PostgresAuditLog) List(ctx context.Context, filter repository.AuditFilter, beforeID int64, limit int) (_ []repository.AuditEntry, err error) {
+	ctx, span := startRepositorySpan(ctx, "PostgresAuditLog", "List", "SELECT", "admin_audit_log")
+	defer func() { telemetry.End(span, err) }()
+
+	rows, err := l.db.QueryContext(ctx, `
+		SELECT id, occurred_at, actor_id, caller, method, member_id, request, code, request_id
+		FROM admin_audit_log
+		WHERE ($1 = '' OR member_id = $1)
+		  AND ($2 = '' OR actor_id = $2)
+		  AND ($3 = 0 OR id < $3)
+		ORDER BY id DESC
+		LIMIT $4
+	`, filter.MemberID, filter.ActorID, beforeID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query audit log: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []repository.AuditEntry
+	for rows.Next() {
+		var e repository.AuditEntry
+		if err := rows.Scan(&e.ID, &e.OccurredAt, &e.ActorID, &e.Caller, &e.Method, &e.MemberID, &e.Request, &e.Code, &e.RequestID); err != nil {
+			return nil, fmt.Errorf("scan audit entry: %w", err)
+		}
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
@@ -6,16 +6,28 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/lib/pq"
+	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/aggregate"
 	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/events"
 	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/repository"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/valueobject"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/correlation"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/eventstore"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/telemetry"
 )
 
 const aggregateType = "Member"
 
+var tracer = otel.Tracer("github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/infrastructure/persistence")
+
 // PostgresMemberRepository implements repository.MemberRepository using PostgreSQL
 // with event sourcing pattern.
 type PostgresMemberRepository struct {
@@ -38,12 +50,15 @@ func (r *PostgresMemberRepository) SaveWithPassword(ctx context.Context, member
 	return r.saveInternal(ctx, member, passwordHash)
 }
 
-func (r *PostgresMemberRepository) saveInternal(ctx context.Context, member *aggregate.Member, passwordHash string) error {
+func (r *PostgresMemberRepository) saveInternal(ctx context.Context, member *aggregate.Member, passwordHash string) (err error) {
 	changes := member.Changes()
 	if len(changes) == 0 {
 		return nil
 	}
 
+	ctx, span := startSpan(ctx, "Save", "INSERT", "events")
+	defer func() { telemetry.End(span, err) }()
+
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("begin transaction: %w", err)
@@ -53,6 +68,13 @@ func (r *PostgresMemberRepository) saveInternal(ctx context.Context, member *agg
 	// Get current version for optimistic locking
 	currentVersion := member.Version() - len(changes)
 
+	md := correlation.FromContext(ctx)
+	md.TraceParent = telemetry.TraceParent(ctx)
+	metadata, err := marshalMetadata(md)
+	if err != nil {
+		return fmt.Errorf("marshal metadata: %w", err)
+	}
+
 	// Save each event to the event store
 	for i, event := range changes {
 		eventData, err := json.Marshal(event)
@@ -62,9 +84,9 @@ func (r *PostgresMemberRepository) saveInternal(ctx context.Context, member *agg
 
 		version := currentVersion + i + 1
 		_, err = tx.ExecContext(ctx, `
-			INSERT INTO events (aggregate_id, aggregate_type, event_type, event_data, version, created_at)
-			VALUES ($1, $2, $3, $4, $5, $6)
-		`, event.AggregateID(), aggregateType, event.EventType(), eventData, version, event.OccurredAt())
+			INSERT INTO events (aggregate_id, aggregate_type, event_type, event_data, metadata, version, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`, event.AggregateID(), aggregateType, event.EventType(), eventData, metadata, version, event.OccurredAt())
 
 		if err != nil {
 			return fmt.Errorf("insert event: %w", err)
@@ -75,10 +97,19 @@ func (r *PostgresMemberRepository) saveInternal(ctx context.Context, member *agg
 	if err := r.updateReadModel(ctx, tx, member, passwordHash); err != nil {
 		return fmt.Errorf("update read model: %w", err)
 	}
+	if err := r.updateBlocks(ctx, tx, changes); err != nil {
+		return fmt.Errorf("update blocks: %w", err)
+	}
+	if err := r.updateSessions(ctx, tx, changes); err != nil {
+		return fmt.Errorf("update sessions: %w", err)
+	}
 
 	if err := tx.Commit(); err != nil {
 		return fmt.Errorf("commit transaction: %w", err)
 	}
+	for _, event := range changes {
+		eventsAppended.WithLabelValues(event.EventType()).Inc()
+	}
 
 	member.ClearChanges()
 	return nil
@@ -98,14 +129,86 @@ func (r *PostgresMemberRepository) GetByID(ctx context.Context, id string) (*agg
 	return aggregate.RehydrateMember(eventStream), nil
 }
 
+// GetByIDAt rehydrates a member from the events recorded up to and including
+// the given time.
+func (r *PostgresMemberRepository) GetByIDAt(ctx context.Context, id string, at time.Time) (*aggregate.Member, error) {
+	eventStream, err := r.loadEvents(ctx, "aggregate_id = $1 AND created_at <= $2", id, at)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(eventStream) == 0 {
+		return nil, aggregate.ErrMemberNotFound
+	}
+
+	return aggregate.RehydrateMember(eventStream), nil
+}
+
+// GetHistory returns a page of the member's events with their metadata,
+// oldest first.
+func (r *PostgresMemberRepository) GetHistory(ctx context.Context, memberID string, afterVersion, limit int) (_ []repository.HistoryEntry, err error) {
+	ctx, span := startSpan(ctx, "GetHistory", "SELECT", "events")
+	defer func() { telemetry.End(span, err) }()
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT event_type, event_data, metadata, version, created_at
+		FROM events
+		WHERE aggregate_id = $1 AND aggregate_type = $2 AND version > $3
+		ORDER BY version ASC
+		LIMIT $4
+	`, memberID, aggregateType, afterVersion, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query events: %w", err)
+	}
+	defer rows.Close()
+
+	var history []repository.HistoryEntry
+	for rows.Next() {
+		var (
+			eventType string
+			eventData []byte
+			metadata  []byte
+			entry     repository.HistoryEntry
+		)
+		if err := rows.Scan(&eventType, &eventData, &metadata, &entry.Version, &entry.RecordedAt); err != nil {
+			return nil, fmt.Errorf("scan event: %w", err)
+		}
+
+		entry.Event, err = deserializeEvent(eventType, eventData)
+		if err != nil {
+			return nil, fmt.Errorf("deserialize event: %w", err)
+		}
+		// Events recorded before metadata was written have none
+		if len(metadata) > 0 {
+			if err := json.Unmarshal(metadata, &entry.Metadata); err != nil {
+				return nil, fmt.Errorf("unmarshal metadata: %w", err)
+			}
+		}
+		history = append(history, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate events: %w", err)
+	}
+
+	return history, nil
+}
+
 // GetByEmail retrieves a member by email using the read model for lookup,
 // then rehydrates from the event stream.
 func (r *PostgresMemberRepository) GetByEmail(ctx context.Context, email string) (*aggregate.Member, error) {
 	// Use read model for email lookup
+	lookupCtx, span := startSpan(ctx, "GetByEmail", "SELECT", "members")
 	var id string
-	err := r.db.QueryRowContext(ctx, `
+	err := r.db.QueryRowContext(lookupCtx, `
 		SELECT id FROM members WHERE email = $1
 	`, email).Scan(&id)
+	// A missing member is an answer, not a failure
+	if errors.Is(err, sql.ErrNoRows) {
+		telemetry.End(span, nil)
+	} else {
+		telemetry.End(span, err)
+	}
 
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, aggregate.ErrMemberNotFound
@@ -118,7 +221,10 @@ func (r *PostgresMemberRepository) GetByEmail(ctx context.Context, email string)
 }
 
 // loadEvents retrieves events from the event store and deserializes them.
-func (r *PostgresMemberRepository) loadEvents(ctx context.Context, where string, args ...any) ([]events.Event, error) {
+func (r *PostgresMemberRepository) loadEvents(ctx context.Context, where string, args ...any) (_ []events.Event, err error) {
+	ctx, span := startSpan(ctx, "LoadEvents", "SELECT", "events")
+	defer func() { telemetry.End(span, err) }()
+
 	query := fmt.Sprintf(`
 		SELECT event_type, event_data, version
 		FROM events
@@ -159,9 +265,12 @@ func (r *PostgresMemberRepository) loadEvents(ctx context.Context, where string,
 }
 
 // GetPasswordHash retrieves the password hash for a member.
-func (r *PostgresMemberRepository) GetPasswordHash(ctx context.Context, memberID string) (string, error) {
+func (r *PostgresMemberRepository) GetPasswordHash(ctx context.Context, memberID string) (_ string, err error) {
+	ctx, span := startSpan(ctx, "GetPasswordHash", "SELECT", "members")
+	defer func() { telemetry.End(span, err) }()
+
 	var passwordHash sql.NullString
-	err := r.db.QueryRowContext(ctx, `
+	err = r.db.QueryRowContext(ctx, `
 		SELECT password_hash FROM members WHERE id = $1
 	`, memberID).Scan(&passwordHash)
 
@@ -175,9 +284,216 @@ func (r *PostgresMemberRepository) GetPasswordHash(ctx context.Context, memberID
 	return passwordHash.String, nil
 }
 
+// IsBlocked reports whether either member has blocked the other, using the
+// blocks read model.
+func (r *PostgresMemberRepository) IsBlocked(ctx context.Context, memberID, otherMemberID string) (_ bool, err error) {
+	ctx, span := startSpan(ctx, "IsBlocked", "SELECT", "member_blocks")
+	defer func() { telemetry.End(span, err) }()
+
+	var blocked bool
+	err = r.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM member_blocks
+			WHERE (member_id = $1 AND blocked_member_id = $2)
+			   OR (member_id = $2 AND blocked_member_id = $1)
+		)
+	`, memberID, otherMemberID).Scan(&blocked)
+	if err != nil {
+		return false, fmt.Errorf("query blocks: %w", err)
+	}
+
+	return blocked, nil
+}
+
+// updateBlocks applies block and unblock events to the blocks read model.
+func (r *PostgresMemberRepository) updateBlocks(ctx context.Context, tx *sql.Tx, changes []events.Event) error {
+	for _, event := range changes {
+		var err error
+		switch e := event.(type) {
+		case events.MemberBlocked:
+			_, err = tx.ExecContext(ctx, `
+				INSERT INTO member_blocks (member_id, blocked_member_id, created_at)
+				VALUES ($1, $2, $3)
+				ON CONFLICT DO NOTHING
+			`, e.MemberID, e.BlockedMemberID, e.Timestamp)
+		case events.MemberUnblocked:
+			_, err = tx.ExecContext(ctx, `
+				DELETE FROM member_blocks WHERE member_id = $1 AND blocked_member_id = $2
+			`, e.MemberID, e.BlockedMemberID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateSessions records when the member was last signed out everywhere,
+// which the gateway checks tokens against.
+func (r *PostgresMemberRepository) updateSessions(ctx context.Context, tx *sql.Tx, changes []events.Event) error {
+	for _, event := range changes {
+		if e, ok := event.(events.SessionsRevoked); ok {
+			if _, err := tx.ExecContext(ctx, `
+				UPDATE members SET sessions_revoked_at = $2 WHERE id = $1
+			`, e.MemberID, e.Timestamp); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ListSessionRevocations returns the members signed out everywhere since
+// the given time, using the read model.
+func (r *PostgresMemberRepository) ListSessionRevocations(ctx context.Context, since time.Time) (_ []repository.SessionRevocation, err error) {
+	ctx, span := startSpan(ctx, "ListSessionRevocations", "SELECT", "members")
+	defer func() { telemetry.End(span, err) }()
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, sessions_revoked_at FROM members
+		WHERE sessions_revoked_at >= $1
+		ORDER BY sessions_revoked_at
+	`, since)
+	if err != nil {
+		return nil, fmt.Errorf("query session revocations: %w", err)
+	}
+	defer rows.Close()
+
+	var revocations []repository.SessionRevocation
+	for rows.Next() {
+		var rev repository.SessionRevocation
+		if err := rows.Scan(&rev.MemberID, &rev.RevokedAt); err != nil {
+			return nil, fmt.Errorf("scan session revocation: %w", err)
+		}
+		revocations = append(revocations, rev)
+	}
+	return revocations, rows.Err()
+}
+
+// memberSortKeys are the ORDER BY expressions of each sort, matching the
+// indexes of migration 000014, and whether they descend.
+var memberSortKeys = map[repository.MemberSort]struct {
+	expr string
+	desc bool
+}{
+	repository.SortNewest:      {"created_at", true},
+	repository.SortOldest:      {"created_at", false},
+	repository.SortEmail:       {"email", false},
+	repository.SortDisplayName: {"COALESCE(display_name, '')", false},
+}
+
+// SearchMembers filters the read model and pages through it by keyset, so
+// later pages cost the same as the first.
+func (r *PostgresMemberRepository) SearchMembers(ctx context.Context, search repository.MemberSearch, after *repository.MemberCursor, limit int) (_ []repository.MemberSummary, err error) {
+	ctx, span := startSpan(ctx, "SearchMembers", "SELECT", "members")
+	defer func() { telemetry.End(span, err) }()
+
+	key, ok := memberSortKeys[search.Sort]
+	if !ok {
+		key = memberSortKeys[repository.SortNewest]
+	}
+
+	var (
+		where []string
+		args  []any
+	)
+	arg := func(v any) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	if search.ID != "" {
+		where = append(where, "id = "+arg(search.ID))
+	}
+	if search.Text != "" {
+		pattern := arg("%" + escapeLike(search.Text) + "%")
+		where = append(where, "(email ILIKE "+pattern+" OR display_name ILIKE "+pattern+")")
+	}
+	if len(search.Statuses) > 0 {
+		where = append(where, "status = ANY("+arg(pq.Array(search.Statuses))+")")
+	}
+	if len(search.Genders) > 0 {
+		where = append(where, "gender = ANY("+arg(pq.Array(search.Genders))+")")
+	}
+	if !search.CreatedFrom.IsZero() {
+		where = append(where, "created_at >= "+arg(search.CreatedFrom))
+	}
+	if !search.CreatedBefore.IsZero() {
+		where = append(where, "created_at < "+arg(search.CreatedBefore))
+	}
+	if !search.BornAfter.IsZero() {
+		where = append(where, "birth_date > "+arg(search.BornAfter))
+	}
+	if !search.BornOnOrBefore.IsZero() {
+		where = append(where, "birth_date <= "+arg(search.BornOnOrBefore))
+	}
+
+	if after != nil {
+		var value any
+		switch search.Sort {
+		case repository.SortEmail:
+			value = after.Email
+		case repository.SortDisplayName:
+			value = after.DisplayName
+		default:
+			value = after.CreatedAt
+		}
+		op := ">"
+		if key.desc {
+			op = "<"
+		}
+		where = append(where, fmt.Sprintf("(%s, id) %s (%s, %s)", key.expr, op, arg(value), arg(after.ID)))
+	}
+
+	direction := "ASC"
+	if key.desc {
+		direction = "DESC"
+	}
+
+	query := `
+		SELECT id, email, COALESCE(display_name, ''), COALESCE(gender, ''), birth_date, status,
+			email_verified, created_at, sessions_revoked_at,
+			ARRAY(SELECT role FROM member_roles WHERE member_id = members.id ORDER BY role)
+		FROM members`
+	if len(where) > 0 {
+		query += "\n\t\tWHERE " + strings.Join(where, "\n\t\t  AND ")
+	}
+	query += fmt.Sprintf("\n\t\tORDER BY %s %s, id %s\n\t\tLIMIT %s", key.expr, direction, direction, arg(limit))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("search members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []repository.MemberSummary
+	for rows.Next() {
+		var (
+			m         repository.MemberSummary
+			birthDate sql.NullTime
+			revokedAt sql.NullTime
+		)
+		if err := rows.Scan(&m.ID, &m.Email, &m.DisplayName, &m.Gender, &birthDate, &m.Status,
+			&m.EmailVerified, &m.CreatedAt, &revokedAt, pq.Array(&m.Roles)); err != nil {
+			return nil, fmt.Errorf("scan member: %w", err)
+		}
+		m.BirthDate = birthDate.Time
+		m.SessionsRevokedAt = revokedAt.Time
+		members = append(members, m)
+	}
+	return members, rows.Err()
+}
+
+// escapeLike escapes the LIKE wildcards in s, so it matches literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
 // updateReadModel upserts the member read model from the current aggregate state.
 func (r *PostgresMemberRepository) updateReadModel(ctx context.Context, tx *sql.Tx, member *aggregate.Member, passwordHash string) error {
 	profile := member.Profile()
+	prefs := member.Preferences()
+	onboarding := member.Onboarding()
 
 	// Convert photo URLs to string slice for PostgreSQL array
 	photos := make([]string, len(profile.Photos))
@@ -185,11 +501,32 @@ func (r *PostgresMemberRepository) updateReadModel(ctx context.Context, tx *sql.
 		photos[i] = string(p)
 	}
 
+	interests := make([]string, len(profile.Interests))
+	for i, id := range profile.Interests {
+		interests[i] = string(id)
+	}
+
+	prompts, err := marshalPrompts(member.Prompts())
+	if err != nil {
+		return fmt.Errorf("marshal prompts: %w", err)
+	}
+
+	prefGenders := make([]string, len(prefs.Genders))
+	for i, g := range prefs.Genders {
+		prefGenders[i] = string(g)
+	}
+	prefDealbreakers := make([]string, len(prefs.Dealbreakers))
+	for i, d := range prefs.Dealbreakers {
+		prefDealbreakers[i] = string(d)
+	}
+
 	if passwordHash != "" {
 		// Insert with password hash (registration)
 		_, err := tx.ExecContext(ctx, `
-			INSERT INTO members (id, email, password_hash, display_name, bio, birth_date, gender, interests, photos, status, version, created_at, updated_at)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NOW(), NOW())
+			INSERT INTO members (id, email, password_hash, display_name, bio, birth_date, gender, interests, photos, status, version,
+				pref_min_age, pref_max_age, pref_genders, pref_max_distance_km, pref_dealbreakers, prompts,
+				email_verified, onboarding_step, completeness, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, NOW(), NOW())
 			ON CONFLICT (id) DO UPDATE SET
 				email = EXCLUDED.email,
 				password_hash = EXCLUDED.password_hash,
@@ -201,6 +538,15 @@ func (r *PostgresMemberRepository) updateReadModel(ctx context.Context, tx *sql.
 				photos = EXCLUDED.photos,
 				status = EXCLUDED.status,
 				version = EXCLUDED.version,
+				pref_min_age = EXCLUDED.pref_min_age,
+				pref_max_age = EXCLUDED.pref_max_age,
+				pref_genders = EXCLUDED.pref_genders,
+				pref_max_distance_km = EXCLUDED.pref_max_distance_km,
+				pref_dealbreakers = EXCLUDED.pref_dealbreakers,
+				prompts = EXCLUDED.prompts,
+				email_verified = EXCLUDED.email_verified,
+				onboarding_step = EXCLUDED.onboarding_step,
+				completeness = EXCLUDED.completeness,
 				updated_at = NOW()
 		`,
 			member.ID(),
@@ -210,18 +556,29 @@ func (r *PostgresMemberRepository) updateReadModel(ctx context.Context, tx *sql.
 			nullString(profile.Bio),
 			nullTime(profile.BirthDate),
 			nullString(string(profile.Gender)),
-			pq.Array(profile.Interests),
+			pq.Array(interests),
 			pq.Array(photos),
 			string(member.Status()),
 			member.Version(),
+			prefs.MinAge,
+			prefs.MaxAge,
+			pq.Array(prefGenders),
+			prefs.MaxDistanceKm,
+			pq.Array(prefDealbreakers),
+			prompts,
+			member.EmailVerified(),
+			string(onboarding.NextStep),
+			onboarding.Completeness,
 		)
 		return err
 	}
 
 	// Update without changing password hash
-	_, err := tx.ExecContext(ctx, `
-		INSERT INTO members (id, email, display_name, bio, birth_date, gender, interests, photos, status, version, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW(), NOW())
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO members (id, email, display_name, bio, birth_date, gender, interests, photos, status, version,
+			pref_min_age, pref_max_age, pref_genders, pref_max_distance_km, pref_dealbreakers, prompts,
+			email_verified, onboarding_step, completeness, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, NOW(), NOW())
 		ON CONFLICT (id) DO UPDATE SET
 			email = EXCLUDED.email,
 			display_name = EXCLUDED.display_name,
@@ -232,6 +589,15 @@ func (r *PostgresMemberRepository) updateReadModel(ctx context.Context, tx *sql.
 			photos = EXCLUDED.photos,
 			status = EXCLUDED.status,
 			version = EXCLUDED.version,
+			pref_min_age = EXCLUDED.pref_min_age,
+			pref_max_age = EXCLUDED.pref_max_age,
+			pref_genders = EXCLUDED.pref_genders,
+			pref_max_distance_km = EXCLUDED.pref_max_distance_km,
+			pref_dealbreakers = EXCLUDED.pref_dealbreakers,
+			prompts = EXCLUDED.prompts,
+			email_verified = EXCLUDED.email_verified,
+			onboarding_step = EXCLUDED.onboarding_step,
+			completeness = EXCLUDED.completeness,
 			updated_at = NOW()
 	`,
 		member.ID(),
@@ -240,15 +606,66 @@ func (r *PostgresMemberRepository) updateReadModel(ctx context.Context, tx *sql.
 		nullString(profile.Bio),
 		nullTime(profile.BirthDate),
 		nullString(string(profile.Gender)),
-		pq.Array(profile.Interests),
+		pq.Array(interests),
 		pq.Array(photos),
 		string(member.Status()),
 		member.Version(),
+		prefs.MinAge,
+		prefs.MaxAge,
+		pq.Array(prefGenders),
+		prefs.MaxDistanceKm,
+		pq.Array(prefDealbreakers),
+		prompts,
+		member.EmailVerified(),
+		string(onboarding.NextStep),
+		onboarding.Completeness,
 	)
 
 	return err
 }
 
+// marshalPrompts encodes answered prompts for the read model's JSONB column.
+func marshalPrompts(prompts valueobject.ProfilePrompts) ([]byte, error) {
+	type promptRow struct {
+		PromptID string `json:"prompt_id"`
+		Answer   string `json:"answer"`
+	}
+
+	rows := make([]promptRow, len(prompts))
+	for i, p := range prompts {
+		rows[i] = promptRow{PromptID: string(p.PromptID), Answer: p.Answer}
+	}
+	return json.Marshal(rows)
+}
+
+// startSpan starts a span for a repository method and the main SQL
+// operation it runs.
+func startSpan(ctx context.Context, method, operation, table string) (context.Context, trace.Span) {
+	return startRepositorySpan(ctx, "PostgresMemberRepository", method, operation, table)
+}
+
+// startRepositorySpan starts a client span for a query made by one of the
+// repositories in this package.
+func startRepositorySpan(ctx context.Context, store, method, operation, table string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, store+"."+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBOperationName(operation),
+			semconv.DBCollectionName(table),
+		),
+	)
+}
+
+// marshalMetadata encodes the request metadata stored with events, or
+// returns nil to store NULL when there is none, e.g. for background work.
+func marshalMetadata(md eventstore.Metadata) ([]byte, error) {
+	if md == (eventstore.Metadata{}) {
+		return nil, nil
+	}
+	return json.Marshal(md)
+}
+
 // nullString returns sql.NullString for optional string fields.
 func nullString(s string) sql.NullString {
 	if s == "" {
@@ -282,6 +699,48 @@ func deserializeEvent(eventType string, data []byte) (events.Event, error) {
 		}
 		return e, nil
 
+	case "member.prompt_answered":
+		var e events.PromptAnswered
+		if err := json.Unmarshal(data, &e); err != nil {
+			return nil, err
+		}
+		return e, nil
+
+	case "member.prompt_removed":
+		var e events.PromptRemoved
+		if err := json.Unmarshal(data, &e); err != nil {
+			return nil, err
+		}
+		return e, nil
+
+	case "member.prompts_reordered":
+		var e events.PromptsReordered
+		if err := json.Unmarshal(data, &e); err != nil {
+			return nil, err
+		}
+		return e, nil
+
+	case "member.interests_updated":
+		var e events.InterestsUpdated
+		if err := json.Unmarshal(data, &e); err != nil {
+			return nil, err
+		}
+		return e, nil
+
+	case "member.preferences_updated":
+		var e events.PreferencesUpdated
+		if err := json.Unmarshal(data, &e); err != nil {
+			return nil, err
+		}
+		return e, nil
+
+	case "member.email_verified":
+		var e events.EmailVerified
+		if err := json.Unmarshal(data, &e); err != nil {
+			return nil, err
+		}
+		return e, nil
+
 	case "member.activated":
 		var e events.MemberActivated
 		if err := json.Unmarshal(data, &e); err != nil {
@@ -296,6 +755,55 @@ func deserializeEvent(eventType string, data []byte) (events.Event, error) {
 		}
 		return e, nil
 
+	case "member.reinstated":
+		var e events.MemberReinstated
+		if err := json.Unmarshal(data, &e); err != nil {
+			return nil, err
+		}
+		return e, nil
+
+	case "member.sessions_revoked":
+		var e events.SessionsRevoked
+		if err := json.Unmarshal(data, &e); err != nil {
+			return nil, err
+		}
+		return e, nil
+
+	case "member.photo_added":
+		var e events.PhotoAdded
+		if err := json.Unmarshal(data, &e); err != nil {
+			return nil, err
+		}
+		return e, nil
+
+	case "member.photo_removed":
+		var e events.PhotoRemoved
+		if err := json.Unmarshal(data, &e); err != nil {
+			return nil, err
+		}
+		return e, nil
+
+	case "member.photos_reordered":
+		var e events.PhotosReordered
+		if err := json.Unmarshal(data, &e); err != nil {
+			return nil, err
+		}
+		return e, nil
+
+	case "member.blocked":
+		var e events.MemberBlocked
+		if err := json.Unmarshal(data, &e); err != nil {
+			return nil, err
+		}
+		return e, nil
+
+	case "member.unblocked":
+		var e events.MemberUnblocked
+		if err := json.Unmarshal(data, &e); err != nil {
+			return nil, err
+		}
+		return e, nil
+
 	default:
 		return nil, fmt.Errorf("unknown event type: %s", eventType)
 	}
@@ -0,0 +1,82 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/repository"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/telemetry"
+)
+
+// PostgresRoleRepository implements repository.RoleRepository using the
+// member_roles table.
+type PostgresRoleRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresRoleRepository creates a new PostgreSQL-backed role repository.
+func NewPostgresRoleRepository(db *sql.DB) repository.RoleRepository {
+	return &PostgresRoleRepository{db: db}
+}
+
+// Roles returns the member's roles in alphabetical order.
+func (r *PostgresRoleRepository) Roles(ctx context.Context, memberID string) (_ []string, err error) {
+	ctx, span := startRepositorySpan(ctx, "PostgresRoleRepository", "Roles", "SELECT", "member_roles")
+	defer func() { telemetry.End(span, err) }()
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT role FROM member_roles WHERE member_id = $1 ORDER BY role
+	`, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("query roles: %w", err)
+	}
+	defer rows.Close()
+
+	var roles []string
+	for rows.Next() {
+		var role string
+		if err := rows.Scan(&role); err != nil {
+			return nil, fmt.Errorf("scan role: %w", err)
+		}
+		roles = append(roles, role)
+	}
+	return roles, rows.Err()
+}
+
+// SetRoles replaces the member's roles in one transaction. Roles the member
+// keeps retain when and by whom they were granted.
+func (r *PostgresRoleRepository) SetRoles(ctx context.Context, memberID string, roles []string, grantedBy string) (err error) {
+	ctx, span := startRepositorySpan(ctx, "PostgresRoleRepository", "SetRoles", "INSERT", "member_roles")
+	defer func() { telemetry.End(span, err) }()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// A nil array would be NULL, which matches no role
+	kept := append([]string{}, roles...)
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM member_roles WHERE member_id = $1 AND NOT (role = ANY($2))
+	`, memberID, pq.Array(kept)); err != nil {
+		return fmt.Errorf("remove roles: %w", err)
+	}
+	for _, role := range roles {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO member_roles (member_id, role, granted_by)
+			VALUES ($1, $2, $3)
+			ON CONFLICT DO NOTHING
+		`, memberID, role, grantedBy); err != nil {
+			return fmt.Errorf("grant role: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
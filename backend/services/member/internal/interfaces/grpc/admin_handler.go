@@ -0,0 +1,262 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	memberv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/member/v1"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/application"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/aggregate"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/commands"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/repository"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/interceptors"
+)
+
+// AdminHandler implements the gRPC MemberAdminServiceServer interface.
+type AdminHandler struct {
+	memberv1.UnimplementedMemberAdminServiceServer
+	service *application.MemberService
+}
+
+// NewAdminHandler creates a new gRPC handler for the member admin service.
+func NewAdminHandler(service *application.MemberService) *AdminHandler {
+	return &AdminHandler{service: service}
+}
+
+// GetMemberHistory returns a page of a member's event timeline.
+func (h *AdminHandler) GetMemberHistory(ctx context.Context, req *memberv1.GetMemberHistoryRequest) (*memberv1.GetMemberHistoryResponse, error) {
+	history, err := h.service.GetMemberHistory(ctx, req.MemberId, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	entries := make([]*memberv1.HistoryEntry, len(history.Items))
+	for i, item := range history.Items {
+		details := make([]*memberv1.HistoryDetail, len(item.Details))
+		for j, d := range item.Details {
+			details[j] = &memberv1.HistoryDetail{Name: d.Name, Value: d.Value}
+		}
+
+		entries[i] = &memberv1.HistoryEntry{
+			Version:    int32(item.Version),
+			EventType:  item.EventType,
+			OccurredAt: timestamppb.New(item.OccurredAt),
+			Summary:    item.Summary,
+			Details:    details,
+			Metadata: &memberv1.EventMetadata{
+				CorrelationId: item.Metadata.CorrelationID,
+				CausationId:   item.Metadata.CausationID,
+				UserId:        item.Metadata.UserID,
+			},
+		}
+	}
+
+	return &memberv1.GetMemberHistoryResponse{
+		Entries:       entries,
+		NextPageToken: history.NextPageToken,
+	}, nil
+}
+
+// GetMemberStateAt returns the member as they were at the given time.
+func (h *AdminHandler) GetMemberStateAt(ctx context.Context, req *memberv1.GetMemberStateAtRequest) (*memberv1.GetMemberStateAtResponse, error) {
+	member, err := h.service.GetMemberAt(ctx, req.MemberId, req.At.AsTime())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &memberv1.GetMemberStateAtResponse{
+		Member:  toProtoMember(member),
+		Version: int32(member.Version()),
+	}, nil
+}
+
+// SearchMembers returns a page of the members matching the request's
+// filters.
+func (h *AdminHandler) SearchMembers(ctx context.Context, req *memberv1.SearchMembersRequest) (*memberv1.SearchMembersResponse, error) {
+	query := application.MemberQuery{
+		Query:  req.Query,
+		MinAge: int(req.MinAge),
+		MaxAge: int(req.MaxAge),
+		Sort:   fromProtoMemberSort(req.Sort),
+	}
+	for _, s := range req.Statuses {
+		if status := fromProtoStatus(s); status != "" {
+			query.Statuses = append(query.Statuses, string(status))
+		}
+	}
+	for _, g := range req.Genders {
+		if gender := protoGenderToString(g); gender != "" {
+			query.Genders = append(query.Genders, gender)
+		}
+	}
+	if req.CreatedAfter != nil {
+		query.CreatedAfter = req.CreatedAfter.AsTime()
+	}
+	if req.CreatedBefore != nil {
+		query.CreatedBefore = req.CreatedBefore.AsTime()
+	}
+
+	page, err := h.service.SearchMembers(ctx, query, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	members := make([]*memberv1.MemberDetails, len(page.Members))
+	for i, m := range page.Members {
+		members[i] = toProtoMemberDetails(m)
+	}
+
+	return &memberv1.SearchMembersResponse{
+		Members:       members,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+// SuspendMember suspends a member and signs them out everywhere.
+func (h *AdminHandler) SuspendMember(ctx context.Context, req *memberv1.SuspendMemberRequest) (*memberv1.SuspendMemberResponse, error) {
+	cmd := commands.SuspendMember{
+		MemberID: req.MemberId,
+		Reason:   req.Reason,
+	}
+
+	if err := h.service.SuspendMember(ctx, cmd); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	member, err := h.service.GetMember(ctx, req.MemberId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &memberv1.SuspendMemberResponse{
+		Member: toProtoMember(member),
+	}, nil
+}
+
+// ReinstateMember lifts a member's suspension.
+func (h *AdminHandler) ReinstateMember(ctx context.Context, req *memberv1.ReinstateMemberRequest) (*memberv1.ReinstateMemberResponse, error) {
+	member, err := h.service.ReinstateMember(ctx, commands.ReinstateMember{
+		MemberID: req.MemberId,
+		Reason:   req.Reason,
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &memberv1.ReinstateMemberResponse{
+		Member: toProtoMember(member),
+	}, nil
+}
+
+// RevokeSessions signs a member out on every device.
+func (h *AdminHandler) RevokeSessions(ctx context.Context, req *memberv1.RevokeSessionsRequest) (*memberv1.RevokeSessionsResponse, error) {
+	revokedAt, err := h.service.RevokeSessions(ctx, commands.RevokeSessions{
+		MemberID: req.MemberId,
+		Reason:   req.Reason,
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &memberv1.RevokeSessionsResponse{
+		RevokedAt: timestamppb.New(revokedAt),
+	}, nil
+}
+
+// SetMemberRoles replaces a member's staff roles.
+func (h *AdminHandler) SetMemberRoles(ctx context.Context, req *memberv1.SetMemberRolesRequest) (*memberv1.SetMemberRolesResponse, error) {
+	roles, err := h.service.SetMemberRoles(ctx, commands.SetMemberRoles{
+		MemberID:  req.MemberId,
+		Roles:     req.Roles,
+		GrantedBy: interceptors.MemberFromContext(ctx),
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &memberv1.SetMemberRolesResponse{Roles: roles}, nil
+}
+
+// ListAuditLog returns a page of recorded admin calls, newest first.
+func (h *AdminHandler) ListAuditLog(ctx context.Context, req *memberv1.ListAuditLogRequest) (*memberv1.ListAuditLogResponse, error) {
+	filter := repository.AuditFilter{MemberID: req.MemberId, ActorID: req.ActorId}
+	page, err := h.service.ListAuditLog(ctx, filter, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	entries := make([]*memberv1.AuditEntry, len(page.Entries))
+	for i, e := range page.Entries {
+		entries[i] = &memberv1.AuditEntry{
+			Id:         e.ID,
+			OccurredAt: timestamppb.New(e.OccurredAt),
+			ActorId:    e.ActorID,
+			Caller:     e.Caller,
+			Method:     e.Method,
+			MemberId:   e.MemberID,
+			Request:    string(e.Request),
+			Code:       e.Code,
+			RequestId:  e.RequestID,
+		}
+	}
+
+	return &memberv1.ListAuditLogResponse{
+		Entries:       entries,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+// toProtoMemberDetails converts a search result to protobuf. The member
+// only carries what the read model lists.
+func toProtoMemberDetails(m repository.MemberSummary) *memberv1.MemberDetails {
+	member := &memberv1.Member{
+		Id:     m.ID,
+		Email:  m.Email,
+		Status: toProtoStatus(aggregate.MemberStatus(m.Status)),
+		Profile: &memberv1.Profile{
+			DisplayName: m.DisplayName,
+			Gender:      toProtoGender(m.Gender),
+		},
+		EmailVerified: m.EmailVerified,
+		CreatedAt:     timestamppb.New(m.CreatedAt),
+	}
+	if !m.BirthDate.IsZero() {
+		member.Profile.BirthDate = timestamppb.New(m.BirthDate)
+	}
+
+	details := &memberv1.MemberDetails{Member: member, Roles: m.Roles}
+	if !m.SessionsRevokedAt.IsZero() {
+		details.SessionsRevokedAt = timestamppb.New(m.SessionsRevokedAt)
+	}
+	return details
+}
+
+// fromProtoStatus converts a protobuf member status to the domain, or ""
+// when unspecified.
+func fromProtoStatus(s memberv1.MemberStatus) aggregate.MemberStatus {
+	switch s {
+	case memberv1.MemberStatus_MEMBER_STATUS_PENDING:
+		return aggregate.MemberStatusPending
+	case memberv1.MemberStatus_MEMBER_STATUS_ACTIVE:
+		return aggregate.MemberStatusActive
+	case memberv1.MemberStatus_MEMBER_STATUS_SUSPENDED:
+		return aggregate.MemberStatusSuspended
+	default:
+		return ""
+	}
+}
+
+// fromProtoMemberSort converts a protobuf search sort to the domain.
+func fromProtoMemberSort(s memberv1.MemberSort) repository.MemberSort {
+	switch s {
+	case memberv1.MemberSort_MEMBER_SORT_OLDEST:
+		return repository.SortOldest
+	case memberv1.MemberSort_MEMBER_SORT_EMAIL:
+		return repository.SortEmail
+	case memberv1.MemberSort_MEMBER_SORT_DISPLAY_NAME:
+		return repository.SortDisplayName
+	default:
+		return repository.SortNewest
+	}
+}
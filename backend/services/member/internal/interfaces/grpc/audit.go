@@ -0,0 +1,73 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	memberv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/member/v1"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/application"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/repository"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/correlation"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/interceptors"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/logging"
+)
+
+// auditTimeout bounds recording an entry, which happens after the call
+// and so outside its deadline.
+const auditTimeout = 5 * time.Second
+
+var auditFailures = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "member_audit_log_failures_total",
+	Help: "Admin calls that could not be recorded in the audit log.",
+})
+
+// Audit records every authorized MemberAdminService call, and every call an
+// operator makes with the admin credentials, in the audit log with its
+// outcome. It must run after interceptors.Authorize, which identifies the
+// caller and verifies the acting member.
+// Calls are not failed when recording fails, so an audit log outage does
+// not stop support; such failures are logged and counted instead.
+func Audit(service *application.MemberService) grpc.UnaryServerInterceptor {
+	adminPrefix := "/" + memberv1.MemberAdminService_ServiceDesc.ServiceName + "/"
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		caller := interceptors.CallerFromContext(ctx)
+		if caller != CallerAdmin && !strings.HasPrefix(info.FullMethod, adminPrefix) {
+			return handler(ctx, req)
+		}
+
+		resp, err := handler(ctx, req)
+
+		md := correlation.FromContext(ctx)
+		entry := repository.AuditEntry{
+			OccurredAt: time.Now(),
+			ActorID:    interceptors.MemberFromContext(ctx),
+			Caller:     caller,
+			Method:     info.FullMethod,
+			Code:       status.Code(err).String(),
+			RequestID:  md.CorrelationID,
+		}
+		if msg, ok := req.(proto.Message); ok {
+			entry.MemberID = interceptors.StringField(msg, "member_id")
+			entry.Request, _ = protojson.Marshal(msg)
+		}
+
+		recordCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), auditTimeout)
+		defer cancel()
+		if recordErr := service.RecordAudit(recordCtx, entry); recordErr != nil {
+			auditFailures.Inc()
+			logging.FromContext(ctx).ErrorContext(ctx, "failed to record admin call in the audit log",
+				"method", info.FullMethod, "member_id", entry.MemberID, "error", recordErr)
+		}
+
+		return resp, err
+	}
+}
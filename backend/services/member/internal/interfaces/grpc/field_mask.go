@@ -0,0 +1,31 @@
+package grpc
+
+import (
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// applyMask copies the fields named in mask from update to current, which
+// holds the stored values. Only top-level fields in updatable may be named;
+// the others have their own RPCs.
+func applyMask(current, update proto.Message, mask *fieldmaskpb.FieldMask, updatable ...string) error {
+	dst, src := current.ProtoReflect(), update.ProtoReflect()
+	fields := dst.Descriptor().Fields()
+	for _, path := range mask.GetPaths() {
+		if !slices.Contains(updatable, path) {
+			return status.Errorf(codes.InvalidArgument, "update_mask: %s cannot be updated", path)
+		}
+		field := fields.ByName(protoreflect.Name(path))
+		if src.Has(field) {
+			dst.Set(field, src.Get(field))
+		} else {
+			dst.Clear(field)
+		}
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"slices"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
@@ -12,6 +13,7 @@ import (
 	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/application"
 	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/aggregate"
 	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/commands"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/valueobject"
 )
 
 // MemberHandler implements the gRPC MemberServiceServer interface.
@@ -27,13 +29,6 @@ func NewMemberHandler(service *application.MemberService) *MemberHandler {
 
 // RegisterMember handles member registration requests.
 func (h *MemberHandler) RegisterMember(ctx context.Context, req *memberv1.RegisterMemberRequest) (*memberv1.RegisterMemberResponse, error) {
-	if req.Email == "" {
-		return nil, status.Error(codes.InvalidArgument, "email is required")
-	}
-	if req.Password == "" {
-		return nil, status.Error(codes.InvalidArgument, "password is required")
-	}
-
 	cmd := commands.RegisterMember{
 		MemberID: uuid.New().String(),
 		Email:    req.Email,
@@ -52,29 +47,25 @@ func (h *MemberHandler) RegisterMember(ctx context.Context, req *memberv1.Regist
 
 // AuthenticateMember handles member authentication requests.
 func (h *MemberHandler) AuthenticateMember(ctx context.Context, req *memberv1.AuthenticateMemberRequest) (*memberv1.AuthenticateMemberResponse, error) {
-	if req.Email == "" {
-		return nil, status.Error(codes.InvalidArgument, "email is required")
-	}
-	if req.Password == "" {
-		return nil, status.Error(codes.InvalidArgument, "password is required")
+	member, err := h.service.AuthenticateMember(ctx, req.Email, req.Password)
+	if err != nil {
+		return nil, toGRPCError(err)
 	}
 
-	member, err := h.service.AuthenticateMember(ctx, req.Email, req.Password)
+	// Staff roles go into the member's tokens
+	roles, err := h.service.MemberRoles(ctx, member.ID())
 	if err != nil {
 		return nil, toGRPCError(err)
 	}
 
 	return &memberv1.AuthenticateMemberResponse{
 		Member: toProtoMember(member),
+		Roles:  roles,
 	}, nil
 }
 
 // GetMember retrieves a member by ID.
 func (h *MemberHandler) GetMember(ctx context.Context, req *memberv1.GetMemberRequest) (*memberv1.GetMemberResponse, error) {
-	if req.MemberId == "" {
-		return nil, status.Error(codes.InvalidArgument, "member_id is required")
-	}
-
 	member, err := h.service.GetMember(ctx, req.MemberId)
 	if err != nil {
 		return nil, toGRPCError(err)
@@ -85,23 +76,34 @@ func (h *MemberHandler) GetMember(ctx context.Context, req *memberv1.GetMemberRe
 	}, nil
 }
 
-// UpdateProfile updates a member's profile.
+// UpdateProfile updates a member's profile, or only the fields named in
+// update_mask.
 func (h *MemberHandler) UpdateProfile(ctx context.Context, req *memberv1.UpdateProfileRequest) (*memberv1.UpdateProfileResponse, error) {
-	if req.MemberId == "" {
-		return nil, status.Error(codes.InvalidArgument, "member_id is required")
-	}
-	if req.Profile == nil {
-		return nil, status.Error(codes.InvalidArgument, "profile is required")
+	// A birth date cannot be removed; an unset one would be stored as the
+	// Unix epoch
+	paths := req.GetUpdateMask().GetPaths()
+	if (len(paths) == 0 || slices.Contains(paths, "birth_date")) && req.GetProfile().GetBirthDate() == nil {
+		return nil, status.Error(codes.InvalidArgument, "profile.birth_date: is required")
 	}
 
-	var birthDate = req.Profile.BirthDate.AsTime()
+	profile := req.Profile
+	if len(paths) > 0 {
+		member, err := h.service.GetMember(ctx, req.MemberId)
+		if err != nil {
+			return nil, toGRPCError(err)
+		}
+		profile = toProtoMember(member).Profile
+		if err := applyMask(profile, req.Profile, req.UpdateMask, "display_name", "bio", "birth_date", "gender"); err != nil {
+			return nil, err
+		}
+	}
 
 	cmd := commands.UpdateProfile{
 		MemberID:    req.MemberId,
-		DisplayName: req.Profile.DisplayName,
-		Bio:         req.Profile.Bio,
-		BirthDate:   birthDate,
-		Gender:      protoGenderToString(req.Profile.Gender),
+		DisplayName: profile.DisplayName,
+		Bio:         profile.Bio,
+		BirthDate:   profile.BirthDate.AsTime(),
+		Gender:      protoGenderToString(profile.Gender),
 	}
 
 	if err := h.service.UpdateProfile(ctx, cmd); err != nil {
@@ -118,12 +120,226 @@ func (h *MemberHandler) UpdateProfile(ctx context.Context, req *memberv1.UpdateP
 	}, nil
 }
 
-// ActivateMember activates a pending member.
-func (h *MemberHandler) ActivateMember(ctx context.Context, req *memberv1.ActivateMemberRequest) (*memberv1.ActivateMemberResponse, error) {
-	if req.MemberId == "" {
-		return nil, status.Error(codes.InvalidArgument, "member_id is required")
+// ListInterests returns the interests catalogue in the requested locale.
+func (h *MemberHandler) ListInterests(ctx context.Context, req *memberv1.ListInterestsRequest) (*memberv1.ListInterestsResponse, error) {
+	locale := valueobject.ParseLocale(req.Locale)
+	catalogue := h.service.ListInterests()
+
+	interests := make([]*memberv1.Interest, len(catalogue))
+	for i, interest := range catalogue {
+		interests[i] = &memberv1.Interest{
+			Id:           string(interest.ID),
+			Category:     interest.Category,
+			CategoryName: interest.CategoryName(locale),
+			Name:         interest.Name(locale),
+		}
 	}
 
+	return &memberv1.ListInterestsResponse{
+		Interests:    interests,
+		MaxInterests: valueobject.MaxInterests,
+	}, nil
+}
+
+// SetInterests replaces the interests on a member's profile.
+func (h *MemberHandler) SetInterests(ctx context.Context, req *memberv1.SetInterestsRequest) (*memberv1.SetInterestsResponse, error) {
+	cmd := commands.SetInterests{
+		MemberID:    req.MemberId,
+		InterestIDs: req.InterestIds,
+	}
+
+	if err := h.service.SetInterests(ctx, cmd); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	member, err := h.service.GetMember(ctx, req.MemberId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &memberv1.SetInterestsResponse{
+		Member: toProtoMember(member),
+	}, nil
+}
+
+// ListPrompts returns the prompt catalogue in the requested locale.
+func (h *MemberHandler) ListPrompts(ctx context.Context, req *memberv1.ListPromptsRequest) (*memberv1.ListPromptsResponse, error) {
+	locale := valueobject.ParseLocale(req.Locale)
+	catalogue := h.service.ListPrompts()
+
+	prompts := make([]*memberv1.Prompt, len(catalogue))
+	for i, prompt := range catalogue {
+		prompts[i] = &memberv1.Prompt{
+			Id:       string(prompt.ID),
+			Question: prompt.Question(locale),
+		}
+	}
+
+	return &memberv1.ListPromptsResponse{
+		Prompts:    prompts,
+		MaxPrompts: valueobject.MaxPrompts,
+	}, nil
+}
+
+// AnswerPrompt adds or updates an answered prompt on a member's profile.
+func (h *MemberHandler) AnswerPrompt(ctx context.Context, req *memberv1.AnswerPromptRequest) (*memberv1.AnswerPromptResponse, error) {
+	cmd := commands.AnswerPrompt{
+		MemberID: req.MemberId,
+		PromptID: req.PromptId,
+		Answer:   req.Answer,
+	}
+
+	if err := h.service.AnswerPrompt(ctx, cmd); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	member, err := h.service.GetMember(ctx, req.MemberId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &memberv1.AnswerPromptResponse{
+		Member: toProtoMember(member),
+	}, nil
+}
+
+// RemovePrompt removes an answered prompt from a member's profile.
+func (h *MemberHandler) RemovePrompt(ctx context.Context, req *memberv1.RemovePromptRequest) (*memberv1.RemovePromptResponse, error) {
+	cmd := commands.RemovePrompt{
+		MemberID: req.MemberId,
+		PromptID: req.PromptId,
+	}
+
+	if err := h.service.RemovePrompt(ctx, cmd); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	member, err := h.service.GetMember(ctx, req.MemberId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &memberv1.RemovePromptResponse{
+		Member: toProtoMember(member),
+	}, nil
+}
+
+// ReorderPrompts sets the display order of a member's answered prompts.
+func (h *MemberHandler) ReorderPrompts(ctx context.Context, req *memberv1.ReorderPromptsRequest) (*memberv1.ReorderPromptsResponse, error) {
+	cmd := commands.ReorderPrompts{
+		MemberID:  req.MemberId,
+		PromptIDs: req.PromptIds,
+	}
+
+	if err := h.service.ReorderPrompts(ctx, cmd); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	member, err := h.service.GetMember(ctx, req.MemberId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &memberv1.ReorderPromptsResponse{
+		Member: toProtoMember(member),
+	}, nil
+}
+
+// GetPreferences retrieves a member's discovery preferences.
+func (h *MemberHandler) GetPreferences(ctx context.Context, req *memberv1.GetPreferencesRequest) (*memberv1.GetPreferencesResponse, error) {
+	member, err := h.service.GetMember(ctx, req.MemberId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &memberv1.GetPreferencesResponse{
+		Preferences: toProtoPreferences(member.Preferences()),
+	}, nil
+}
+
+// UpdatePreferences replaces a member's discovery preferences, or only
+// those named in update_mask.
+func (h *MemberHandler) UpdatePreferences(ctx context.Context, req *memberv1.UpdatePreferencesRequest) (*memberv1.UpdatePreferencesResponse, error) {
+	preferences := req.Preferences
+	if len(req.GetUpdateMask().GetPaths()) > 0 {
+		member, err := h.service.GetMember(ctx, req.MemberId)
+		if err != nil {
+			return nil, toGRPCError(err)
+		}
+		preferences = toProtoPreferences(member.Preferences())
+		if err := applyMask(preferences, req.Preferences, req.UpdateMask,
+			"min_age", "max_age", "genders", "max_distance_km", "dealbreakers"); err != nil {
+			return nil, err
+		}
+	}
+
+	genders := make([]string, len(preferences.Genders))
+	for i, g := range preferences.Genders {
+		genders[i] = protoGenderToString(g)
+	}
+	dealbreakers := make([]string, len(preferences.Dealbreakers))
+	for i, d := range preferences.Dealbreakers {
+		dealbreakers[i] = protoDealbreakerToString(d)
+	}
+
+	cmd := commands.UpdatePreferences{
+		MemberID:      req.MemberId,
+		MinAge:        int(preferences.MinAge),
+		MaxAge:        int(preferences.MaxAge),
+		Genders:       genders,
+		MaxDistanceKm: int(preferences.MaxDistanceKm),
+		Dealbreakers:  dealbreakers,
+	}
+
+	if err := h.service.UpdatePreferences(ctx, cmd); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	member, err := h.service.GetMember(ctx, req.MemberId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &memberv1.UpdatePreferencesResponse{
+		Preferences: toProtoPreferences(member.Preferences()),
+	}, nil
+}
+
+// SendEmailVerification sends the member a new email verification link.
+func (h *MemberHandler) SendEmailVerification(ctx context.Context, req *memberv1.SendEmailVerificationRequest) (*memberv1.SendEmailVerificationResponse, error) {
+	cmd := commands.SendEmailVerification{
+		MemberID: req.MemberId,
+	}
+
+	if err := h.service.SendEmailVerification(ctx, cmd); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &memberv1.SendEmailVerificationResponse{}, nil
+}
+
+// VerifyEmail confirms a member's email address with the token from a
+// verification link.
+func (h *MemberHandler) VerifyEmail(ctx context.Context, req *memberv1.VerifyEmailRequest) (*memberv1.VerifyEmailResponse, error) {
+	cmd := commands.VerifyEmail{
+		Token: req.Token,
+	}
+
+	member, err := h.service.VerifyEmail(ctx, cmd)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &memberv1.VerifyEmailResponse{
+		Member: toProtoMember(member),
+	}, nil
+}
+
+// ActivateMember activates a member whose onboarding is complete, and
+// fails with FailedPrecondition otherwise. Members who finish onboarding
+// are activated automatically; suspended members are reinstated through
+// the admin service.
+func (h *MemberHandler) ActivateMember(ctx context.Context, req *memberv1.ActivateMemberRequest) (*memberv1.ActivateMemberResponse, error) {
 	cmd := commands.ActivateMember{
 		MemberID: req.MemberId,
 	}
@@ -142,6 +358,185 @@ func (h *MemberHandler) ActivateMember(ctx context.Context, req *memberv1.Activa
 	}, nil
 }
 
+// AddPhoto attaches an already processed photo to a member's profile.
+func (h *MemberHandler) AddPhoto(ctx context.Context, req *memberv1.AddPhotoRequest) (*memberv1.AddPhotoResponse, error) {
+	cmd := commands.AddPhoto{
+		MemberID: req.MemberId,
+		PhotoID:  req.PhotoId,
+		URL:      req.PhotoUrl,
+	}
+
+	if err := h.service.AddPhoto(ctx, cmd); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	member, err := h.service.GetMember(ctx, req.MemberId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &memberv1.AddPhotoResponse{
+		Member: toProtoMember(member),
+	}, nil
+}
+
+// RemovePhoto detaches a photo from a member's profile.
+func (h *MemberHandler) RemovePhoto(ctx context.Context, req *memberv1.RemovePhotoRequest) (*memberv1.RemovePhotoResponse, error) {
+	cmd := commands.RemovePhoto{
+		MemberID: req.MemberId,
+		PhotoID:  req.PhotoId,
+		URL:      req.PhotoUrl,
+	}
+
+	if err := h.service.RemovePhoto(ctx, cmd); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	member, err := h.service.GetMember(ctx, req.MemberId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &memberv1.RemovePhotoResponse{
+		Member: toProtoMember(member),
+	}, nil
+}
+
+// ReorderPhotos sets the display order of a member's photos.
+func (h *MemberHandler) ReorderPhotos(ctx context.Context, req *memberv1.ReorderPhotosRequest) (*memberv1.ReorderPhotosResponse, error) {
+	cmd := commands.ReorderPhotos{
+		MemberID: req.MemberId,
+		URLs:     req.PhotoUrls,
+	}
+
+	if err := h.service.ReorderPhotos(ctx, cmd); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	member, err := h.service.GetMember(ctx, req.MemberId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &memberv1.ReorderPhotosResponse{
+		Member: toProtoMember(member),
+	}, nil
+}
+
+// SetPrimaryPhoto makes the given photo the first one shown on the profile.
+func (h *MemberHandler) SetPrimaryPhoto(ctx context.Context, req *memberv1.SetPrimaryPhotoRequest) (*memberv1.SetPrimaryPhotoResponse, error) {
+	cmd := commands.SetPrimaryPhoto{
+		MemberID: req.MemberId,
+		URL:      req.PhotoUrl,
+	}
+
+	if err := h.service.SetPrimaryPhoto(ctx, cmd); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	member, err := h.service.GetMember(ctx, req.MemberId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &memberv1.SetPrimaryPhotoResponse{
+		Member: toProtoMember(member),
+	}, nil
+}
+
+// SuspendMember suspends a member, e.g. as the outcome of a moderation case.
+func (h *MemberHandler) SuspendMember(ctx context.Context, req *memberv1.SuspendMemberRequest) (*memberv1.SuspendMemberResponse, error) {
+	cmd := commands.SuspendMember{
+		MemberID: req.MemberId,
+		Reason:   req.Reason,
+	}
+
+	if err := h.service.SuspendMember(ctx, cmd); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	member, err := h.service.GetMember(ctx, req.MemberId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &memberv1.SuspendMemberResponse{
+		Member: toProtoMember(member),
+	}, nil
+}
+
+// ListSessionRevocations lists the members signed out everywhere since the
+// given time.
+func (h *MemberHandler) ListSessionRevocations(ctx context.Context, req *memberv1.ListSessionRevocationsRequest) (*memberv1.ListSessionRevocationsResponse, error) {
+	revocations, err := h.service.ListSessionRevocations(ctx, req.Since.AsTime())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &memberv1.ListSessionRevocationsResponse{
+		Revocations: make([]*memberv1.SessionRevocation, len(revocations)),
+	}
+	for i, rev := range revocations {
+		resp.Revocations[i] = &memberv1.SessionRevocation{
+			MemberId:  rev.MemberID,
+			RevokedAt: timestamppb.New(rev.RevokedAt),
+		}
+	}
+	return resp, nil
+}
+
+// BlockMember blocks another member.
+func (h *MemberHandler) BlockMember(ctx context.Context, req *memberv1.BlockMemberRequest) (*memberv1.BlockMemberResponse, error) {
+	cmd := commands.BlockMember{
+		MemberID:        req.MemberId,
+		BlockedMemberID: req.BlockedMemberId,
+	}
+
+	if err := h.service.BlockMember(ctx, cmd); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &memberv1.BlockMemberResponse{}, nil
+}
+
+// UnblockMember lifts a block.
+func (h *MemberHandler) UnblockMember(ctx context.Context, req *memberv1.UnblockMemberRequest) (*memberv1.UnblockMemberResponse, error) {
+	cmd := commands.UnblockMember{
+		MemberID:        req.MemberId,
+		BlockedMemberID: req.BlockedMemberId,
+	}
+
+	if err := h.service.UnblockMember(ctx, cmd); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &memberv1.UnblockMemberResponse{}, nil
+}
+
+// ListBlockedMembers lists the members a member has blocked.
+func (h *MemberHandler) ListBlockedMembers(ctx context.Context, req *memberv1.ListBlockedMembersRequest) (*memberv1.ListBlockedMembersResponse, error) {
+	member, err := h.service.GetMember(ctx, req.MemberId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &memberv1.ListBlockedMembersResponse{
+		BlockedMemberIds: member.BlockedMembers(),
+	}, nil
+}
+
+// CheckBlocked reports whether either member has blocked the other.
+func (h *MemberHandler) CheckBlocked(ctx context.Context, req *memberv1.CheckBlockedRequest) (*memberv1.CheckBlockedResponse, error) {
+	blocked, err := h.service.IsBlocked(ctx, req.MemberId, req.OtherMemberId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &memberv1.CheckBlockedResponse{
+		Blocked: blocked,
+	}, nil
+}
+
 // toProtoMember converts a domain member to a protobuf member.
 func toProtoMember(m *aggregate.Member) *memberv1.Member {
 	profile := m.Profile()
@@ -151,18 +546,91 @@ func toProtoMember(m *aggregate.Member) *memberv1.Member {
 		photoURLs[i] = string(p)
 	}
 
+	interests := make([]string, len(profile.Interests))
+	for i, id := range profile.Interests {
+		interests[i] = string(id)
+	}
+
+	prompts := make([]*memberv1.ProfilePrompt, len(m.Prompts()))
+	for i, p := range m.Prompts() {
+		prompt, _ := valueobject.LookupPrompt(p.PromptID)
+		prompts[i] = &memberv1.ProfilePrompt{
+			PromptId: string(p.PromptID),
+			Question: prompt.Question(valueobject.DefaultLocale),
+			Answer:   p.Answer,
+		}
+	}
+
 	return &memberv1.Member{
 		Id:     m.ID(),
 		Email:  m.Email().String(),
 		Status: toProtoStatus(m.Status()),
 		Profile: &memberv1.Profile{
-			DisplayName: profile.DisplayName,
-			Bio:         profile.Bio,
-			BirthDate:   timestamppb.New(profile.BirthDate),
-			Gender:      toProtoGender(string(profile.Gender)),
-			Interests:   profile.Interests,
-			PhotoUrls:   photoURLs,
+			DisplayName:     profile.DisplayName,
+			Bio:             profile.Bio,
+			BirthDate:       timestamppb.New(profile.BirthDate),
+			Gender:          toProtoGender(string(profile.Gender)),
+			Interests:       interests,
+			PhotoUrls:       photoURLs,
+			PrimaryPhotoUrl: string(profile.PrimaryPhoto()),
+			Prompts:         prompts,
 		},
+		EmailVerified: m.EmailVerified(),
+		Onboarding:    toProtoOnboarding(m.Onboarding()),
+	}
+}
+
+// toProtoOnboarding converts domain onboarding progress to protobuf.
+func toProtoOnboarding(o aggregate.Onboarding) *memberv1.Onboarding {
+	completed := make([]memberv1.OnboardingStep, len(o.CompletedSteps))
+	for i, step := range o.CompletedSteps {
+		completed[i] = toProtoOnboardingStep(step)
+	}
+
+	return &memberv1.Onboarding{
+		NextStep:       toProtoOnboardingStep(o.NextStep),
+		CompletedSteps: completed,
+		Completeness:   int32(o.Completeness),
+	}
+}
+
+// toProtoOnboardingStep converts a domain onboarding step to protobuf.
+func toProtoOnboardingStep(step aggregate.OnboardingStep) memberv1.OnboardingStep {
+	switch step {
+	case aggregate.OnboardingStepProfile:
+		return memberv1.OnboardingStep_ONBOARDING_STEP_PROFILE
+	case aggregate.OnboardingStepPhotos:
+		return memberv1.OnboardingStep_ONBOARDING_STEP_PHOTOS
+	case aggregate.OnboardingStepPrompts:
+		return memberv1.OnboardingStep_ONBOARDING_STEP_PROMPTS
+	case aggregate.OnboardingStepPreferences:
+		return memberv1.OnboardingStep_ONBOARDING_STEP_PREFERENCES
+	case aggregate.OnboardingStepVerifyEmail:
+		return memberv1.OnboardingStep_ONBOARDING_STEP_VERIFY_EMAIL
+	case aggregate.OnboardingStepComplete:
+		return memberv1.OnboardingStep_ONBOARDING_STEP_COMPLETE
+	default:
+		return memberv1.OnboardingStep_ONBOARDING_STEP_UNSPECIFIED
+	}
+}
+
+// toProtoPreferences converts domain preferences to protobuf preferences.
+func toProtoPreferences(p valueobject.Preferences) *memberv1.Preferences {
+	genders := make([]memberv1.Gender, len(p.Genders))
+	for i, g := range p.Genders {
+		genders[i] = toProtoGender(string(g))
+	}
+	dealbreakers := make([]memberv1.Dealbreaker, len(p.Dealbreakers))
+	for i, d := range p.Dealbreakers {
+		dealbreakers[i] = toProtoDealbreaker(string(d))
+	}
+
+	return &memberv1.Preferences{
+		MinAge:        int32(p.MinAge),
+		MaxAge:        int32(p.MaxAge),
+		Genders:       genders,
+		MaxDistanceKm: int32(p.MaxDistanceKm),
+		Dealbreakers:  dealbreakers,
 	}
 }
 
@@ -208,6 +676,34 @@ func protoGenderToString(g memberv1.Gender) string {
 	}
 }
 
+// toProtoDealbreaker converts a domain dealbreaker to protobuf.
+func toProtoDealbreaker(d string) memberv1.Dealbreaker {
+	switch d {
+	case "age":
+		return memberv1.Dealbreaker_DEALBREAKER_AGE
+	case "gender":
+		return memberv1.Dealbreaker_DEALBREAKER_GENDER
+	case "distance":
+		return memberv1.Dealbreaker_DEALBREAKER_DISTANCE
+	default:
+		return memberv1.Dealbreaker_DEALBREAKER_UNSPECIFIED
+	}
+}
+
+// protoDealbreakerToString converts a protobuf dealbreaker to string.
+func protoDealbreakerToString(d memberv1.Dealbreaker) string {
+	switch d {
+	case memberv1.Dealbreaker_DEALBREAKER_AGE:
+		return "age"
+	case memberv1.Dealbreaker_DEALBREAKER_GENDER:
+		return "gender"
+	case memberv1.Dealbreaker_DEALBREAKER_DISTANCE:
+		return "distance"
+	default:
+		return ""
+	}
+}
+
 // toGRPCError converts domain errors to gRPC status errors.
 func toGRPCError(err error) error {
 	switch err {
@@ -219,6 +715,50 @@ func toGRPCError(err error) error {
 		return status.Error(codes.AlreadyExists, err.Error())
 	case application.ErrInvalidCredentials:
 		return status.Error(codes.Unauthenticated, err.Error())
+	case aggregate.ErrPhotoNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case aggregate.ErrInvalidPhotoOrder:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case aggregate.ErrCannotBlockSelf:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case aggregate.ErrMemberSuspended:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case aggregate.ErrProfileIncomplete,
+		aggregate.ErrMemberNotSuspended,
+		application.ErrEmailAlreadyVerified:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case application.ErrInvalidVerificationToken,
+		application.ErrInvalidPageToken,
+		application.ErrUnknownRole:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case valueobject.ErrTooManyPhotos:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case valueobject.ErrDisplayNameTooShort,
+		valueobject.ErrDisplayNameTooLong,
+		valueobject.ErrBioTooLong,
+		valueobject.ErrInvalidBirthDate,
+		valueobject.ErrTooYoung:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case valueobject.ErrUnknownPrompt,
+		valueobject.ErrAnswerRequired,
+		valueobject.ErrAnswerTooLong,
+		valueobject.ErrInvalidPromptOrder,
+		application.ErrContentRejected:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case valueobject.ErrPromptNotAnswered:
+		return status.Error(codes.NotFound, err.Error())
+	case valueobject.ErrTooManyPrompts,
+		valueobject.ErrLastPrompt:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case valueobject.ErrUnknownInterest,
+		valueobject.ErrTooManyInterests:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case valueobject.ErrInvalidAgeRange,
+		valueobject.ErrNoGenderInterest,
+		valueobject.ErrInvalidGender,
+		valueobject.ErrInvalidMaxDistance,
+		valueobject.ErrInvalidDealbreaker:
+		return status.Error(codes.InvalidArgument, err.Error())
 	default:
 		return status.Error(codes.Internal, err.Error())
 	}
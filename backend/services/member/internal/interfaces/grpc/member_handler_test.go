@@ -0,0 +1,42 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	memberv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/member/v1"
+)
+
+// An unset birth date must not reach the service, which would store the
+// Unix epoch in its place.
+func TestUpdateProfileRequiresBirthDate(t *testing.T) {
+	h := NewMemberHandler(nil)
+	const memberID = "11111111-2222-3333-4444-555555555555"
+
+	tests := map[string]*memberv1.UpdateProfileRequest{
+		"cleared through the mask": {
+			MemberId:   memberID,
+			Profile:    &memberv1.Profile{DisplayName: "Sam"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name", "birth_date"}},
+		},
+		"omitted without a mask": {
+			MemberId: memberID,
+			Profile:  &memberv1.Profile{DisplayName: "Sam"},
+		},
+		"no profile": {
+			MemberId: memberID,
+		},
+	}
+	for name, req := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := h.UpdateProfile(context.Background(), req)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("UpdateProfile = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	reflectionv1 "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionv1alpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+
+	experimentsv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/experiments/v1"
+	featureflagsv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/featureflags/v1"
+	memberv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/member/v1"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/auth"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/interceptors"
+)
+
+// Services that call the member service, as named in SERVICE_TOKENS.
+// Admin is used by operators, for example through grpcurl.
+const (
+	CallerGateway    = "gateway"
+	CallerModeration = "moderation"
+	CallerAdmin      = "admin"
+)
+
+// Policies says which services may call each method. Calls the gateway
+// makes for a signed-in member may only act on that member, except for
+// staff calling MemberAdminService, who need one of the listed roles. The
+// gateway must forward the member's access token for both; only operators
+// may make such calls without one.
+var Policies = interceptors.Policies{
+	// Load balancers and probes check health without credentials
+	healthpb.Health_Check_FullMethodName: {Public: true},
+	healthpb.Health_Watch_FullMethodName: {Public: true},
+
+	// Operators list the API with grpcurl
+	reflectionv1.ServerReflection_ServerReflectionInfo_FullMethodName:      admin(),
+	reflectionv1alpha.ServerReflection_ServerReflectionInfo_FullMethodName: admin(),
+
+	// Called before a member is signed in
+	memberv1.MemberService_RegisterMember_FullMethodName:     gateway(""),
+	memberv1.MemberService_AuthenticateMember_FullMethodName: gateway(""),
+	memberv1.MemberService_VerifyEmail_FullMethodName:        gateway(""),
+	memberv1.MemberService_ListInterests_FullMethodName:      gateway(""),
+	memberv1.MemberService_ListPrompts_FullMethodName:        gateway(""),
+
+	memberv1.MemberService_GetMember_FullMethodName:             {Callers: []string{CallerGateway, CallerAdmin}, Subject: "member_id", Operators: []string{CallerAdmin}},
+	memberv1.MemberService_UpdateProfile_FullMethodName:         gateway("member_id"),
+	memberv1.MemberService_SetInterests_FullMethodName:          gateway("member_id"),
+	memberv1.MemberService_AnswerPrompt_FullMethodName:          gateway("member_id"),
+	memberv1.MemberService_RemovePrompt_FullMethodName:          gateway("member_id"),
+	memberv1.MemberService_ReorderPrompts_FullMethodName:        gateway("member_id"),
+	memberv1.MemberService_GetPreferences_FullMethodName:        gateway("member_id"),
+	memberv1.MemberService_UpdatePreferences_FullMethodName:     gateway("member_id"),
+	memberv1.MemberService_SendEmailVerification_FullMethodName: gateway("member_id"),
+	memberv1.MemberService_ReorderPhotos_FullMethodName:         gateway("member_id"),
+	memberv1.MemberService_SetPrimaryPhoto_FullMethodName:       gateway("member_id"),
+	memberv1.MemberService_BlockMember_FullMethodName:           gateway("member_id"),
+	memberv1.MemberService_UnblockMember_FullMethodName:         gateway("member_id"),
+	memberv1.MemberService_ListBlockedMembers_FullMethodName:    gateway("member_id"),
+	memberv1.MemberService_CheckBlocked_FullMethodName:          gateway("member_id"),
+
+	// The gateway rejects tokens issued before a member was signed out
+	memberv1.MemberService_ListSessionRevocations_FullMethodName: gateway(""),
+
+	// Photos arrive through media service events; activation follows
+	// onboarding. Both remain callable by operators.
+	memberv1.MemberService_AddPhoto_FullMethodName:       admin(),
+	memberv1.MemberService_RemovePhoto_FullMethodName:    admin(),
+	memberv1.MemberService_ActivateMember_FullMethodName: admin(),
+	memberv1.MemberService_SuspendMember_FullMethodName:  {Callers: []string{CallerModeration, CallerAdmin}},
+
+	// Support staff look members up; only admins change them
+	memberv1.MemberAdminService_GetMemberHistory_FullMethodName: staff(auth.RoleSupport, auth.RoleAdmin),
+	memberv1.MemberAdminService_GetMemberStateAt_FullMethodName: staff(auth.RoleSupport, auth.RoleAdmin),
+	memberv1.MemberAdminService_SearchMembers_FullMethodName:    staff(auth.RoleSupport, auth.RoleAdmin),
+	memberv1.MemberAdminService_SuspendMember_FullMethodName:    staff(auth.RoleAdmin),
+	memberv1.MemberAdminService_ReinstateMember_FullMethodName:  staff(auth.RoleAdmin),
+	memberv1.MemberAdminService_RevokeSessions_FullMethodName:   staff(auth.RoleAdmin),
+	memberv1.MemberAdminService_SetMemberRoles_FullMethodName:   staff(auth.RoleAdmin),
+	memberv1.MemberAdminService_ListAuditLog_FullMethodName:     staff(auth.RoleAdmin),
+
+	// Every service may read the flags it evaluates; only operators
+	// change them
+	featureflagsv1.FeatureFlagService_ListFlags_FullMethodName:  {Callers: []string{CallerGateway, CallerModeration, CallerAdmin}},
+	featureflagsv1.FeatureFlagService_SetFlag_FullMethodName:    admin(),
+	featureflagsv1.FeatureFlagService_DeleteFlag_FullMethodName: admin(),
+
+	// Likewise for experiments, which the gateway assigns members to
+	experimentsv1.ExperimentService_ListExperiments_FullMethodName:  {Callers: []string{CallerGateway, CallerModeration, CallerAdmin}},
+	experimentsv1.ExperimentService_SetExperiment_FullMethodName:    admin(),
+	experimentsv1.ExperimentService_DeleteExperiment_FullMethodName: admin(),
+}
+
+func gateway(subject string) interceptors.Policy {
+	return interceptors.Policy{Callers: []string{CallerGateway}, Subject: subject}
+}
+
+func admin() interceptors.Policy {
+	return interceptors.Policy{Callers: []string{CallerAdmin}}
+}
+
+// staff allows operators, and the gateway on behalf of signed-in members
+// with one of roles.
+func staff(roles ...string) interceptors.Policy {
+	return interceptors.Policy{Callers: []string{CallerGateway, CallerAdmin}, Roles: roles, Operators: []string{CallerAdmin}}
+}
@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"slices"
+	"testing"
+
+	memberv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/member/v1"
+)
+
+// TestAdminPoliciesNeedStaffRoles fails when a MemberAdminService method
+// could be called without a staff role by anyone but an operator.
+func TestAdminPoliciesNeedStaffRoles(t *testing.T) {
+	service := memberv1.MemberAdminService_ServiceDesc
+	for _, method := range service.Methods {
+		fullMethod := "/" + service.ServiceName + "/" + method.MethodName
+		policy, ok := Policies[fullMethod]
+		if !ok {
+			t.Errorf("%s has no policy", fullMethod)
+			continue
+		}
+		if policy.Public || len(policy.Roles) == 0 {
+			t.Errorf("%s can be called without a staff role", fullMethod)
+		}
+		for _, operator := range policy.Operators {
+			if operator != CallerAdmin {
+				t.Errorf("%s trusts %s to call it without a signed-in member", fullMethod, operator)
+			}
+		}
+		if slices.Contains(policy.Callers, CallerModeration) {
+			t.Errorf("%s may be called by the moderation service", fullMethod)
+		}
+	}
+}
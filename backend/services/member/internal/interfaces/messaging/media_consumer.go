@@ -0,0 +1,100 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/application"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/aggregate"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/commands"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/member/internal/domain/valueobject"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/logging"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/messaging"
+)
+
+const (
+	topicPhotoUploaded = "media.photo_uploaded"
+	topicPhotoDeleted  = "media.photo_deleted"
+)
+
+// photoPayload is the payload of the media service's photo events.
+type photoPayload struct {
+	PhotoID  string
+	MemberID string
+	URL      string
+}
+
+// MediaConsumer keeps member profiles in sync with photos processed by the
+// media service.
+type MediaConsumer struct {
+	service *application.MemberService
+}
+
+// NewMediaConsumer creates a consumer for media service events.
+func NewMediaConsumer(service *application.MemberService) *MediaConsumer {
+	return &MediaConsumer{service: service}
+}
+
+// Start subscribes to the media topics until ctx is cancelled.
+func (c *MediaConsumer) Start(ctx context.Context, subscriber messaging.Subscriber) error {
+	if err := subscriber.Subscribe(ctx, topicPhotoUploaded, c.handlePhotoUploaded); err != nil {
+		return err
+	}
+	return subscriber.Subscribe(ctx, topicPhotoDeleted, c.handlePhotoDeleted)
+}
+
+func (c *MediaConsumer) handlePhotoUploaded(ctx context.Context, message messaging.Message) error {
+	payload, err := decodePhotoPayload(message)
+	if err != nil {
+		return err
+	}
+
+	err = c.service.AddPhoto(ctx, commands.AddPhoto{
+		MemberID: payload.MemberID,
+		PhotoID:  payload.PhotoID,
+		URL:      payload.URL,
+	})
+	if errors.Is(err, valueobject.ErrTooManyPhotos) {
+		logging.FromContext(ctx).Info("not adding photo: member has the maximum number of photos",
+			"photo_id", payload.PhotoID, "member_id", payload.MemberID, "max_photos", valueobject.MaxPhotos)
+		return nil
+	}
+	return ignoreUnknownMember(ctx, err, payload)
+}
+
+func (c *MediaConsumer) handlePhotoDeleted(ctx context.Context, message messaging.Message) error {
+	payload, err := decodePhotoPayload(message)
+	if err != nil {
+		return err
+	}
+
+	err = c.service.RemovePhoto(ctx, commands.RemovePhoto{
+		MemberID: payload.MemberID,
+		PhotoID:  payload.PhotoID,
+		URL:      payload.URL,
+	})
+	return ignoreUnknownMember(ctx, err, payload)
+}
+
+func decodePhotoPayload(message messaging.Message) (photoPayload, error) {
+	var payload photoPayload
+	if err := json.Unmarshal(message.Payload, &payload); err != nil {
+		return photoPayload{}, fmt.Errorf("decode %s payload: %w", message.Type, err)
+	}
+	if payload.MemberID == "" || payload.URL == "" {
+		return photoPayload{}, fmt.Errorf("%s payload is missing member or URL", message.Type)
+	}
+	return payload, nil
+}
+
+// ignoreUnknownMember drops events for members that no longer exist rather
+// than report them as failures.
+func ignoreUnknownMember(ctx context.Context, err error, payload photoPayload) error {
+	if errors.Is(err, aggregate.ErrMemberNotFound) {
+		logging.FromContext(ctx).Info("ignoring photo for unknown member", "photo_id", payload.PhotoID, "member_id", payload.MemberID)
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_member_blocks_blocked;
+DROP TABLE IF EXISTS member_blocks;
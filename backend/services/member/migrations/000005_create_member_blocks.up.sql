@@ -0,0 +1,11 @@
+-- Blocks read model, projected from member.blocked / member.unblocked events
+CREATE TABLE IF NOT EXISTS member_blocks (
+    member_id VARCHAR(36) NOT NULL,
+    blocked_member_id VARCHAR(36) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (member_id, blocked_member_id)
+);
+
+-- Index for checking blocks in the reverse direction
+CREATE INDEX idx_member_blocks_blocked ON member_blocks(blocked_member_id);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_members_pref_genders;
+
+ALTER TABLE members
+    DROP COLUMN IF EXISTS pref_min_age,
+    DROP COLUMN IF EXISTS pref_max_age,
+    DROP COLUMN IF EXISTS pref_genders,
+    DROP COLUMN IF EXISTS pref_max_distance_km,
+    DROP COLUMN IF EXISTS pref_dealbreakers;
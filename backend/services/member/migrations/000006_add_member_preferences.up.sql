@@ -0,0 +1,12 @@
+-- Discovery preferences on the members read model. Existing members get the
+-- defaults the aggregate applies until they set their own.
+ALTER TABLE members
+    ADD COLUMN pref_min_age INT NOT NULL DEFAULT 18,
+    ADD COLUMN pref_max_age INT NOT NULL DEFAULT 99,
+    ADD COLUMN pref_genders TEXT[] NOT NULL DEFAULT '{male,female,other}',
+    ADD COLUMN pref_max_distance_km INT NOT NULL DEFAULT 50,
+    ADD COLUMN pref_dealbreakers TEXT[] NOT NULL DEFAULT '{}';
+
+-- Index for mutual-interest filtering ("candidates interested in my gender")
+CREATE INDEX idx_members_pref_genders ON members USING GIN (pref_genders)
+    WHERE status = 'active';
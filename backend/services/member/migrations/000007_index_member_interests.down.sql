@@ -0,0 +1,2 @@
+DROP FUNCTION IF EXISTS shared_interest_count(TEXT[], TEXT[]);
+DROP INDEX IF EXISTS idx_members_interests;
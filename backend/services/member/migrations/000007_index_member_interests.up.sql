@@ -0,0 +1,12 @@
+-- Interests now hold IDs from the member service's interests catalogue.
+-- The GIN index serves overlap filters (interests && $1) in discovery, and
+-- shared_interest_count ranks candidates by how many interests they share.
+CREATE INDEX idx_members_interests ON members USING GIN (interests)
+    WHERE status = 'active';
+
+CREATE OR REPLACE FUNCTION shared_interest_count(a TEXT[], b TEXT[])
+RETURNS INT
+LANGUAGE SQL IMMUTABLE PARALLEL SAFE
+AS $$
+    SELECT COUNT(*)::INT FROM (SELECT unnest(a) INTERSECT SELECT unnest(b)) AS shared
+$$;
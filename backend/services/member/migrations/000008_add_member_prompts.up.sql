@@ -0,0 +1,2 @@
+-- Answered profile prompts in display order: [{"prompt_id": ..., "answer": ...}]
+ALTER TABLE members ADD COLUMN prompts JSONB NOT NULL DEFAULT '[]';
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_members_onboarding_step;
+
+ALTER TABLE members
+    DROP COLUMN IF EXISTS completeness,
+    DROP COLUMN IF EXISTS onboarding_step,
+    DROP COLUMN IF EXISTS email_verified;
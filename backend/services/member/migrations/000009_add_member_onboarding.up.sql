@@ -0,0 +1,10 @@
+-- Onboarding progress on the members read model, for discovery filters and
+-- the onboarding funnel. Existing members start unverified; active members
+-- stay active.
+ALTER TABLE members
+    ADD COLUMN email_verified BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN onboarding_step TEXT NOT NULL DEFAULT 'profile',
+    ADD COLUMN completeness SMALLINT NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_members_onboarding_step ON members (onboarding_step)
+    WHERE status = 'pending';
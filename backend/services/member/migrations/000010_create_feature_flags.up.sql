@@ -0,0 +1,10 @@
+-- Feature flag definitions, served to other services by FeatureFlagService
+CREATE TABLE IF NOT EXISTS feature_flags (
+    key VARCHAR(64) PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    rollout_percent SMALLINT NOT NULL DEFAULT 0 CHECK (rollout_percent BETWEEN 0 AND 100),
+    -- Targeting rules: [{"attribute": "country", "operator": "in", "values": ["NL"]}]
+    rules JSONB NOT NULL DEFAULT '[]',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,33 @@
+-- Experiment definitions, served to other services by ExperimentService
+CREATE TABLE IF NOT EXISTS experiments (
+    key VARCHAR(64) PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    status VARCHAR(16) NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'running', 'stopped')),
+    allocation_percent SMALLINT NOT NULL DEFAULT 0 CHECK (allocation_percent BETWEEN 0 AND 100),
+    -- Variants, control first: [{"name": "control", "weight": 50}, ...]
+    variants JSONB NOT NULL DEFAULT '[]',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- The first exposure of each member to an experiment, recorded from
+-- experiments.exposure events
+CREATE TABLE IF NOT EXISTS experiment_exposures (
+    experiment VARCHAR(64) NOT NULL,
+    member_id VARCHAR(36) NOT NULL,
+    variant VARCHAR(64) NOT NULL,
+    exposed_at TIMESTAMPTZ NOT NULL,
+
+    PRIMARY KEY (experiment, member_id)
+);
+
+-- Matches per member, recorded from matching.match_created events as the
+-- outcome experiments are measured by
+CREATE TABLE IF NOT EXISTS experiment_matches (
+    match_id VARCHAR(36) NOT NULL,
+    member_id VARCHAR(36) NOT NULL,
+    matched_at TIMESTAMPTZ NOT NULL,
+
+    PRIMARY KEY (match_id, member_id)
+);
+
+CREATE INDEX idx_experiment_matches_member ON experiment_matches(member_id, matched_at);
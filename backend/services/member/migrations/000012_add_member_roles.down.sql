@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_members_sessions_revoked_at;
+ALTER TABLE members DROP COLUMN IF EXISTS sessions_revoked_at;
+DROP TABLE IF EXISTS member_roles;
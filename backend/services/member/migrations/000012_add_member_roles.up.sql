@@ -0,0 +1,18 @@
+-- Staff roles, granted through MemberAdminService.SetMemberRoles. Members
+-- without a row have no role.
+CREATE TABLE IF NOT EXISTS member_roles (
+    member_id VARCHAR(36) NOT NULL,
+    role VARCHAR(32) NOT NULL,
+    granted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- Who granted the role; empty when granted by an operator outside the app
+    granted_by VARCHAR(36) NOT NULL DEFAULT '',
+
+    PRIMARY KEY (member_id, role)
+);
+
+-- When the member was last signed out everywhere, projected from
+-- member.sessions_revoked events. Tokens issued before it are rejected.
+ALTER TABLE members ADD COLUMN IF NOT EXISTS sessions_revoked_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_members_sessions_revoked_at ON members(sessions_revoked_at)
+    WHERE sessions_revoked_at IS NOT NULL;
@@ -0,0 +1,34 @@
+-- Every call to MemberAdminService and every call by an operator, recorded
+-- by the member service. Entries are never changed or removed.
+CREATE TABLE IF NOT EXISTS admin_audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- The signed-in member acting, empty for operator calls
+    actor_id VARCHAR(36) NOT NULL DEFAULT '',
+    -- The calling service, e.g. gateway or admin
+    caller VARCHAR(64) NOT NULL,
+    method VARCHAR(255) NOT NULL,
+    -- The member acted on, when the request names one
+    member_id VARCHAR(36) NOT NULL DEFAULT '',
+    request JSONB NOT NULL DEFAULT '{}',
+    -- gRPC status code of the call, e.g. OK or PermissionDenied
+    code VARCHAR(32) NOT NULL,
+    request_id VARCHAR(128) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_admin_audit_log_member ON admin_audit_log(member_id, id);
+CREATE INDEX idx_admin_audit_log_actor ON admin_audit_log(actor_id, id);
+
+CREATE OR REPLACE FUNCTION admin_audit_log_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'admin_audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER admin_audit_log_no_update_delete
+    BEFORE UPDATE OR DELETE ON admin_audit_log
+    FOR EACH ROW EXECUTE FUNCTION admin_audit_log_append_only();
+
+CREATE TRIGGER admin_audit_log_no_truncate
+    BEFORE TRUNCATE ON admin_audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION admin_audit_log_append_only();
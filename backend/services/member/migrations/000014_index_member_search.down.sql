@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_members_display_name_id;
+DROP INDEX IF EXISTS idx_members_email_id;
+DROP INDEX IF EXISTS idx_members_created_at_id;
+DROP INDEX IF EXISTS idx_members_display_name_trgm;
+DROP INDEX IF EXISTS idx_members_email_trgm;
+-- pg_trgm is left installed, since other objects may have come to use it
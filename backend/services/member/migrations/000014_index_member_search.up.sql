@@ -0,0 +1,12 @@
+-- Indexes for MemberAdminService.SearchMembers. Trigram indexes serve
+-- partial, case-insensitive matches (ILIKE '%text%') on email address and
+-- display name; the btree indexes serve each sort order and its keyset
+-- pagination, with the member ID breaking ties.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_members_email_trgm ON members USING GIN (email gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_members_display_name_trgm ON members USING GIN (display_name gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS idx_members_created_at_id ON members(created_at, id);
+CREATE INDEX IF NOT EXISTS idx_members_email_id ON members(email, id);
+CREATE INDEX IF NOT EXISTS idx_members_display_name_id ON members((COALESCE(display_name, '')), id);
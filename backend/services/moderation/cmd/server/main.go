@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	_ "github.com/lib/pq"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	memberv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/member/v1"
+	moderationv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/moderation/v1"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/moderation/internal/application"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/moderation/internal/config"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/moderation/internal/infrastructure/memberclient"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/moderation/internal/infrastructure/persistence"
+	grpchandler "github.com/mattuttis/inetcontrol/zoekdeware/backend/services/moderation/internal/interfaces/grpc"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/correlation"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/grpcclient"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/interceptors"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/logging"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/messaging"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/mtls"
+)
+
+func main() {
+	cfg := config.Load()
+	logging.Setup("moderation")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Database connection
+	db, err := sql.Open("postgres", cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.PingContext(ctx); err != nil {
+		log.Printf("warning: database not available: %v", err)
+	}
+
+	// Connections to the member service use mTLS when GRPC_TLS_* is set
+	clientCreds, err := mtls.ClientCredentials(cfg.TLS)
+	if err != nil {
+		log.Fatalf("failed to load TLS credentials: %v", err)
+	}
+
+	// Member service, used to suspend members when a case is resolved
+	memberConn, err := grpcclient.New("member", cfg.MemberServiceAddr,
+		grpcclient.WithTransportCredentials(clientCreds),
+		grpcclient.WithDialOptions(grpc.WithPerRPCCredentials(interceptors.ServiceToken(cfg.ServiceToken))),
+	)
+	if err != nil {
+		log.Fatalf("failed to create member service client: %v", err)
+	}
+	defer memberConn.Close()
+
+	// Message broker
+	var publisher messaging.Publisher
+	broker, err := messaging.NewNATSBroker(cfg.NATSURL, "moderation")
+	if err != nil {
+		log.Printf("warning: message broker not available, moderation events will not be published: %v", err)
+	} else {
+		defer broker.Close()
+		publisher = broker
+	}
+
+	// Initialize repository and service
+	cases := persistence.NewPostgresCaseRepository(db)
+	members := memberclient.New(memberv1.NewMemberServiceClient(memberConn))
+	moderationService := application.NewModerationService(cases, members, publisher)
+
+	// Initialize gRPC handler
+	moderationHandler := grpchandler.NewModerationHandler(moderationService)
+
+	// Callers authenticate as in the member service. Staff working the
+	// queue are identified by the access token the caller forwards, which
+	// also carries their roles
+	tokens, err := interceptors.ParseTokens(cfg.ServiceTokens)
+	if err != nil {
+		log.Fatalf("invalid SERVICE_TOKENS: %v", err)
+	}
+	authenticate := interceptors.FirstOf(
+		interceptors.SPIFFEAuthenticator(cfg.SPIFFETrustDomain, grpchandler.CallerGateway, grpchandler.CallerAdmin),
+		interceptors.TokenAuthenticator(tokens),
+	)
+
+	serverCreds, err := mtls.ServerCredentials(cfg.TLS)
+	if err != nil {
+		log.Fatalf("failed to load TLS credentials: %v", err)
+	}
+
+	// Create gRPC server
+	// Request IDs from the gateway are passed on to the member service and
+	// onto published moderation events
+	grpcServer := grpc.NewServer(
+		grpc.Creds(serverCreds),
+		grpcclient.ServerKeepalive(),
+		grpc.ChainUnaryInterceptor(
+			correlation.UnaryServerInterceptor(),
+			logging.UnaryServerInterceptor(),
+			interceptors.Authorize(authenticate, grpchandler.Policies,
+				interceptors.WithMembers(interceptors.JWTMemberAuthenticator(cfg.JWTSecret)),
+				interceptors.WithRoles(interceptors.JWTMemberRoles(cfg.JWTSecret)),
+			),
+		),
+	)
+	moderationv1.RegisterModerationServiceServer(grpcServer, moderationHandler)
+	reflection.Register(grpcServer) // Enable reflection for grpcurl
+
+	// HTTP server for health checks
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	})
+
+	httpServer := &http.Server{
+		Addr:         cfg.HTTPAddr,
+		Handler:      mux,
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 10 * time.Second,
+	}
+
+	// Start gRPC server
+	go func() {
+		lis, err := net.Listen("tcp", cfg.GRPCAddr)
+		if err != nil {
+			log.Fatalf("failed to listen: %v", err)
+		}
+		log.Printf("gRPC server listening on %s", cfg.GRPCAddr)
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Fatalf("failed to serve gRPC: %v", err)
+		}
+	}()
+
+	// Start HTTP server
+	go func() {
+		log.Printf("HTTP server listening on %s", httpServer.Addr)
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("failed to serve HTTP: %v", err)
+		}
+	}()
+
+	// Graceful shutdown
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	log.Println("Shutting down servers...")
+	grpcServer.GracefulStop()
+	_ = httpServer.Shutdown(ctx)
+}
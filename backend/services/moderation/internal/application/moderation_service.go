@@ -0,0 +1,169 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/moderation/internal/domain/aggregate"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/moderation/internal/domain/commands"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/moderation/internal/domain/repository"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/moderation/internal/domain/valueobject"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/messaging"
+)
+
+// DefaultQueueLimit is the number of cases listed when no limit is given.
+const DefaultQueueLimit = 50
+
+// MemberSuspender issues the member service's suspension command.
+type MemberSuspender interface {
+	SuspendMember(ctx context.Context, memberID, reason string) error
+}
+
+type ModerationService struct {
+	cases     repository.CaseRepository
+	members   MemberSuspender
+	publisher messaging.Publisher
+}
+
+func NewModerationService(cases repository.CaseRepository, members MemberSuspender, publisher messaging.Publisher) *ModerationService {
+	return &ModerationService{
+		cases:     cases,
+		members:   members,
+		publisher: publisher,
+	}
+}
+
+// FileReport adds the report to the member's unresolved case, opening a new
+// case if there is none.
+func (s *ModerationService) FileReport(ctx context.Context, cmd commands.FileReport) (aggregate.Report, error) {
+	report, err := aggregate.NewReport(
+		cmd.ReportID,
+		cmd.ReporterID,
+		cmd.MemberID,
+		valueobject.Category(cmd.Category),
+		cmd.Description,
+	)
+	if err != nil {
+		return aggregate.Report{}, err
+	}
+
+	c, err := s.fileReport(ctx, cmd, report)
+	if errors.Is(err, aggregate.ErrCaseAlreadyExists) {
+		// Another report opened a case concurrently; join that one.
+		c, err = s.fileReport(ctx, cmd, report)
+	}
+	if err != nil {
+		return aggregate.Report{}, err
+	}
+
+	s.publishChanges(ctx, c)
+	return report, nil
+}
+
+func (s *ModerationService) fileReport(ctx context.Context, cmd commands.FileReport, report aggregate.Report) (*aggregate.Case, error) {
+	c, err := s.cases.GetUnresolvedByMember(ctx, cmd.MemberID)
+	switch {
+	case errors.Is(err, aggregate.ErrCaseNotFound):
+		c = aggregate.OpenCase(cmd.CaseID, cmd.MemberID, report)
+	case err != nil:
+		return nil, err
+	default:
+		if err := c.AddReport(report); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.cases.Save(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (s *ModerationService) GetCase(ctx context.Context, caseID string) (*aggregate.Case, error) {
+	return s.cases.GetByID(ctx, caseID)
+}
+
+// ListQueue lists cases with the given status in review order.
+func (s *ModerationService) ListQueue(ctx context.Context, status aggregate.CaseStatus, limit int) ([]*aggregate.Case, error) {
+	if limit <= 0 || limit > DefaultQueueLimit {
+		limit = DefaultQueueLimit
+	}
+	return s.cases.ListByStatus(ctx, status, limit)
+}
+
+func (s *ModerationService) ClaimCase(ctx context.Context, cmd commands.ClaimCase) (*aggregate.Case, error) {
+	c, err := s.cases.GetByID(ctx, cmd.CaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.Claim(cmd.ModeratorID); err != nil {
+		return nil, err
+	}
+
+	if err := s.cases.Save(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ResolveCase records the moderator's decision. A suspension is issued to
+// the member service before the case is closed, so a failed suspension
+// leaves the case in review to be retried.
+func (s *ModerationService) ResolveCase(ctx context.Context, cmd commands.ResolveCase) (*aggregate.Case, error) {
+	c, err := s.cases.GetByID(ctx, cmd.CaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	action := valueobject.Action(cmd.Action)
+	if err := c.Resolve(cmd.ModeratorID, action, cmd.Note); err != nil {
+		return nil, err
+	}
+
+	if action == valueobject.ActionSuspend {
+		reason := fmt.Sprintf("moderation case %s", c.ID())
+		if cmd.Note != "" {
+			reason += ": " + cmd.Note
+		}
+		if err := s.members.SuspendMember(ctx, c.MemberID(), reason); err != nil {
+			return nil, fmt.Errorf("suspend member: %w", err)
+		}
+	}
+
+	if err := s.cases.Save(ctx, c); err != nil {
+		return nil, err
+	}
+
+	s.publishChanges(ctx, c)
+	return c, nil
+}
+
+// publishChanges announces the case's pending events on the broker. Publish
+// failures are logged rather than returned: the case is already stored.
+func (s *ModerationService) publishChanges(ctx context.Context, c *aggregate.Case) {
+	defer c.ClearChanges()
+
+	if s.publisher == nil {
+		return
+	}
+
+	for _, event := range c.Changes() {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			log.Printf("failed to marshal %s for case %s: %v", event.EventType(), c.ID(), err)
+			continue
+		}
+
+		err = s.publisher.Publish(ctx, event.EventType(), messaging.Message{
+			Type:    event.EventType(),
+			Payload: payload,
+		})
+		if err != nil {
+			log.Printf("failed to publish %s for case %s: %v", event.EventType(), c.ID(), err)
+		}
+	}
+}
@@ -0,0 +1,250 @@
+package aggregate
+
+import (
+	"errors"
+	"time"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/moderation/internal/domain/events"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/moderation/internal/domain/valueobject"
+)
+
+var (
+	ErrCaseNotFound      = errors.New("case not found")
+	ErrCaseClosed        = errors.New("case is already resolved")
+	ErrCaseAssigned      = errors.New("case is assigned to another moderator")
+	ErrCaseNotClaimed    = errors.New("case must be claimed before it is resolved")
+	ErrCannotReportSelf  = errors.New("members cannot report themselves")
+	ErrCaseAlreadyExists = errors.New("member already has an open case")
+)
+
+type CaseStatus string
+
+const (
+	CaseStatusOpen     CaseStatus = "open"
+	CaseStatusInReview CaseStatus = "in_review"
+	CaseStatusResolved CaseStatus = "resolved"
+)
+
+// Report is a single member's complaint about another member.
+type Report struct {
+	ID          string
+	ReporterID  string
+	Category    valueobject.Category
+	Description string
+	CreatedAt   time.Time
+}
+
+// NewReport validates a report filed by reporterID against memberID.
+func NewReport(id, reporterID, memberID string, category valueobject.Category, description string) (Report, error) {
+	if reporterID == memberID {
+		return Report{}, ErrCannotReportSelf
+	}
+	if !category.Valid() {
+		return Report{}, valueobject.ErrInvalidCategory
+	}
+	if len(description) > valueobject.MaxDescriptionLength {
+		return Report{}, valueobject.ErrDescriptionTooLong
+	}
+	if category == valueobject.CategoryOther && description == "" {
+		return Report{}, valueobject.ErrDescriptionRequired
+	}
+
+	return Report{
+		ID:          id,
+		ReporterID:  reporterID,
+		Category:    category,
+		Description: description,
+		CreatedAt:   time.Now(),
+	}, nil
+}
+
+// Case collects the reports against a member until a moderator resolves
+// it. A member has at most one unresolved case; new reports join it.
+type Case struct {
+	id             string
+	memberID       string
+	status         CaseStatus
+	reports        []Report
+	priority       int
+	assigneeID     string
+	action         valueobject.Action
+	resolutionNote string
+	createdAt      time.Time
+	updatedAt      time.Time
+	resolvedAt     time.Time
+
+	changes []events.Event
+}
+
+// OpenCase starts a case for the member with its first report.
+func OpenCase(id, memberID string, report Report) *Case {
+	c := &Case{
+		id:        id,
+		memberID:  memberID,
+		status:    CaseStatusOpen,
+		createdAt: report.CreatedAt,
+		changes:   make([]events.Event, 0),
+	}
+	c.addReport(report)
+	return c
+}
+
+// RestoreCase rebuilds a case from persisted state without raising events.
+func RestoreCase(id, memberID string, status CaseStatus, reports []Report, assigneeID string, action valueobject.Action, resolutionNote string, createdAt, updatedAt, resolvedAt time.Time) *Case {
+	c := &Case{
+		id:             id,
+		memberID:       memberID,
+		status:         status,
+		reports:        reports,
+		assigneeID:     assigneeID,
+		action:         action,
+		resolutionNote: resolutionNote,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+		resolvedAt:     resolvedAt,
+		changes:        make([]events.Event, 0),
+	}
+	c.priority = c.triage()
+	return c
+}
+
+func (c *Case) ID() string {
+	return c.id
+}
+
+func (c *Case) MemberID() string {
+	return c.memberID
+}
+
+func (c *Case) Status() CaseStatus {
+	return c.status
+}
+
+func (c *Case) Reports() []Report {
+	return c.reports
+}
+
+func (c *Case) Priority() int {
+	return c.priority
+}
+
+func (c *Case) AssigneeID() string {
+	return c.assigneeID
+}
+
+func (c *Case) Action() valueobject.Action {
+	return c.action
+}
+
+func (c *Case) ResolutionNote() string {
+	return c.resolutionNote
+}
+
+func (c *Case) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *Case) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+func (c *Case) ResolvedAt() time.Time {
+	return c.resolvedAt
+}
+
+// AddReport attaches another report to an unresolved case. A second report
+// by the same reporter is recorded but does not raise the priority.
+func (c *Case) AddReport(report Report) error {
+	if c.status == CaseStatusResolved {
+		return ErrCaseClosed
+	}
+
+	c.addReport(report)
+	return nil
+}
+
+func (c *Case) addReport(report Report) {
+	c.reports = append(c.reports, report)
+	c.priority = c.triage()
+	c.updatedAt = report.CreatedAt
+
+	c.raise(events.ReportFiled{
+		CaseID:     c.id,
+		ReportID:   report.ID,
+		MemberID:   c.memberID,
+		ReporterID: report.ReporterID,
+		Category:   string(report.Category),
+		Timestamp:  report.CreatedAt,
+	})
+}
+
+// triage ranks the case by its most severe report, then by the number of
+// distinct members who reported it.
+func (c *Case) triage() int {
+	severity := 0
+	reporters := make(map[string]bool)
+	for _, r := range c.reports {
+		severity = max(severity, r.Category.Severity())
+		reporters[r.ReporterID] = true
+	}
+	return severity*10 + min(len(reporters), 9)
+}
+
+// Claim assigns the case to a moderator for review. Claiming a case one
+// already holds is a no-op.
+func (c *Case) Claim(moderatorID string) error {
+	switch {
+	case c.status == CaseStatusResolved:
+		return ErrCaseClosed
+	case c.status == CaseStatusInReview && c.assigneeID != moderatorID:
+		return ErrCaseAssigned
+	}
+
+	c.status = CaseStatusInReview
+	c.assigneeID = moderatorID
+	c.updatedAt = time.Now()
+	return nil
+}
+
+// Resolve closes the case with the moderator's decision.
+func (c *Case) Resolve(moderatorID string, action valueobject.Action, note string) error {
+	if !action.Valid() {
+		return valueobject.ErrInvalidAction
+	}
+	switch {
+	case c.status == CaseStatusResolved:
+		return ErrCaseClosed
+	case c.status != CaseStatusInReview:
+		return ErrCaseNotClaimed
+	case c.assigneeID != moderatorID:
+		return ErrCaseAssigned
+	}
+
+	c.status = CaseStatusResolved
+	c.action = action
+	c.resolutionNote = note
+	c.resolvedAt = time.Now()
+	c.updatedAt = c.resolvedAt
+
+	c.raise(events.ActionTaken{
+		CaseID:      c.id,
+		MemberID:    c.memberID,
+		ModeratorID: moderatorID,
+		Action:      string(action),
+		Timestamp:   c.resolvedAt,
+	})
+
+	return nil
+}
+
+func (c *Case) raise(event events.Event) {
+	c.changes = append(c.changes, event)
+}
+
+func (c *Case) Changes() []events.Event {
+	return c.changes
+}
+
+func (c *Case) ClearChanges() {
+	c.changes = make([]events.Event, 0)
+}
@@ -0,0 +1,33 @@
+package commands
+
+type Command interface {
+	CommandType() string
+}
+
+// FileReport files a report. CaseID is used when the report opens a new case.
+type FileReport struct {
+	ReportID    string
+	CaseID      string
+	ReporterID  string
+	MemberID    string
+	Category    string
+	Description string
+}
+
+func (c FileReport) CommandType() string { return "moderation.file_report" }
+
+type ClaimCase struct {
+	CaseID      string
+	ModeratorID string
+}
+
+func (c ClaimCase) CommandType() string { return "moderation.claim_case" }
+
+type ResolveCase struct {
+	CaseID      string
+	ModeratorID string
+	Action      string
+	Note        string
+}
+
+func (c ResolveCase) CommandType() string { return "moderation.resolve_case" }
@@ -0,0 +1,36 @@
+package events
+
+import "time"
+
+type Event interface {
+	EventType() string
+	AggregateID() string
+	OccurredAt() time.Time
+}
+
+type ReportFiled struct {
+	CaseID     string
+	ReportID   string
+	MemberID   string
+	ReporterID string
+	Category   string
+	Timestamp  time.Time
+}
+
+func (e ReportFiled) EventType() string     { return "moderation.report_filed" }
+func (e ReportFiled) AggregateID() string   { return e.CaseID }
+func (e ReportFiled) OccurredAt() time.Time { return e.Timestamp }
+
+// ActionTaken is raised when a moderator resolves a case. Dismissals are
+// included so every case has a recorded outcome.
+type ActionTaken struct {
+	CaseID      string
+	MemberID    string
+	ModeratorID string
+	Action      string
+	Timestamp   time.Time
+}
+
+func (e ActionTaken) EventType() string     { return "moderation.action_taken" }
+func (e ActionTaken) AggregateID() string   { return e.CaseID }
+func (e ActionTaken) OccurredAt() time.Time { return e.Timestamp }
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/moderation/internal/domain/aggregate"
+)
+
+type CaseRepository interface {
+	// Save upserts the case and its reports. It returns
+	// aggregate.ErrCaseAlreadyExists when a new case collides with another
+	// unresolved case for the same member.
+	Save(ctx context.Context, c *aggregate.Case) error
+	GetByID(ctx context.Context, id string) (*aggregate.Case, error)
+	// GetUnresolvedByMember returns the member's open or in-review case.
+	GetUnresolvedByMember(ctx context.Context, memberID string) (*aggregate.Case, error)
+	// ListByStatus returns cases in queue order: highest priority first,
+	// then oldest first.
+	ListByStatus(ctx context.Context, status aggregate.CaseStatus, limit int) ([]*aggregate.Case, error)
+}
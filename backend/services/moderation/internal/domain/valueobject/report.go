@@ -0,0 +1,64 @@
+package valueobject
+
+import "errors"
+
+var (
+	ErrInvalidCategory     = errors.New("invalid report category")
+	ErrInvalidAction       = errors.New("invalid moderation action")
+	ErrDescriptionTooLong  = errors.New("description must be at most 1000 characters")
+	ErrDescriptionRequired = errors.New("description is required for reports in category other")
+)
+
+// MaxDescriptionLength is the longest free-text description of a report.
+const MaxDescriptionLength = 1000
+
+// Category is what a member is reported for.
+type Category string
+
+const (
+	CategorySpam                 Category = "spam"
+	CategoryFakeProfile          Category = "fake_profile"
+	CategoryInappropriateContent Category = "inappropriate_content"
+	CategoryHarassment           Category = "harassment"
+	CategoryScam                 Category = "scam"
+	CategoryUnderage             Category = "underage"
+	CategoryOther                Category = "other"
+)
+
+// severities rank categories for triage; higher is reviewed first.
+var severities = map[Category]int{
+	CategorySpam:                 1,
+	CategoryOther:                1,
+	CategoryFakeProfile:          2,
+	CategoryInappropriateContent: 3,
+	CategoryHarassment:           4,
+	CategoryScam:                 4,
+	CategoryUnderage:             5,
+}
+
+func (c Category) Valid() bool {
+	_, ok := severities[c]
+	return ok
+}
+
+func (c Category) Severity() int {
+	return severities[c]
+}
+
+// Action is the outcome of a moderation case.
+type Action string
+
+const (
+	ActionDismiss Action = "dismiss"
+	ActionWarn    Action = "warn"
+	ActionSuspend Action = "suspend"
+)
+
+func (a Action) Valid() bool {
+	switch a {
+	case ActionDismiss, ActionWarn, ActionSuspend:
+		return true
+	default:
+		return false
+	}
+}
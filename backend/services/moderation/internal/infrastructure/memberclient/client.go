@@ -0,0 +1,25 @@
+package memberclient
+
+import (
+	"context"
+
+	memberv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/member/v1"
+)
+
+// Client issues moderation commands to the member service.
+type Client struct {
+	members memberv1.MemberServiceClient
+}
+
+func New(members memberv1.MemberServiceClient) *Client {
+	return &Client{members: members}
+}
+
+// SuspendMember implements application.MemberSuspender.
+func (c *Client) SuspendMember(ctx context.Context, memberID, reason string) error {
+	_, err := c.members.SuspendMember(ctx, &memberv1.SuspendMemberRequest{
+		MemberId: memberID,
+		Reason:   reason,
+	})
+	return err
+}
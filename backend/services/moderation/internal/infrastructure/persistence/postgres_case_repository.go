@@ -0,0 +1,218 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/moderation/internal/domain/aggregate"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/moderation/internal/domain/repository"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/moderation/internal/domain/valueobject"
+)
+
+// uniqueViolation is the PostgreSQL error code for unique constraint violations.
+const uniqueViolation = "23505"
+
+const selectCases = `
+	SELECT id, member_id, status, assignee_id, action, resolution_note, created_at, updated_at, resolved_at
+	FROM moderation_cases
+`
+
+// PostgresCaseRepository implements repository.CaseRepository using PostgreSQL.
+type PostgresCaseRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresCaseRepository creates a new PostgreSQL-backed case repository.
+func NewPostgresCaseRepository(db *sql.DB) repository.CaseRepository {
+	return &PostgresCaseRepository{db: db}
+}
+
+// Save upserts the case and inserts reports that are not stored yet, in a
+// single transaction.
+func (r *PostgresCaseRepository) Save(ctx context.Context, c *aggregate.Case) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO moderation_cases (id, member_id, status, priority, assignee_id, action, resolution_note, created_at, updated_at, resolved_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			priority = EXCLUDED.priority,
+			assignee_id = EXCLUDED.assignee_id,
+			action = EXCLUDED.action,
+			resolution_note = EXCLUDED.resolution_note,
+			updated_at = EXCLUDED.updated_at,
+			resolved_at = EXCLUDED.resolved_at
+	`,
+		c.ID(),
+		c.MemberID(),
+		string(c.Status()),
+		c.Priority(),
+		c.AssigneeID(),
+		string(c.Action()),
+		c.ResolutionNote(),
+		c.CreatedAt(),
+		c.UpdatedAt(),
+		nullTime(c.ResolvedAt()),
+	)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		return aggregate.ErrCaseAlreadyExists
+	}
+	if err != nil {
+		return fmt.Errorf("upsert case: %w", err)
+	}
+
+	for _, report := range c.Reports() {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO moderation_reports (id, case_id, reporter_id, category, description, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (id) DO NOTHING
+		`, report.ID, c.ID(), report.ReporterID, string(report.Category), report.Description, report.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("insert report: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresCaseRepository) GetByID(ctx context.Context, id string) (*aggregate.Case, error) {
+	return r.getOne(ctx, selectCases+`WHERE id = $1`, id)
+}
+
+func (r *PostgresCaseRepository) GetUnresolvedByMember(ctx context.Context, memberID string) (*aggregate.Case, error) {
+	return r.getOne(ctx, selectCases+`WHERE member_id = $1 AND status <> 'resolved'`, memberID)
+}
+
+func (r *PostgresCaseRepository) ListByStatus(ctx context.Context, status aggregate.CaseStatus, limit int) ([]*aggregate.Case, error) {
+	return r.list(ctx, selectCases+`
+		WHERE status = $1
+		ORDER BY priority DESC, created_at ASC
+		LIMIT $2
+	`, string(status), limit)
+}
+
+func (r *PostgresCaseRepository) getOne(ctx context.Context, query string, args ...any) (*aggregate.Case, error) {
+	cases, err := r.list(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	if len(cases) == 0 {
+		return nil, aggregate.ErrCaseNotFound
+	}
+	return cases[0], nil
+}
+
+// caseRow holds a case row until its reports are loaded.
+type caseRow struct {
+	id, memberID, status, assigneeID, action, note string
+	createdAt, updatedAt                           time.Time
+	resolvedAt                                     sql.NullTime
+}
+
+func (r *PostgresCaseRepository) list(ctx context.Context, query string, args ...any) ([]*aggregate.Case, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query cases: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		caseRows []caseRow
+		ids      []string
+	)
+	for rows.Next() {
+		var row caseRow
+		if err := rows.Scan(&row.id, &row.memberID, &row.status, &row.assigneeID, &row.action, &row.note, &row.createdAt, &row.updatedAt, &row.resolvedAt); err != nil {
+			return nil, fmt.Errorf("scan case: %w", err)
+		}
+		caseRows = append(caseRows, row)
+		ids = append(ids, row.id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate cases: %w", err)
+	}
+
+	if len(caseRows) == 0 {
+		return nil, nil
+	}
+
+	reports, err := r.loadReports(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	cases := make([]*aggregate.Case, len(caseRows))
+	for i, row := range caseRows {
+		cases[i] = aggregate.RestoreCase(
+			row.id,
+			row.memberID,
+			aggregate.CaseStatus(row.status),
+			reports[row.id],
+			row.assigneeID,
+			valueobject.Action(row.action),
+			row.note,
+			row.createdAt,
+			row.updatedAt,
+			row.resolvedAt.Time,
+		)
+	}
+
+	return cases, nil
+}
+
+// loadReports returns the reports of the given cases keyed by case ID.
+func (r *PostgresCaseRepository) loadReports(ctx context.Context, caseIDs []string) (map[string][]aggregate.Report, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT case_id, id, reporter_id, category, description, created_at
+		FROM moderation_reports
+		WHERE case_id = ANY($1)
+		ORDER BY created_at ASC
+	`, pq.Array(caseIDs))
+	if err != nil {
+		return nil, fmt.Errorf("query reports: %w", err)
+	}
+	defer rows.Close()
+
+	reports := make(map[string][]aggregate.Report)
+	for rows.Next() {
+		var (
+			caseID   string
+			report   aggregate.Report
+			category string
+		)
+		if err := rows.Scan(&caseID, &report.ID, &report.ReporterID, &category, &report.Description, &report.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan report: %w", err)
+		}
+		report.Category = valueobject.Category(category)
+		reports[caseID] = append(reports[caseID], report)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate reports: %w", err)
+	}
+
+	return reports, nil
+}
+
+// nullTime returns sql.NullTime for optional time fields.
+func nullTime(t time.Time) sql.NullTime {
+	if t.IsZero() {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: t, Valid: true}
+}
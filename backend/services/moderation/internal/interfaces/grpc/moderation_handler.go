@@ -0,0 +1,256 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	moderationv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/moderation/v1"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/moderation/internal/application"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/moderation/internal/domain/aggregate"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/moderation/internal/domain/commands"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/moderation/internal/domain/valueobject"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/interceptors"
+)
+
+var categories = map[moderationv1.ReportCategory]valueobject.Category{
+	moderationv1.ReportCategory_REPORT_CATEGORY_SPAM:                  valueobject.CategorySpam,
+	moderationv1.ReportCategory_REPORT_CATEGORY_FAKE_PROFILE:          valueobject.CategoryFakeProfile,
+	moderationv1.ReportCategory_REPORT_CATEGORY_INAPPROPRIATE_CONTENT: valueobject.CategoryInappropriateContent,
+	moderationv1.ReportCategory_REPORT_CATEGORY_HARASSMENT:            valueobject.CategoryHarassment,
+	moderationv1.ReportCategory_REPORT_CATEGORY_SCAM:                  valueobject.CategoryScam,
+	moderationv1.ReportCategory_REPORT_CATEGORY_UNDERAGE:              valueobject.CategoryUnderage,
+	moderationv1.ReportCategory_REPORT_CATEGORY_OTHER:                 valueobject.CategoryOther,
+}
+
+var actions = map[moderationv1.ModerationAction]valueobject.Action{
+	moderationv1.ModerationAction_MODERATION_ACTION_DISMISS: valueobject.ActionDismiss,
+	moderationv1.ModerationAction_MODERATION_ACTION_WARN:    valueobject.ActionWarn,
+	moderationv1.ModerationAction_MODERATION_ACTION_SUSPEND: valueobject.ActionSuspend,
+}
+
+var caseStatuses = map[moderationv1.CaseStatus]aggregate.CaseStatus{
+	moderationv1.CaseStatus_CASE_STATUS_OPEN:      aggregate.CaseStatusOpen,
+	moderationv1.CaseStatus_CASE_STATUS_IN_REVIEW: aggregate.CaseStatusInReview,
+	moderationv1.CaseStatus_CASE_STATUS_RESOLVED:  aggregate.CaseStatusResolved,
+}
+
+// errNoModerator rejects claims and resolutions made without a verified
+// signed-in member, who would be recorded as the moderator.
+var errNoModerator = status.Error(codes.PermissionDenied, "cases are claimed and resolved by a signed-in staff member")
+
+// ModerationHandler implements the gRPC ModerationServiceServer interface.
+type ModerationHandler struct {
+	moderationv1.UnimplementedModerationServiceServer
+	service *application.ModerationService
+}
+
+// NewModerationHandler creates a new gRPC handler for the moderation service.
+func NewModerationHandler(service *application.ModerationService) *ModerationHandler {
+	return &ModerationHandler{service: service}
+}
+
+// FileReport files a member's report about another member.
+func (h *ModerationHandler) FileReport(ctx context.Context, req *moderationv1.FileReportRequest) (*moderationv1.FileReportResponse, error) {
+	if req.ReporterId == "" {
+		return nil, status.Error(codes.InvalidArgument, "reporter_id is required")
+	}
+	if req.MemberId == "" {
+		return nil, status.Error(codes.InvalidArgument, "member_id is required")
+	}
+
+	cmd := commands.FileReport{
+		ReportID:    uuid.New().String(),
+		CaseID:      uuid.New().String(),
+		ReporterID:  req.ReporterId,
+		MemberID:    req.MemberId,
+		Category:    string(categories[req.Category]),
+		Description: req.Description,
+	}
+
+	report, err := h.service.FileReport(ctx, cmd)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &moderationv1.FileReportResponse{
+		ReportId: report.ID,
+	}, nil
+}
+
+// ListCases lists the moderation queue.
+func (h *ModerationHandler) ListCases(ctx context.Context, req *moderationv1.ListCasesRequest) (*moderationv1.ListCasesResponse, error) {
+	caseStatus := aggregate.CaseStatusOpen
+	if req.Status != moderationv1.CaseStatus_CASE_STATUS_UNSPECIFIED {
+		caseStatus = caseStatuses[req.Status]
+	}
+
+	cases, err := h.service.ListQueue(ctx, caseStatus, int(req.Limit))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &moderationv1.ListCasesResponse{
+		Cases: make([]*moderationv1.Case, len(cases)),
+	}
+	for i, c := range cases {
+		resp.Cases[i] = toProtoCase(c)
+	}
+
+	return resp, nil
+}
+
+// GetCase retrieves a case with its reports.
+func (h *ModerationHandler) GetCase(ctx context.Context, req *moderationv1.GetCaseRequest) (*moderationv1.GetCaseResponse, error) {
+	if req.CaseId == "" {
+		return nil, status.Error(codes.InvalidArgument, "case_id is required")
+	}
+
+	c, err := h.service.GetCase(ctx, req.CaseId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &moderationv1.GetCaseResponse{
+		Case: toProtoCase(c),
+	}, nil
+}
+
+// ClaimCase assigns a case to the signed-in moderator.
+func (h *ModerationHandler) ClaimCase(ctx context.Context, req *moderationv1.ClaimCaseRequest) (*moderationv1.ClaimCaseResponse, error) {
+	if req.CaseId == "" {
+		return nil, status.Error(codes.InvalidArgument, "case_id is required")
+	}
+	moderatorID := interceptors.MemberFromContext(ctx)
+	if moderatorID == "" {
+		return nil, errNoModerator
+	}
+
+	cmd := commands.ClaimCase{
+		CaseID:      req.CaseId,
+		ModeratorID: moderatorID,
+	}
+
+	c, err := h.service.ClaimCase(ctx, cmd)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &moderationv1.ClaimCaseResponse{
+		Case: toProtoCase(c),
+	}, nil
+}
+
+// ResolveCase records a moderator's decision on a claimed case.
+func (h *ModerationHandler) ResolveCase(ctx context.Context, req *moderationv1.ResolveCaseRequest) (*moderationv1.ResolveCaseResponse, error) {
+	if req.CaseId == "" {
+		return nil, status.Error(codes.InvalidArgument, "case_id is required")
+	}
+	moderatorID := interceptors.MemberFromContext(ctx)
+	if moderatorID == "" {
+		return nil, errNoModerator
+	}
+
+	cmd := commands.ResolveCase{
+		CaseID:      req.CaseId,
+		ModeratorID: moderatorID,
+		Action:      string(actions[req.Action]),
+		Note:        req.Note,
+	}
+
+	c, err := h.service.ResolveCase(ctx, cmd)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &moderationv1.ResolveCaseResponse{
+		Case: toProtoCase(c),
+	}, nil
+}
+
+// toProtoCase converts a domain case to a protobuf case.
+func toProtoCase(c *aggregate.Case) *moderationv1.Case {
+	reports := make([]*moderationv1.Report, len(c.Reports()))
+	for i, r := range c.Reports() {
+		reports[i] = &moderationv1.Report{
+			Id:          r.ID,
+			ReporterId:  r.ReporterID,
+			Category:    toProtoCategory(r.Category),
+			Description: r.Description,
+			CreatedAt:   timestamppb.New(r.CreatedAt),
+		}
+	}
+
+	return &moderationv1.Case{
+		Id:             c.ID(),
+		MemberId:       c.MemberID(),
+		Status:         toProtoStatus(c.Status()),
+		Priority:       int32(c.Priority()),
+		Reports:        reports,
+		AssigneeId:     c.AssigneeID(),
+		Action:         toProtoAction(c.Action()),
+		ResolutionNote: c.ResolutionNote(),
+		CreatedAt:      timestamppb.New(c.CreatedAt()),
+		UpdatedAt:      timestamppb.New(c.UpdatedAt()),
+		ResolvedAt:     optionalTimestamp(c.ResolvedAt()),
+	}
+}
+
+func toProtoCategory(c valueobject.Category) moderationv1.ReportCategory {
+	for proto, category := range categories {
+		if category == c {
+			return proto
+		}
+	}
+	return moderationv1.ReportCategory_REPORT_CATEGORY_UNSPECIFIED
+}
+
+func toProtoAction(a valueobject.Action) moderationv1.ModerationAction {
+	for proto, action := range actions {
+		if action == a {
+			return proto
+		}
+	}
+	return moderationv1.ModerationAction_MODERATION_ACTION_UNSPECIFIED
+}
+
+func toProtoStatus(s aggregate.CaseStatus) moderationv1.CaseStatus {
+	for proto, caseStatus := range caseStatuses {
+		if caseStatus == s {
+			return proto
+		}
+	}
+	return moderationv1.CaseStatus_CASE_STATUS_UNSPECIFIED
+}
+
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// toGRPCError converts domain errors to gRPC status errors.
+func toGRPCError(err error) error {
+	switch {
+	case errors.Is(err, aggregate.ErrCaseNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, aggregate.ErrCaseClosed),
+		errors.Is(err, aggregate.ErrCaseNotClaimed),
+		errors.Is(err, aggregate.ErrCaseAssigned):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, aggregate.ErrCannotReportSelf),
+		errors.Is(err, valueobject.ErrInvalidCategory),
+		errors.Is(err, valueobject.ErrInvalidAction),
+		errors.Is(err, valueobject.ErrDescriptionTooLong),
+		errors.Is(err, valueobject.ErrDescriptionRequired):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
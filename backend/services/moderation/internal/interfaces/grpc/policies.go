@@ -0,0 +1,32 @@
+package grpc
+
+import (
+	moderationv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/moderation/v1"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/auth"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/interceptors"
+)
+
+// Services that call the moderation service, as named in SERVICE_TOKENS.
+// Admin is used by operators, for example through grpcurl.
+const (
+	CallerGateway = "gateway"
+	CallerAdmin   = "admin"
+)
+
+// staffRoles may work the moderation queue.
+var staffRoles = []string{auth.RoleSupport, auth.RoleAdmin}
+
+// Policies says which services may call each method. Members file reports
+// through the gateway, as themselves. The queue is worked by staff, whose
+// access token the caller must forward: claims and resolutions are made in
+// the signed-in member's name, so not even operators may make them without
+// one.
+var Policies = interceptors.Policies{
+	moderationv1.ModerationService_FileReport_FullMethodName: {Callers: []string{CallerGateway}, Subject: "reporter_id"},
+
+	moderationv1.ModerationService_ListCases_FullMethodName: {Callers: []string{CallerGateway, CallerAdmin}, Roles: staffRoles, Operators: []string{CallerAdmin}},
+	moderationv1.ModerationService_GetCase_FullMethodName:   {Callers: []string{CallerGateway, CallerAdmin}, Roles: staffRoles, Operators: []string{CallerAdmin}},
+
+	moderationv1.ModerationService_ClaimCase_FullMethodName:   {Callers: []string{CallerGateway, CallerAdmin}, Roles: staffRoles},
+	moderationv1.ModerationService_ResolveCase_FullMethodName: {Callers: []string{CallerGateway, CallerAdmin}, Roles: staffRoles},
+}
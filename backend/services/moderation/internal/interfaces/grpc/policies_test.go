@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	moderationv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/moderation/v1"
+)
+
+// TestCasePoliciesNeedStaffRoles fails when anyone but staff could work the
+// queue, or an operator could claim or resolve a case in no one's name.
+func TestCasePoliciesNeedStaffRoles(t *testing.T) {
+	service := moderationv1.ModerationService_ServiceDesc
+	for _, method := range service.Methods {
+		fullMethod := "/" + service.ServiceName + "/" + method.MethodName
+		policy, ok := Policies[fullMethod]
+		switch {
+		case !ok:
+			t.Errorf("%s has no policy", fullMethod)
+		case fullMethod == moderationv1.ModerationService_FileReport_FullMethodName:
+			if policy.Subject != "reporter_id" {
+				t.Errorf("%s lets the caller choose the reporter", fullMethod)
+			}
+		case policy.Public || len(policy.Roles) == 0:
+			t.Errorf("%s can be called without a staff role", fullMethod)
+		}
+	}
+
+	for _, method := range []string{
+		moderationv1.ModerationService_ClaimCase_FullMethodName,
+		moderationv1.ModerationService_ResolveCase_FullMethodName,
+	} {
+		if operators := Policies[method].Operators; len(operators) > 0 {
+			t.Errorf("%s may be called by %v without a signed-in moderator", method, operators)
+		}
+	}
+}
+
+func TestCasesNeedSignedInModerator(t *testing.T) {
+	h := NewModerationHandler(nil)
+	ctx := context.Background()
+
+	_, err := h.ClaimCase(ctx, &moderationv1.ClaimCaseRequest{CaseId: "case-1"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ClaimCase without a member = %v, want PermissionDenied", err)
+	}
+	_, err = h.ResolveCase(ctx, &moderationv1.ResolveCaseRequest{CaseId: "case-1", Action: moderationv1.ModerationAction_MODERATION_ACTION_SUSPEND})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ResolveCase without a member = %v, want PermissionDenied", err)
+	}
+}
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_moderation_reports_case;
+DROP TABLE IF EXISTS moderation_reports;
+DROP INDEX IF EXISTS idx_moderation_cases_queue;
+DROP INDEX IF EXISTS idx_moderation_cases_unresolved;
+DROP TABLE IF EXISTS moderation_cases;
@@ -0,0 +1,33 @@
+-- Moderation cases: the reports against a member awaiting or after review
+CREATE TABLE IF NOT EXISTS moderation_cases (
+    id VARCHAR(36) PRIMARY KEY,
+    member_id VARCHAR(36) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    priority INT NOT NULL,
+    assignee_id VARCHAR(36) NOT NULL DEFAULT '',
+    action VARCHAR(20) NOT NULL DEFAULT '',
+    resolution_note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMPTZ
+);
+
+-- A member has at most one unresolved case; new reports join it
+CREATE UNIQUE INDEX idx_moderation_cases_unresolved ON moderation_cases(member_id)
+    WHERE status <> 'resolved';
+
+-- Index for the review queue
+CREATE INDEX idx_moderation_cases_queue ON moderation_cases(status, priority DESC, created_at);
+
+-- Reports filed by members, grouped into cases
+CREATE TABLE IF NOT EXISTS moderation_reports (
+    id VARCHAR(36) PRIMARY KEY,
+    case_id VARCHAR(36) NOT NULL REFERENCES moderation_cases(id),
+    reporter_id VARCHAR(36) NOT NULL,
+    category VARCHAR(30) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Index for loading a case's reports
+CREATE INDEX idx_moderation_reports_case ON moderation_reports(case_id, created_at);
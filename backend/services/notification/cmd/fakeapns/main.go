@@ -0,0 +1,46 @@
+// Command fakeapns is a stand-in for APNs in local development. It accepts
+// pushes over cleartext HTTP/2 on the same path as APNs, logs them and
+// answers the way APNs would for tokens it considers dead:
+//
+//   - tokens listed in FAKE_APNS_UNREGISTERED get 410 Unregistered
+//   - tokens that are not 64 hex characters get 400 BadDeviceToken
+//
+// Point the notification service at it with APNS_URL=http://localhost:8093.
+package main
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/infrastructure/apns/apnstest"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/config"
+)
+
+type Config struct {
+	HTTPAddr     string   `env:"HTTP_ADDR" default:":8093"`
+	Unregistered []string `env:"FAKE_APNS_UNREGISTERED"`
+}
+
+func main() {
+	var cfg Config
+	config.MustLoad(&cfg)
+
+	fake := &apnstest.Server{Unregistered: make(map[string]bool)}
+	for _, token := range cfg.Unregistered {
+		fake.Unregistered[token] = true
+	}
+
+	var protocols http.Protocols
+	protocols.SetUnencryptedHTTP2(true)
+
+	server := &http.Server{
+		Addr:      cfg.HTTPAddr,
+		Handler:   fake,
+		Protocols: &protocols,
+	}
+
+	log.Printf("fake APNs listening on %s", server.Addr)
+	if err := server.ListenAndServe(); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	_ "github.com/lib/pq"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	notificationv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/notification/v1"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/application"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/config"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/infrastructure/apns"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/infrastructure/persistence"
+	grpchandler "github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/interfaces/grpc"
+	msghandler "github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/interfaces/messaging"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/correlation"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/grpcclient"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/interceptors"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/logging"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/messaging"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/mtls"
+)
+
+func main() {
+	cfg := config.Load()
+	logging.Setup("notification")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Database connection
+	db, err := sql.Open("postgres", cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.PingContext(ctx); err != nil {
+		log.Printf("warning: database not available: %v", err)
+	}
+
+	// APNs client. Without a signing key the client only works against
+	// servers that skip authentication, such as cmd/fakeapns.
+	var auth *apns.ProviderToken
+	if cfg.APNsKeyFile != "" {
+		auth, err = apns.LoadProviderToken(cfg.APNsKeyFile, cfg.APNsKeyID, cfg.APNsTeamID)
+		if err != nil {
+			log.Fatalf("failed to load apns key: %v", err)
+		}
+	} else {
+		log.Printf("warning: APNS_KEY_FILE not set, pushes are sent unauthenticated")
+	}
+
+	pusher, err := apns.NewClient(cfg.APNsURL, cfg.APNsTopic, auth)
+	if err != nil {
+		log.Fatalf("failed to create apns client: %v", err)
+	}
+
+	// Initialize repositories and service
+	devices := persistence.NewPostgresDeviceRepository(db)
+	preferences := persistence.NewPostgresPreferencesRepository(db)
+	notificationService := application.NewNotificationService(
+		devices,
+		preferences,
+		pusher,
+		cfg.DigestWindow,
+	)
+	defer notificationService.Close()
+
+	// Message broker
+	broker, err := messaging.NewNATSBroker(cfg.NATSURL, "notification")
+	if err != nil {
+		log.Printf("warning: message broker not available, no notifications will be sent: %v", err)
+	} else {
+		defer broker.Close()
+		if err := msghandler.NewEventConsumer(notificationService).Start(ctx, broker); err != nil {
+			log.Fatalf("failed to subscribe to events: %v", err)
+		}
+	}
+
+	// Initialize gRPC handler
+	notificationHandler := grpchandler.NewNotificationHandler(notificationService)
+
+	// Callers authenticate as in the member service; the member a call is
+	// made for is taken from the access token the gateway forwards
+	tokens, err := interceptors.ParseTokens(cfg.ServiceTokens)
+	if err != nil {
+		log.Fatalf("invalid SERVICE_TOKENS: %v", err)
+	}
+	authenticate := interceptors.FirstOf(
+		interceptors.SPIFFEAuthenticator(cfg.SPIFFETrustDomain, grpchandler.CallerGateway, grpchandler.CallerAdmin),
+		interceptors.TokenAuthenticator(tokens),
+	)
+
+	serverCreds, err := mtls.ServerCredentials(cfg.TLS)
+	if err != nil {
+		log.Fatalf("failed to load TLS credentials: %v", err)
+	}
+
+	// Create gRPC server
+	grpcServer := grpc.NewServer(
+		grpc.Creds(serverCreds),
+		grpcclient.ServerKeepalive(),
+		grpc.ChainUnaryInterceptor(
+			correlation.UnaryServerInterceptor(),
+			logging.UnaryServerInterceptor(),
+			interceptors.Authorize(authenticate, grpchandler.Policies,
+				interceptors.WithMembers(interceptors.JWTMemberAuthenticator(cfg.JWTSecret)),
+			),
+		),
+	)
+	notificationv1.RegisterNotificationServiceServer(grpcServer, notificationHandler)
+	reflection.Register(grpcServer) // Enable reflection for grpcurl
+
+	// HTTP server for health checks
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	})
+
+	httpServer := &http.Server{
+		Addr:         cfg.HTTPAddr,
+		Handler:      mux,
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 10 * time.Second,
+	}
+
+	// Start gRPC server
+	go func() {
+		lis, err := net.Listen("tcp", cfg.GRPCAddr)
+		if err != nil {
+			log.Fatalf("failed to listen: %v", err)
+		}
+		log.Printf("gRPC server listening on %s", cfg.GRPCAddr)
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Fatalf("failed to serve gRPC: %v", err)
+		}
+	}()
+
+	// Start HTTP server
+	go func() {
+		log.Printf("HTTP server listening on %s", httpServer.Addr)
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("failed to serve HTTP: %v", err)
+		}
+	}()
+
+	// Graceful shutdown
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	log.Println("Shutting down servers...")
+	grpcServer.GracefulStop()
+	_ = httpServer.Shutdown(ctx)
+}
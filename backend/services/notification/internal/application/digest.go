@@ -0,0 +1,123 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/domain/valueobject"
+)
+
+// digestSendTimeout bounds a flushed digest, which runs outside the context
+// of the event that started it.
+const digestSendTimeout = 30 * time.Second
+
+// Digester collapses bursts of notifications with the same member and
+// collapse ID. The first notification starts a window; when it closes a
+// single push is sent carrying the latest notification and the number of
+// notifications it stands for.
+//
+// Pending digests live in memory of the replica that received the first
+// notification; nothing is shared between replicas. That is why the
+// service runs as a single replica (see notification-deployment.yaml):
+//
+//   - with several replicas, the queue group spreads a burst across them
+//     and each sends its own digest, so a member gets up to one push per
+//     replica instead of one
+//   - a graceful shutdown sends what is pending on Close, but a crash
+//     loses it, and those messages are never notified
+//   - during a rolling update, the old and new pod each digest part of a
+//     burst for a moment
+//
+// Running more replicas needs the pending digests keyed in shared storage,
+// such as Redis, with one replica owning each window.
+type Digester struct {
+	window time.Duration
+	send   func(ctx context.Context, notification valueobject.Notification) error
+
+	mu      sync.Mutex
+	pending map[string]*digest
+	closed  bool
+}
+
+type digest struct {
+	latest valueobject.Notification
+	count  int
+	timer  *time.Timer
+}
+
+func NewDigester(window time.Duration, send func(ctx context.Context, notification valueobject.Notification) error) *Digester {
+	return &Digester{
+		window:  window,
+		send:    send,
+		pending: make(map[string]*digest),
+	}
+}
+
+// Add queues the notification, starting a new window if none is open.
+func (d *Digester) Add(notification valueobject.Notification) {
+	key := notification.MemberID + "/" + notification.CollapseID
+
+	d.mu.Lock()
+	if d.closed {
+		d.mu.Unlock()
+		d.flush(notification, 1)
+		return
+	}
+
+	if pending, ok := d.pending[key]; ok {
+		pending.latest = notification
+		pending.count++
+		d.mu.Unlock()
+		return
+	}
+
+	d.pending[key] = &digest{
+		latest: notification,
+		count:  1,
+		timer:  time.AfterFunc(d.window, func() { d.expire(key) }),
+	}
+	d.mu.Unlock()
+}
+
+// Close stops all windows and sends what is pending.
+func (d *Digester) Close() {
+	d.mu.Lock()
+	d.closed = true
+	pending := d.pending
+	d.pending = make(map[string]*digest)
+	d.mu.Unlock()
+
+	// A timer that already fired finds its digest gone, so nothing is sent
+	// twice.
+	for _, p := range pending {
+		p.timer.Stop()
+		d.flush(p.latest, p.count)
+	}
+}
+
+func (d *Digester) expire(key string) {
+	d.mu.Lock()
+	p, ok := d.pending[key]
+	delete(d.pending, key)
+	d.mu.Unlock()
+
+	if ok {
+		d.flush(p.latest, p.count)
+	}
+}
+
+func (d *Digester) flush(notification valueobject.Notification, count int) {
+	if count > 1 {
+		notification.Body = fmt.Sprintf("%d new messages", count)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), digestSendTimeout)
+	defer cancel()
+
+	if err := d.send(ctx, notification); err != nil {
+		log.Printf("failed to send digest to member %s: %v", notification.MemberID, err)
+	}
+}
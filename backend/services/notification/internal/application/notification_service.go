@@ -0,0 +1,159 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/domain/aggregate"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/domain/commands"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/domain/repository"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/domain/valueobject"
+)
+
+// Pusher delivers a notification to a single device. Implementations wrap
+// aggregate.ErrTokenUnavailable when the provider reports that the token
+// will never be deliverable again, and aggregate.ErrDeliveryRejected when
+// the push was refused for this device only. Silent pushes must not play a
+// sound or light up the screen.
+type Pusher interface {
+	Push(ctx context.Context, token string, notification valueobject.Notification, silent bool) error
+}
+
+type NotificationService struct {
+	devices     repository.DeviceRepository
+	preferences repository.PreferencesRepository
+	pusher      Pusher
+	digester    *Digester
+}
+
+// NewNotificationService creates the service. Message notifications for the
+// same conversation arriving within digestWindow are collapsed into a single
+// push; a zero window sends every message on its own.
+func NewNotificationService(devices repository.DeviceRepository, preferences repository.PreferencesRepository, pusher Pusher, digestWindow time.Duration) *NotificationService {
+	s := &NotificationService{
+		devices:     devices,
+		preferences: preferences,
+		pusher:      pusher,
+	}
+	if digestWindow > 0 {
+		s.digester = NewDigester(digestWindow, s.Send)
+	}
+	return s
+}
+
+// RegisterDevice stores a push token. A token that moves to another member,
+// e.g. after a logout and login on the same phone, is reassigned.
+func (s *NotificationService) RegisterDevice(ctx context.Context, cmd commands.RegisterDevice) (*aggregate.Device, error) {
+	device, err := aggregate.NewDevice(cmd.Token, cmd.MemberID, cmd.Platform)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.devices.Save(ctx, device); err != nil {
+		return nil, err
+	}
+
+	return device, nil
+}
+
+func (s *NotificationService) UnregisterDevice(ctx context.Context, cmd commands.UnregisterDevice) error {
+	device, err := s.devices.GetByToken(ctx, cmd.Token)
+	if err != nil {
+		return err
+	}
+	if device.MemberID() != cmd.MemberID {
+		return aggregate.ErrDeviceNotFound
+	}
+
+	return s.devices.Delete(ctx, cmd.Token)
+}
+
+func (s *NotificationService) GetPreferences(ctx context.Context, memberID string) (valueobject.Preferences, error) {
+	return s.preferences.Get(ctx, memberID)
+}
+
+func (s *NotificationService) UpdatePreferences(ctx context.Context, cmd commands.UpdatePreferences) error {
+	return s.preferences.Save(ctx, cmd.MemberID, cmd.Preferences)
+}
+
+// Notify sends the notification, holding back message notifications so
+// bursts within the digest window go out as one push.
+func (s *NotificationService) Notify(ctx context.Context, notification valueobject.Notification) error {
+	if s.digester != nil && notification.Category == valueobject.CategoryMessage {
+		s.digester.Add(notification)
+		return nil
+	}
+	return s.Send(ctx, notification)
+}
+
+// Send pushes the notification to every device of the member, unless the
+// member opted out of its category. During quiet hours the push is
+// delivered silently. Delivery failures are recorded per device and dead
+// tokens are pruned; they are not returned to the caller.
+func (s *NotificationService) Send(ctx context.Context, notification valueobject.Notification) error {
+	preferences, err := s.preferences.Get(ctx, notification.MemberID)
+	if err != nil {
+		return err
+	}
+	if !preferences.Allows(notification.Category) {
+		return nil
+	}
+
+	devices, err := s.devices.ListByMember(ctx, notification.MemberID)
+	if err != nil {
+		return err
+	}
+
+	silent := preferences.QuietHours.Contains(time.Now())
+	for _, device := range devices {
+		s.deliver(ctx, device, notification, silent)
+	}
+
+	return nil
+}
+
+// Close flushes pending digests.
+func (s *NotificationService) Close() {
+	if s.digester != nil {
+		s.digester.Close()
+	}
+}
+
+func (s *NotificationService) deliver(ctx context.Context, device *aggregate.Device, notification valueobject.Notification, silent bool) {
+	err := s.pusher.Push(ctx, device.Token(), notification, silent)
+	switch {
+	case err == nil:
+		device.RecordDelivery()
+	case ctx.Err() != nil:
+		// Not the device's fault; leave its failure streak alone.
+		return
+	case errors.Is(err, aggregate.ErrTokenUnavailable):
+		log.Printf("pruning device of member %s: %v", device.MemberID(), err)
+		s.prune(ctx, device)
+		return
+	case errors.Is(err, aggregate.ErrDeliveryRejected):
+		device.RecordFailure(err.Error())
+		if device.IsDead() {
+			log.Printf("pruning device of member %s after %d rejected deliveries: %v", device.MemberID(), device.FailureCount(), err)
+			s.prune(ctx, device)
+			return
+		}
+		log.Printf("push rejected for device of member %s: %v", device.MemberID(), err)
+	default:
+		// Provider-wide failures say nothing about this device.
+		log.Printf("failed to push to device of member %s: %v", device.MemberID(), err)
+		return
+	}
+
+	if err := s.devices.Save(ctx, device); err != nil {
+		log.Printf("failed to record delivery for device of member %s: %v", device.MemberID(), err)
+	}
+}
+
+func (s *NotificationService) prune(ctx context.Context, device *aggregate.Device) {
+	if err := s.devices.Delete(ctx, device.Token()); err != nil {
+		log.Printf("failed to prune device of member %s: %v", device.MemberID(), err)
+	}
+}
@@ -0,0 +1,118 @@
+package aggregate
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrDeviceNotFound   = errors.New("device not found")
+	ErrInvalidToken     = errors.New("device token is required")
+	ErrInvalidPlatform  = errors.New("unsupported device platform")
+	ErrTokenUnavailable = errors.New("device token is no longer valid")
+	ErrDeliveryRejected = errors.New("push was rejected for this device")
+)
+
+// MaxConsecutiveFailures is the number of rejected deliveries in a row after
+// which a device is considered dead even without a definitive answer from
+// the push provider.
+const MaxConsecutiveFailures = 5
+
+type Platform string
+
+const (
+	PlatformIOS Platform = "ios"
+)
+
+// Device is a push token registered by one of a member's app installs.
+type Device struct {
+	token             string
+	memberID          string
+	platform          Platform
+	registeredAt      time.Time
+	lastDeliveredAt   time.Time
+	failureCount      int
+	lastFailureReason string
+	lastFailureAt     time.Time
+}
+
+func NewDevice(token, memberID string, platform Platform) (*Device, error) {
+	if token == "" {
+		return nil, ErrInvalidToken
+	}
+	if platform != PlatformIOS {
+		return nil, ErrInvalidPlatform
+	}
+
+	return &Device{
+		token:        token,
+		memberID:     memberID,
+		platform:     platform,
+		registeredAt: time.Now(),
+	}, nil
+}
+
+// RestoreDevice rebuilds a device from persisted state.
+func RestoreDevice(token, memberID string, platform Platform, registeredAt, lastDeliveredAt time.Time, failureCount int, lastFailureReason string, lastFailureAt time.Time) *Device {
+	return &Device{
+		token:             token,
+		memberID:          memberID,
+		platform:          platform,
+		registeredAt:      registeredAt,
+		lastDeliveredAt:   lastDeliveredAt,
+		failureCount:      failureCount,
+		lastFailureReason: lastFailureReason,
+		lastFailureAt:     lastFailureAt,
+	}
+}
+
+func (d *Device) Token() string {
+	return d.token
+}
+
+func (d *Device) MemberID() string {
+	return d.memberID
+}
+
+func (d *Device) Platform() Platform {
+	return d.platform
+}
+
+func (d *Device) RegisteredAt() time.Time {
+	return d.registeredAt
+}
+
+func (d *Device) LastDeliveredAt() time.Time {
+	return d.lastDeliveredAt
+}
+
+func (d *Device) FailureCount() int {
+	return d.failureCount
+}
+
+func (d *Device) LastFailureReason() string {
+	return d.lastFailureReason
+}
+
+func (d *Device) LastFailureAt() time.Time {
+	return d.lastFailureAt
+}
+
+// RecordDelivery resets the failure streak after a successful push.
+func (d *Device) RecordDelivery() {
+	d.lastDeliveredAt = time.Now()
+	d.failureCount = 0
+	d.lastFailureReason = ""
+}
+
+// RecordFailure notes a failed push.
+func (d *Device) RecordFailure(reason string) {
+	d.failureCount++
+	d.lastFailureReason = reason
+	d.lastFailureAt = time.Now()
+}
+
+// IsDead reports whether the device has failed too often to keep.
+func (d *Device) IsDead() bool {
+	return d.failureCount >= MaxConsecutiveFailures
+}
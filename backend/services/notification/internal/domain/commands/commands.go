@@ -0,0 +1,32 @@
+package commands
+
+import (
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/domain/aggregate"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/domain/valueobject"
+)
+
+type Command interface {
+	CommandType() string
+}
+
+type RegisterDevice struct {
+	MemberID string
+	Token    string
+	Platform aggregate.Platform
+}
+
+func (c RegisterDevice) CommandType() string { return "notification.register_device" }
+
+type UnregisterDevice struct {
+	MemberID string
+	Token    string
+}
+
+func (c UnregisterDevice) CommandType() string { return "notification.unregister_device" }
+
+type UpdatePreferences struct {
+	MemberID    string
+	Preferences valueobject.Preferences
+}
+
+func (c UpdatePreferences) CommandType() string { return "notification.update_preferences" }
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/domain/aggregate"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/domain/valueobject"
+)
+
+type DeviceRepository interface {
+	Save(ctx context.Context, device *aggregate.Device) error
+	Delete(ctx context.Context, token string) error
+	GetByToken(ctx context.Context, token string) (*aggregate.Device, error)
+	ListByMember(ctx context.Context, memberID string) ([]*aggregate.Device, error)
+}
+
+// PreferencesRepository stores notification preferences. Get returns
+// valueobject.DefaultPreferences for members without stored preferences.
+type PreferencesRepository interface {
+	Get(ctx context.Context, memberID string) (valueobject.Preferences, error)
+	Save(ctx context.Context, memberID string, preferences valueobject.Preferences) error
+}
@@ -0,0 +1,15 @@
+package valueobject
+
+// Notification is a push addressed to all devices of a member.
+type Notification struct {
+	MemberID string
+	Category Category
+	Title    string
+	Body     string
+	// ThreadID groups notifications on the device, e.g. per conversation.
+	ThreadID string
+	// CollapseID makes a newer push replace an older one with the same ID.
+	CollapseID string
+	// Data is delivered to the app alongside the alert.
+	Data map[string]string
+}
@@ -0,0 +1,133 @@
+package valueobject
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrInvalidQuietHours = errors.New("quiet hours must be formatted as HH:MM")
+	ErrEmptyQuietHours   = errors.New("quiet hours start and end must differ")
+	ErrInvalidTimeZone   = errors.New("unknown time zone")
+)
+
+// Category groups notifications so members can opt out of each kind.
+type Category string
+
+const (
+	CategoryMatch      Category = "match"
+	CategoryMessage    Category = "message"
+	CategoryModeration Category = "moderation"
+)
+
+// Preferences are a member's notification settings.
+type Preferences struct {
+	Matches    bool
+	Messages   bool
+	Moderation bool
+	QuietHours QuietHours
+}
+
+// DefaultPreferences applies to members who never changed their settings.
+func DefaultPreferences() Preferences {
+	return Preferences{
+		Matches:    true,
+		Messages:   true,
+		Moderation: true,
+	}
+}
+
+// Allows reports whether the member wants notifications of the category.
+func (p Preferences) Allows(category Category) bool {
+	switch category {
+	case CategoryMatch:
+		return p.Matches
+	case CategoryMessage:
+		return p.Messages
+	case CategoryModeration:
+		return p.Moderation
+	default:
+		return false
+	}
+}
+
+// QuietHours is a daily window in the member's time zone. The zero value
+// means no quiet hours are set.
+type QuietHours struct {
+	start    int
+	end      int
+	location *time.Location
+}
+
+func NewQuietHours(start, end, timeZone string) (QuietHours, error) {
+	startMinute, err := parseClock(start)
+	if err != nil {
+		return QuietHours{}, err
+	}
+	endMinute, err := parseClock(end)
+	if err != nil {
+		return QuietHours{}, err
+	}
+	if startMinute == endMinute {
+		return QuietHours{}, ErrEmptyQuietHours
+	}
+
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return QuietHours{}, fmt.Errorf("%w: %s", ErrInvalidTimeZone, timeZone)
+	}
+
+	return QuietHours{
+		start:    startMinute,
+		end:      endMinute,
+		location: location,
+	}, nil
+}
+
+func (q QuietHours) IsZero() bool {
+	return q.location == nil
+}
+
+// Contains reports whether t falls inside the window. Windows whose end is
+// before their start wrap past midnight, so 22:00-07:00 covers the night.
+func (q QuietHours) Contains(t time.Time) bool {
+	if q.IsZero() {
+		return false
+	}
+
+	local := t.In(q.location)
+	minute := local.Hour()*60 + local.Minute()
+
+	if q.start < q.end {
+		return minute >= q.start && minute < q.end
+	}
+	return minute >= q.start || minute < q.end
+}
+
+func (q QuietHours) Start() string {
+	return formatClock(q.start)
+}
+
+func (q QuietHours) End() string {
+	return formatClock(q.end)
+}
+
+func (q QuietHours) TimeZone() string {
+	if q.IsZero() {
+		return ""
+	}
+	return q.location.String()
+}
+
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, ErrInvalidQuietHours
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func formatClock(minute int) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
@@ -0,0 +1,127 @@
+// Package apnstest provides a server speaking the APNs provider API, for
+// local development (cmd/fakeapns) and tests. It answers the way APNs
+// would for tokens it considers dead:
+//
+//   - tokens in Unregistered get 410 Unregistered
+//   - tokens that are not 64 hex characters get 400 BadDeviceToken
+//
+// With a Key it also checks provider tokens, answering 403 like APNs when
+// one is missing or not signed with the key.
+package apnstest
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Push is a push the server accepted.
+type Push struct {
+	Token   string
+	Header  http.Header
+	Payload map[string]any
+}
+
+// Server is an http.Handler serving POST /3/device/{token}.
+type Server struct {
+	// Unregistered holds the tokens answered with 410 Unregistered.
+	Unregistered map[string]bool
+	// Key, when set, is the public key provider tokens must be signed with.
+	Key *ecdsa.PublicKey
+	// Record keeps accepted pushes for Pushes. It is off for the
+	// long-running fake, which only logs them.
+	Record bool
+
+	mu     sync.Mutex
+	pushes []Push
+}
+
+// Pushes returns the pushes accepted so far, if Record is set.
+func (s *Server) Pushes() []Push {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Push(nil), s.pushes...)
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	token, ok := strings.CutPrefix(r.URL.Path, "/3/device/")
+	if r.Method != http.MethodPost || !ok || token == "" || strings.Contains(token, "/") {
+		http.NotFound(w, r)
+		return
+	}
+	body, _ := io.ReadAll(io.LimitReader(r.Body, 4096))
+
+	w.Header().Set("apns-id", r.Header.Get("apns-id"))
+
+	if reason := s.checkAuthorization(r); reason != "" {
+		reject(w, http.StatusForbidden, reason)
+		return
+	}
+
+	var payload map[string]any
+	switch {
+	case s.Unregistered[token]:
+		reject(w, http.StatusGone, "Unregistered")
+	case !validToken(token):
+		reject(w, http.StatusBadRequest, "BadDeviceToken")
+	case r.Header.Get("apns-topic") == "":
+		reject(w, http.StatusBadRequest, "MissingTopic")
+	case json.Unmarshal(body, &payload) != nil:
+		reject(w, http.StatusBadRequest, "PayloadEmpty")
+	default:
+		log.Printf("push to %s (topic %s, collapse %q, priority %s): %s",
+			token, r.Header.Get("apns-topic"), r.Header.Get("apns-collapse-id"), r.Header.Get("apns-priority"), body)
+		if s.Record {
+			s.mu.Lock()
+			s.pushes = append(s.pushes, Push{Token: token, Header: r.Header.Clone(), Payload: payload})
+			s.mu.Unlock()
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// checkAuthorization returns the APNs reason for rejecting the provider
+// token of r, or "" when it is acceptable.
+func (s *Server) checkAuthorization(r *http.Request) string {
+	if s.Key == nil {
+		return ""
+	}
+	bearer, ok := strings.CutPrefix(r.Header.Get("Authorization"), "bearer ")
+	if !ok {
+		return "MissingProviderToken"
+	}
+	token, err := jwt.Parse(bearer, func(*jwt.Token) (any, error) { return s.Key, nil },
+		jwt.WithValidMethods([]string{jwt.SigningMethodES256.Alg()}), jwt.WithIssuedAt())
+	if err != nil {
+		return "InvalidProviderToken"
+	}
+	if kid, _ := token.Header["kid"].(string); kid == "" {
+		return "InvalidProviderToken"
+	}
+	if issuer, err := token.Claims.GetIssuer(); err != nil || issuer == "" {
+		return "InvalidProviderToken"
+	}
+	return ""
+}
+
+func validToken(token string) bool {
+	if len(token) != 64 {
+		return false
+	}
+	_, err := hex.DecodeString(token)
+	return err == nil
+}
+
+func reject(w http.ResponseWriter, status int, reason string) {
+	log.Printf("rejecting push: %d %s", status, reason)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"reason": reason})
+}
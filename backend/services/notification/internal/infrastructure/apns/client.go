@@ -0,0 +1,184 @@
+package apns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/domain/aggregate"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/domain/valueobject"
+)
+
+const (
+	ProductionURL  = "https://api.push.apple.com"
+	DevelopmentURL = "https://api.sandbox.push.apple.com"
+)
+
+// permanentReasons are the APNs rejection reasons after which a token
+// must not be used again.
+var permanentReasons = map[string]bool{
+	"BadDeviceToken":         true,
+	"DeviceTokenNotForTopic": true,
+	"Unregistered":           true,
+	"ExpiredToken":           true,
+}
+
+// Error is a push rejected by APNs.
+type Error struct {
+	StatusCode int
+	Reason     string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("apns: %d %s", e.StatusCode, e.Reason)
+}
+
+// Unwrap reports dead tokens as aggregate.ErrTokenUnavailable and other
+// rejections of the individual push as aggregate.ErrDeliveryRejected.
+// Authentication, throttling and server errors affect every device alike
+// and unwrap to nil.
+func (e *Error) Unwrap() error {
+	switch {
+	case e.StatusCode == http.StatusGone || permanentReasons[e.Reason]:
+		return aggregate.ErrTokenUnavailable
+	case e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusRequestEntityTooLarge:
+		return aggregate.ErrDeliveryRejected
+	default:
+		return nil
+	}
+}
+
+// Client sends alert pushes over HTTP/2 to APNs or any server speaking the
+// same protocol. Plain http:// base URLs use HTTP/2 without TLS, which is
+// what the local fake (cmd/fakeapns) serves.
+type Client struct {
+	baseURL string
+	topic   string
+	auth    *ProviderToken
+	http    *http.Client
+}
+
+// NewClient creates a client for the app with the given bundle ID. auth may
+// be nil for servers that do not check provider tokens.
+func NewClient(baseURL, topic string, auth *ProviderToken) (*Client, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse apns url: %w", err)
+	}
+
+	transport := &http.Transport{
+		ForceAttemptHTTP2:   true,
+		MaxIdleConnsPerHost: 4,
+		IdleConnTimeout:     5 * time.Minute,
+	}
+
+	switch u.Scheme {
+	case "https":
+	case "http":
+		var protocols http.Protocols
+		protocols.SetUnencryptedHTTP2(true)
+		transport.Protocols = &protocols
+	default:
+		return nil, fmt.Errorf("unsupported apns url scheme %q", u.Scheme)
+	}
+
+	return &Client{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		topic:   topic,
+		auth:    auth,
+		http: &http.Client{
+			Transport: transport,
+			Timeout:   10 * time.Second,
+		},
+	}, nil
+}
+
+type payload struct {
+	APS  aps               `json:"aps"`
+	Data map[string]string `json:"data,omitempty"`
+}
+
+type aps struct {
+	Alert             alert  `json:"alert"`
+	Sound             string `json:"sound,omitempty"`
+	ThreadID          string `json:"thread-id,omitempty"`
+	InterruptionLevel string `json:"interruption-level"`
+}
+
+type alert struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body"`
+}
+
+type errorResponse struct {
+	Reason string `json:"reason"`
+}
+
+// Push implements application.Pusher.
+func (c *Client) Push(ctx context.Context, token string, notification valueobject.Notification, silent bool) error {
+	p := payload{
+		APS: aps{
+			Alert: alert{
+				Title: notification.Title,
+				Body:  notification.Body,
+			},
+			ThreadID:          notification.ThreadID,
+			InterruptionLevel: "active",
+			Sound:             "default",
+		},
+		Data: notification.Data,
+	}
+	if silent {
+		p.APS.InterruptionLevel = "passive"
+		p.APS.Sound = ""
+	}
+
+	body, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("marshal apns payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/3/device/"+url.PathEscape(token), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("apns-push-type", "alert")
+	req.Header.Set("apns-topic", c.topic)
+	if notification.CollapseID != "" {
+		req.Header.Set("apns-collapse-id", notification.CollapseID)
+	}
+	if silent {
+		req.Header.Set("apns-priority", "5")
+	} else {
+		req.Header.Set("apns-priority", "10")
+	}
+	if c.auth != nil {
+		bearer, err := c.auth.Bearer()
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "bearer "+bearer)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("apns request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	var errResp errorResponse
+	_ = json.NewDecoder(io.LimitReader(resp.Body, 4096)).Decode(&errResp)
+	return &Error{StatusCode: resp.StatusCode, Reason: errResp.Reason}
+}
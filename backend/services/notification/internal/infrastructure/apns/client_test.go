@@ -0,0 +1,238 @@
+package apns
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/application"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/domain/aggregate"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/domain/valueobject"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/infrastructure/apns/apnstest"
+)
+
+const (
+	liveToken = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	deadToken = "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+	topic     = "nl.zoekdeware.app"
+)
+
+// startFake serves fake over cleartext HTTP/2, as cmd/fakeapns does, and
+// returns its URL.
+func startFake(t *testing.T, fake *apnstest.Server) string {
+	t.Helper()
+	server := httptest.NewUnstartedServer(fake)
+	server.Config.Protocols = new(http.Protocols)
+	server.Config.Protocols.SetUnencryptedHTTP2(true)
+	server.Start()
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+// providerToken writes a .p8 signing key like the one from the Apple
+// developer portal and loads it.
+func providerToken(t *testing.T) (*ProviderToken, *ecdsa.PublicKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "AuthKey_KEYID12345.p8")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := LoadProviderToken(path, "KEYID12345", "TEAMID1234")
+	if err != nil {
+		t.Fatalf("LoadProviderToken: %v", err)
+	}
+	return token, &key.PublicKey
+}
+
+func newTestClient(t *testing.T, fake *apnstest.Server, auth *ProviderToken) *Client {
+	t.Helper()
+	client, err := NewClient(startFake(t, fake), topic, auth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestPushSendsAuthenticatedAlert(t *testing.T) {
+	auth, key := providerToken(t)
+	fake := &apnstest.Server{Key: key, Record: true}
+	client := newTestClient(t, fake, auth)
+
+	notification := valueobject.Notification{
+		Title:      "Sam",
+		Body:       "3 new messages",
+		ThreadID:   "conversation-1",
+		CollapseID: "conversation-1",
+		Data:       map[string]string{"conversation_id": "conversation-1"},
+	}
+	if err := client.Push(context.Background(), liveToken, notification, false); err != nil {
+		t.Fatalf("Push: %v", err)
+	}
+	if err := client.Push(context.Background(), liveToken, notification, true); err != nil {
+		t.Fatalf("silent Push: %v", err)
+	}
+
+	pushes := fake.Pushes()
+	if len(pushes) != 2 {
+		t.Fatalf("fake received %d pushes, want 2", len(pushes))
+	}
+	for name, want := range map[string]string{
+		"apns-topic":       topic,
+		"apns-push-type":   "alert",
+		"apns-collapse-id": "conversation-1",
+		"apns-priority":    "10",
+	} {
+		if got := pushes[0].Header.Get(name); got != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+	aps, _ := pushes[0].Payload["aps"].(map[string]any)
+	if aps["thread-id"] != "conversation-1" || aps["interruption-level"] != "active" || aps["sound"] != "default" {
+		t.Errorf("aps = %v", aps)
+	}
+
+	silent, _ := pushes[1].Payload["aps"].(map[string]any)
+	if pushes[1].Header.Get("apns-priority") != "5" || silent["interruption-level"] != "passive" || silent["sound"] != nil {
+		t.Errorf("silent push: priority %q, aps %v", pushes[1].Header.Get("apns-priority"), silent)
+	}
+}
+
+// A provider token APNs refuses affects every device alike, so it must not
+// be mistaken for a dead device token.
+func TestPushRejectedProviderToken(t *testing.T) {
+	_, key := providerToken(t)
+	otherAuth, _ := providerToken(t)
+
+	for name, auth := range map[string]*ProviderToken{
+		"MissingProviderToken": nil,
+		"InvalidProviderToken": otherAuth,
+	} {
+		t.Run(name, func(t *testing.T) {
+			client := newTestClient(t, &apnstest.Server{Key: key}, auth)
+			err := client.Push(context.Background(), liveToken, valueobject.Notification{Body: "hi"}, false)
+
+			var apnsErr *Error
+			if !errors.As(err, &apnsErr) || apnsErr.StatusCode != http.StatusForbidden || apnsErr.Reason != name {
+				t.Fatalf("Push = %v, want 403 %s", err, name)
+			}
+			if errors.Is(err, aggregate.ErrTokenUnavailable) || errors.Is(err, aggregate.ErrDeliveryRejected) {
+				t.Errorf("%v is blamed on the device", err)
+			}
+		})
+	}
+}
+
+func TestUnregisteredTokenRemovesDevice(t *testing.T) {
+	auth, key := providerToken(t)
+	fake := &apnstest.Server{Key: key, Record: true, Unregistered: map[string]bool{deadToken: true}}
+	devices := newMemDevices(t, liveToken, deadToken)
+	service := application.NewNotificationService(devices, memPreferences{}, newTestClient(t, fake, auth), 0)
+
+	err := service.Send(context.Background(), valueobject.Notification{
+		MemberID: "member-1",
+		Category: valueobject.CategoryMatch,
+		Body:     "You have a new match",
+	})
+	if err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	if _, ok := devices.devices[deadToken]; ok {
+		t.Error("device with unregistered token was kept")
+	}
+	live, ok := devices.devices[liveToken]
+	if !ok {
+		t.Fatal("device with live token was removed")
+	}
+	if live.LastDeliveredAt().IsZero() {
+		t.Error("delivery to the live device was not recorded")
+	}
+	if pushes := fake.Pushes(); len(pushes) != 1 || pushes[0].Token != liveToken {
+		t.Errorf("pushes = %v, want one to the live token", pushes)
+	}
+}
+
+// memDevices is a DeviceRepository keeping devices in memory.
+type memDevices struct {
+	mu      sync.Mutex
+	devices map[string]*aggregate.Device
+}
+
+func newMemDevices(t *testing.T, tokens ...string) *memDevices {
+	t.Helper()
+	r := &memDevices{devices: make(map[string]*aggregate.Device)}
+	for _, token := range tokens {
+		device, err := aggregate.NewDevice(token, "member-1", aggregate.PlatformIOS)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.devices[token] = device
+	}
+	return r
+}
+
+func (r *memDevices) Save(_ context.Context, device *aggregate.Device) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.devices[device.Token()] = device
+	return nil
+}
+
+func (r *memDevices) Delete(_ context.Context, token string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.devices, token)
+	return nil
+}
+
+func (r *memDevices) GetByToken(_ context.Context, token string) (*aggregate.Device, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	device, ok := r.devices[token]
+	if !ok {
+		return nil, aggregate.ErrDeviceNotFound
+	}
+	return device, nil
+}
+
+func (r *memDevices) ListByMember(_ context.Context, memberID string) ([]*aggregate.Device, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var devices []*aggregate.Device
+	for _, device := range r.devices {
+		if device.MemberID() == memberID {
+			devices = append(devices, device)
+		}
+	}
+	return devices, nil
+}
+
+// memPreferences gives every member the default preferences.
+type memPreferences struct{}
+
+func (memPreferences) Get(context.Context, string) (valueobject.Preferences, error) {
+	return valueobject.DefaultPreferences(), nil
+}
+
+func (memPreferences) Save(context.Context, string, valueobject.Preferences) error {
+	return nil
+}
@@ -0,0 +1,74 @@
+package apns
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// tokenLifetime is how long a provider token is reused. APNs rejects tokens
+// older than an hour and throttles ones refreshed more than every 20 minutes.
+const tokenLifetime = 50 * time.Minute
+
+// ProviderToken signs the ES256 JWTs APNs uses for token-based
+// authentication and caches them for tokenLifetime.
+type ProviderToken struct {
+	key    *ecdsa.PrivateKey
+	keyID  string
+	teamID string
+
+	mu       sync.Mutex
+	token    string
+	issuedAt time.Time
+}
+
+// LoadProviderToken reads the .p8 signing key downloaded from the Apple
+// developer portal.
+func LoadProviderToken(keyFile, keyID, teamID string) (*ProviderToken, error) {
+	pem, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("read apns key: %w", err)
+	}
+
+	key, err := jwt.ParseECPrivateKeyFromPEM(pem)
+	if err != nil {
+		return nil, fmt.Errorf("parse apns key: %w", err)
+	}
+
+	return &ProviderToken{
+		key:    key,
+		keyID:  keyID,
+		teamID: teamID,
+	}, nil
+}
+
+// Bearer returns a valid token, signing a new one when the cached token is
+// about to expire.
+func (p *ProviderToken) Bearer() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.token != "" && time.Since(p.issuedAt) < tokenLifetime {
+		return p.token, nil
+	}
+
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.RegisteredClaims{
+		Issuer:   p.teamID,
+		IssuedAt: jwt.NewNumericDate(now),
+	})
+	token.Header["kid"] = p.keyID
+
+	signed, err := token.SignedString(p.key)
+	if err != nil {
+		return "", fmt.Errorf("sign apns token: %w", err)
+	}
+
+	p.token = signed
+	p.issuedAt = now
+	return signed, nil
+}
@@ -0,0 +1,140 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/domain/aggregate"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/domain/repository"
+)
+
+// PostgresDeviceRepository implements repository.DeviceRepository using PostgreSQL.
+type PostgresDeviceRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresDeviceRepository creates a new PostgreSQL-backed device repository.
+func NewPostgresDeviceRepository(db *sql.DB) repository.DeviceRepository {
+	return &PostgresDeviceRepository{db: db}
+}
+
+// Save upserts the device by token, reassigning it if another member
+// registered it before.
+func (r *PostgresDeviceRepository) Save(ctx context.Context, device *aggregate.Device) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO devices (token, member_id, platform, registered_at, last_delivered_at, failure_count, last_failure_reason, last_failure_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (token) DO UPDATE SET
+			member_id = EXCLUDED.member_id,
+			platform = EXCLUDED.platform,
+			registered_at = EXCLUDED.registered_at,
+			last_delivered_at = EXCLUDED.last_delivered_at,
+			failure_count = EXCLUDED.failure_count,
+			last_failure_reason = EXCLUDED.last_failure_reason,
+			last_failure_at = EXCLUDED.last_failure_at
+	`,
+		device.Token(),
+		device.MemberID(),
+		string(device.Platform()),
+		device.RegisteredAt(),
+		nullTime(device.LastDeliveredAt()),
+		device.FailureCount(),
+		device.LastFailureReason(),
+		nullTime(device.LastFailureAt()),
+	)
+	if err != nil {
+		return fmt.Errorf("upsert device: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresDeviceRepository) Delete(ctx context.Context, token string) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM devices WHERE token = $1`, token); err != nil {
+		return fmt.Errorf("delete device: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresDeviceRepository) GetByToken(ctx context.Context, token string) (*aggregate.Device, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT token, member_id, platform, registered_at, last_delivered_at, failure_count, last_failure_reason, last_failure_at
+		FROM devices
+		WHERE token = $1
+	`, token)
+
+	device, err := scanDevice(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, aggregate.ErrDeviceNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query device: %w", err)
+	}
+
+	return device, nil
+}
+
+func (r *PostgresDeviceRepository) ListByMember(ctx context.Context, memberID string) ([]*aggregate.Device, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT token, member_id, platform, registered_at, last_delivered_at, failure_count, last_failure_reason, last_failure_at
+		FROM devices
+		WHERE member_id = $1
+	`, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("query devices: %w", err)
+	}
+	defer rows.Close()
+
+	var devices []*aggregate.Device
+	for rows.Next() {
+		device, err := scanDevice(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan device: %w", err)
+		}
+		devices = append(devices, device)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate devices: %w", err)
+	}
+
+	return devices, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanDevice(s scanner) (*aggregate.Device, error) {
+	var (
+		token, memberID, platform, lastFailureReason string
+		registeredAt                                 time.Time
+		lastDeliveredAt, lastFailureAt               sql.NullTime
+		failureCount                                 int
+	)
+	if err := s.Scan(&token, &memberID, &platform, &registeredAt, &lastDeliveredAt, &failureCount, &lastFailureReason, &lastFailureAt); err != nil {
+		return nil, err
+	}
+
+	return aggregate.RestoreDevice(
+		token,
+		memberID,
+		aggregate.Platform(platform),
+		registeredAt,
+		lastDeliveredAt.Time,
+		failureCount,
+		lastFailureReason,
+		lastFailureAt.Time,
+	), nil
+}
+
+// nullTime returns sql.NullTime for optional time fields.
+func nullTime(t time.Time) sql.NullTime {
+	if t.IsZero() {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: t, Valid: true}
+}
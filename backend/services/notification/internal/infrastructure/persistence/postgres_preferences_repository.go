@@ -0,0 +1,75 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/domain/repository"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/domain/valueobject"
+)
+
+// PostgresPreferencesRepository implements repository.PreferencesRepository using PostgreSQL.
+type PostgresPreferencesRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresPreferencesRepository creates a new PostgreSQL-backed preferences repository.
+func NewPostgresPreferencesRepository(db *sql.DB) repository.PreferencesRepository {
+	return &PostgresPreferencesRepository{db: db}
+}
+
+func (r *PostgresPreferencesRepository) Get(ctx context.Context, memberID string) (valueobject.Preferences, error) {
+	var (
+		preferences                    valueobject.Preferences
+		quietStart, quietEnd, timeZone sql.NullString
+	)
+	err := r.db.QueryRowContext(ctx, `
+		SELECT matches, messages, moderation, quiet_start, quiet_end, time_zone
+		FROM notification_preferences
+		WHERE member_id = $1
+	`, memberID).Scan(&preferences.Matches, &preferences.Messages, &preferences.Moderation, &quietStart, &quietEnd, &timeZone)
+	if errors.Is(err, sql.ErrNoRows) {
+		return valueobject.DefaultPreferences(), nil
+	}
+	if err != nil {
+		return valueobject.Preferences{}, fmt.Errorf("query preferences: %w", err)
+	}
+
+	if quietStart.Valid {
+		preferences.QuietHours, err = valueobject.NewQuietHours(quietStart.String, quietEnd.String, timeZone.String)
+		if err != nil {
+			return valueobject.Preferences{}, fmt.Errorf("restore quiet hours: %w", err)
+		}
+	}
+
+	return preferences, nil
+}
+
+func (r *PostgresPreferencesRepository) Save(ctx context.Context, memberID string, preferences valueobject.Preferences) error {
+	var quietStart, quietEnd, timeZone sql.NullString
+	if quiet := preferences.QuietHours; !quiet.IsZero() {
+		quietStart = sql.NullString{String: quiet.Start(), Valid: true}
+		quietEnd = sql.NullString{String: quiet.End(), Valid: true}
+		timeZone = sql.NullString{String: quiet.TimeZone(), Valid: true}
+	}
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO notification_preferences (member_id, matches, messages, moderation, quiet_start, quiet_end, time_zone, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())
+		ON CONFLICT (member_id) DO UPDATE SET
+			matches = EXCLUDED.matches,
+			messages = EXCLUDED.messages,
+			moderation = EXCLUDED.moderation,
+			quiet_start = EXCLUDED.quiet_start,
+			quiet_end = EXCLUDED.quiet_end,
+			time_zone = EXCLUDED.time_zone,
+			updated_at = EXCLUDED.updated_at
+	`, memberID, preferences.Matches, preferences.Messages, preferences.Moderation, quietStart, quietEnd, timeZone)
+	if err != nil {
+		return fmt.Errorf("upsert preferences: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	notificationv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/notification/v1"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/application"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/domain/aggregate"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/domain/commands"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/domain/valueobject"
+)
+
+// NotificationHandler implements the gRPC NotificationServiceServer interface.
+type NotificationHandler struct {
+	notificationv1.UnimplementedNotificationServiceServer
+	service *application.NotificationService
+}
+
+// NewNotificationHandler creates a new gRPC handler for the notification service.
+func NewNotificationHandler(service *application.NotificationService) *NotificationHandler {
+	return &NotificationHandler{service: service}
+}
+
+// RegisterDevice stores a device's push token.
+func (h *NotificationHandler) RegisterDevice(ctx context.Context, req *notificationv1.RegisterDeviceRequest) (*notificationv1.RegisterDeviceResponse, error) {
+	if req.MemberId == "" {
+		return nil, status.Error(codes.InvalidArgument, "member_id is required")
+	}
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	cmd := commands.RegisterDevice{
+		MemberID: req.MemberId,
+		Token:    req.Token,
+		Platform: fromProtoPlatform(req.Platform),
+	}
+
+	device, err := h.service.RegisterDevice(ctx, cmd)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &notificationv1.RegisterDeviceResponse{
+		Device: toProtoDevice(device),
+	}, nil
+}
+
+// UnregisterDevice removes a device's push token.
+func (h *NotificationHandler) UnregisterDevice(ctx context.Context, req *notificationv1.UnregisterDeviceRequest) (*notificationv1.UnregisterDeviceResponse, error) {
+	if req.MemberId == "" {
+		return nil, status.Error(codes.InvalidArgument, "member_id is required")
+	}
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	cmd := commands.UnregisterDevice{
+		MemberID: req.MemberId,
+		Token:    req.Token,
+	}
+
+	if err := h.service.UnregisterDevice(ctx, cmd); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &notificationv1.UnregisterDeviceResponse{}, nil
+}
+
+// GetPreferences retrieves a member's notification preferences.
+func (h *NotificationHandler) GetPreferences(ctx context.Context, req *notificationv1.GetPreferencesRequest) (*notificationv1.GetPreferencesResponse, error) {
+	if req.MemberId == "" {
+		return nil, status.Error(codes.InvalidArgument, "member_id is required")
+	}
+
+	preferences, err := h.service.GetPreferences(ctx, req.MemberId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &notificationv1.GetPreferencesResponse{
+		Preferences: toProtoPreferences(preferences),
+	}, nil
+}
+
+// UpdatePreferences replaces a member's notification preferences.
+func (h *NotificationHandler) UpdatePreferences(ctx context.Context, req *notificationv1.UpdatePreferencesRequest) (*notificationv1.UpdatePreferencesResponse, error) {
+	if req.MemberId == "" {
+		return nil, status.Error(codes.InvalidArgument, "member_id is required")
+	}
+	if req.Preferences == nil {
+		return nil, status.Error(codes.InvalidArgument, "preferences is required")
+	}
+
+	preferences, err := fromProtoPreferences(req.Preferences)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	cmd := commands.UpdatePreferences{
+		MemberID:    req.MemberId,
+		Preferences: preferences,
+	}
+
+	if err := h.service.UpdatePreferences(ctx, cmd); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &notificationv1.UpdatePreferencesResponse{
+		Preferences: toProtoPreferences(preferences),
+	}, nil
+}
+
+// toProtoDevice converts a domain device to a protobuf device.
+func toProtoDevice(d *aggregate.Device) *notificationv1.Device {
+	return &notificationv1.Device{
+		Token:        d.Token(),
+		MemberId:     d.MemberID(),
+		Platform:     toProtoPlatform(d.Platform()),
+		RegisteredAt: timestamppb.New(d.RegisteredAt()),
+	}
+}
+
+func toProtoPlatform(p aggregate.Platform) notificationv1.Platform {
+	switch p {
+	case aggregate.PlatformIOS:
+		return notificationv1.Platform_PLATFORM_IOS
+	default:
+		return notificationv1.Platform_PLATFORM_UNSPECIFIED
+	}
+}
+
+func fromProtoPlatform(p notificationv1.Platform) aggregate.Platform {
+	switch p {
+	case notificationv1.Platform_PLATFORM_IOS:
+		return aggregate.PlatformIOS
+	default:
+		return ""
+	}
+}
+
+func toProtoPreferences(p valueobject.Preferences) *notificationv1.Preferences {
+	preferences := &notificationv1.Preferences{
+		Matches:    p.Matches,
+		Messages:   p.Messages,
+		Moderation: p.Moderation,
+	}
+	if !p.QuietHours.IsZero() {
+		preferences.QuietHours = &notificationv1.QuietHours{
+			Start:    p.QuietHours.Start(),
+			End:      p.QuietHours.End(),
+			TimeZone: p.QuietHours.TimeZone(),
+		}
+	}
+	return preferences
+}
+
+func fromProtoPreferences(p *notificationv1.Preferences) (valueobject.Preferences, error) {
+	preferences := valueobject.Preferences{
+		Matches:    p.Matches,
+		Messages:   p.Messages,
+		Moderation: p.Moderation,
+	}
+	if q := p.QuietHours; q != nil {
+		quietHours, err := valueobject.NewQuietHours(q.Start, q.End, q.TimeZone)
+		if err != nil {
+			return valueobject.Preferences{}, err
+		}
+		preferences.QuietHours = quietHours
+	}
+	return preferences, nil
+}
+
+// toGRPCError converts domain errors to gRPC status errors.
+func toGRPCError(err error) error {
+	switch {
+	case errors.Is(err, aggregate.ErrDeviceNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, aggregate.ErrInvalidToken),
+		errors.Is(err, aggregate.ErrInvalidPlatform),
+		errors.Is(err, valueobject.ErrInvalidQuietHours),
+		errors.Is(err, valueobject.ErrEmptyQuietHours),
+		errors.Is(err, valueobject.ErrInvalidTimeZone):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,28 @@
+package grpc
+
+import (
+	notificationv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/notification/v1"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/interceptors"
+)
+
+// Services that call the notification service, as named in
+// SERVICE_TOKENS. Admin is used by operators, for example through grpcurl.
+const (
+	CallerGateway = "gateway"
+	CallerAdmin   = "admin"
+)
+
+// Policies says which services may call each method. Every method acts on
+// a member's devices or preferences, so the gateway must forward that
+// member's access token; a device registered for another member would
+// receive their pushes. Operators may act on any member.
+var Policies = interceptors.Policies{
+	notificationv1.NotificationService_RegisterDevice_FullMethodName:    member(),
+	notificationv1.NotificationService_UnregisterDevice_FullMethodName:  member(),
+	notificationv1.NotificationService_GetPreferences_FullMethodName:    member(),
+	notificationv1.NotificationService_UpdatePreferences_FullMethodName: member(),
+}
+
+func member() interceptors.Policy {
+	return interceptors.Policy{Callers: []string{CallerGateway, CallerAdmin}, Subject: "member_id", Operators: []string{CallerAdmin}}
+}
@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	notificationv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/notification/v1"
+)
+
+// TestPoliciesBindMember fails when a NotificationService method has no
+// policy, or lets the gateway pick the member whose devices or preferences
+// it changes.
+func TestPoliciesBindMember(t *testing.T) {
+	service := notificationv1.NotificationService_ServiceDesc
+	for _, method := range service.Methods {
+		fullMethod := "/" + service.ServiceName + "/" + method.MethodName
+		policy, ok := Policies[fullMethod]
+		if !ok {
+			t.Errorf("%s has no policy", fullMethod)
+			continue
+		}
+
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service.ServiceName + "." + method.MethodName))
+		if err != nil {
+			t.Fatal(err)
+		}
+		input := desc.(protoreflect.MethodDescriptor).Input()
+		if input.Fields().ByName("member_id") != nil && policy.Subject != "member_id" {
+			t.Errorf("%s takes a member_id the caller chooses", fullMethod)
+		}
+	}
+}
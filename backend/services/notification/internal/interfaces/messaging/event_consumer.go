@@ -0,0 +1,158 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/application"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/services/notification/internal/domain/valueobject"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/messaging"
+)
+
+const (
+	topicMatchCreated     = "matching.match_created"
+	topicMessageSent      = "messaging.message_sent"
+	topicModerationAction = "moderation.action_taken"
+
+	actionDismiss = "dismiss"
+)
+
+// matchPayload is the payload of matching.match_created.
+type matchPayload struct {
+	MatchID   string
+	MemberIDs []string
+}
+
+// messagePayload is the payload of messaging.message_sent.
+type messagePayload struct {
+	MessageID      string
+	ConversationID string
+	SenderID       string
+	SenderName     string
+	RecipientID    string
+	Preview        string
+}
+
+// moderationPayload is the payload of moderation.action_taken.
+type moderationPayload struct {
+	CaseID   string
+	MemberID string
+	Action   string
+}
+
+// EventConsumer turns domain events of other services into notifications.
+type EventConsumer struct {
+	service *application.NotificationService
+}
+
+// NewEventConsumer creates a consumer for match, message and moderation events.
+func NewEventConsumer(service *application.NotificationService) *EventConsumer {
+	return &EventConsumer{service: service}
+}
+
+// Start subscribes to the notification-worthy topics until ctx is cancelled.
+func (c *EventConsumer) Start(ctx context.Context, subscriber messaging.Subscriber) error {
+	handlers := map[string]messaging.MessageHandler{
+		topicMatchCreated:     c.handleMatchCreated,
+		topicMessageSent:      c.handleMessageSent,
+		topicModerationAction: c.handleModerationAction,
+	}
+	for topic, handler := range handlers {
+		if err := subscriber.Subscribe(ctx, topic, handler); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *EventConsumer) handleMatchCreated(ctx context.Context, message messaging.Message) error {
+	var payload matchPayload
+	if err := decode(message, &payload); err != nil {
+		return err
+	}
+
+	for _, memberID := range payload.MemberIDs {
+		err := c.service.Notify(ctx, valueobject.Notification{
+			MemberID:   memberID,
+			Category:   valueobject.CategoryMatch,
+			Title:      "It's a match!",
+			Body:       "You have a new match. Say hello!",
+			CollapseID: "match-" + payload.MatchID,
+			Data: map[string]string{
+				"type":     "match",
+				"match_id": payload.MatchID,
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *EventConsumer) handleMessageSent(ctx context.Context, message messaging.Message) error {
+	var payload messagePayload
+	if err := decode(message, &payload); err != nil {
+		return err
+	}
+	if payload.RecipientID == "" {
+		return fmt.Errorf("%s payload is missing the recipient", message.Type)
+	}
+
+	title := payload.SenderName
+	if title == "" {
+		title = "New message"
+	}
+	body := payload.Preview
+	if body == "" {
+		body = "Sent you a message"
+	}
+
+	return c.service.Notify(ctx, valueobject.Notification{
+		MemberID:   payload.RecipientID,
+		Category:   valueobject.CategoryMessage,
+		Title:      title,
+		Body:       body,
+		ThreadID:   payload.ConversationID,
+		CollapseID: "conversation-" + payload.ConversationID,
+		Data: map[string]string{
+			"type":            "message",
+			"conversation_id": payload.ConversationID,
+		},
+	})
+}
+
+func (c *EventConsumer) handleModerationAction(ctx context.Context, message messaging.Message) error {
+	var payload moderationPayload
+	if err := decode(message, &payload); err != nil {
+		return err
+	}
+	if payload.MemberID == "" {
+		return fmt.Errorf("%s payload is missing the member", message.Type)
+	}
+	// A dismissed case leaves the member untouched, and telling them about it
+	// would only reveal that somebody reported them.
+	if payload.Action == actionDismiss {
+		return nil
+	}
+
+	return c.service.Notify(ctx, valueobject.Notification{
+		MemberID: payload.MemberID,
+		Category: valueobject.CategoryModeration,
+		Title:    "Account notice",
+		Body:     "We reviewed activity on your account. Open the app for details.",
+		Data: map[string]string{
+			"type":    "moderation",
+			"case_id": payload.CaseID,
+			"action":  payload.Action,
+		},
+	})
+}
+
+func decode(message messaging.Message, payload any) error {
+	if err := json.Unmarshal(message.Payload, payload); err != nil {
+		return fmt.Errorf("decode %s payload: %w", message.Type, err)
+	}
+	return nil
+}
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS notification_preferences;
+DROP INDEX IF EXISTS idx_devices_member;
+DROP TABLE IF EXISTS devices;
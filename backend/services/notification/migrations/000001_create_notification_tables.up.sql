@@ -0,0 +1,28 @@
+-- Push tokens registered by the mobile apps. A token belongs to one member
+-- at a time; failure columns track the current streak of failed deliveries.
+CREATE TABLE IF NOT EXISTS devices (
+    token VARCHAR(200) PRIMARY KEY,
+    member_id VARCHAR(36) NOT NULL,
+    platform VARCHAR(20) NOT NULL,
+    registered_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_delivered_at TIMESTAMPTZ,
+    failure_count INT NOT NULL DEFAULT 0,
+    last_failure_reason TEXT NOT NULL DEFAULT '',
+    last_failure_at TIMESTAMPTZ
+);
+
+-- Index for looking up a member's devices
+CREATE INDEX idx_devices_member ON devices(member_id);
+
+-- Per-member notification preferences. Members without a row get the
+-- defaults (everything enabled, no quiet hours).
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    member_id VARCHAR(36) PRIMARY KEY,
+    matches BOOLEAN NOT NULL,
+    messages BOOLEAN NOT NULL,
+    moderation BOOLEAN NOT NULL,
+    quiet_start VARCHAR(5),
+    quiet_end VARCHAR(5),
+    time_zone VARCHAR(64),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,166 @@
+// Command devca issues certificates for running the services with mTLS
+// locally. It creates a CA in the output directory, or reuses the one
+// already there, and signs a certificate for each service with:
+//
+//   - DNS names for the service, its Kubernetes service name and localhost
+//   - the SPIFFE ID spiffe://<trust domain>/<service>, which the member
+//     service uses to identify callers
+//
+// Running it again issues fresh service certificates from the same CA,
+// which running services pick up without a restart.
+//
+//	go run ./cmd/devca -out ../../certs
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func main() {
+	out := flag.String("out", "certs", "directory to write the certificates to")
+	services := flag.String("services", "gateway,member,media,notification,moderation", "comma-separated services to issue certificates for")
+	trustDomain := flag.String("trust-domain", "zoekdeware.local", "SPIFFE trust domain")
+	namespace := flag.String("namespace", "dating-app", "Kubernetes namespace added to the DNS names")
+	validFor := flag.Duration("valid-for", 30*24*time.Hour, "how long service certificates are valid")
+	flag.Parse()
+
+	if err := os.MkdirAll(*out, 0o700); err != nil {
+		log.Fatalf("create %s: %v", *out, err)
+	}
+
+	ca, caKey, err := loadOrCreateCA(*out)
+	if err != nil {
+		log.Fatalf("CA: %v", err)
+	}
+
+	for _, service := range strings.Split(*services, ",") {
+		service = strings.TrimSpace(service)
+		if service == "" {
+			continue
+		}
+		if err := issue(*out, service, *trustDomain, *namespace, *validFor, ca, caKey); err != nil {
+			log.Fatalf("issue certificate for %s: %v", service, err)
+		}
+		log.Printf("issued %s", filepath.Join(*out, service+".pem"))
+	}
+}
+
+func loadOrCreateCA(dir string) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	certFile, keyFile := filepath.Join(dir, "ca.pem"), filepath.Join(dir, "ca-key.pem")
+
+	if pair, err := tls.LoadX509KeyPair(certFile, keyFile); err == nil {
+		key, ok := pair.PrivateKey.(*ecdsa.PrivateKey)
+		if !ok {
+			return nil, nil, errors.New("existing CA key is not an ECDSA key")
+		}
+		cert, err := x509.ParseCertificate(pair.Certificate[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		log.Printf("reusing CA in %s", certFile)
+		return cert, key, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber(),
+		Subject:               pkix.Name{CommonName: "zoekdeware development CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(365 * 24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := writePair(certFile, keyFile, der, key); err != nil {
+		return nil, nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, err
+	}
+	log.Printf("created CA in %s", certFile)
+	return cert, key, nil
+}
+
+func issue(dir, service, trustDomain, namespace string, validFor time.Duration, ca *x509.Certificate, caKey *ecdsa.PrivateKey) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	template := &x509.Certificate{
+		SerialNumber: serialNumber(),
+		Subject:      pkix.Name{CommonName: service},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(validFor),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		// Every service is both a server and a client of other services
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames: []string{
+			service,
+			fmt.Sprintf("%s.%s.svc.cluster.local", service, namespace),
+			"localhost",
+		},
+		IPAddresses: []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+		URIs:        []*url.URL{{Scheme: "spiffe", Host: trustDomain, Path: "/" + service}},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	if err != nil {
+		return err
+	}
+	return writePair(filepath.Join(dir, service+".pem"), filepath.Join(dir, service+"-key.pem"), der, key)
+}
+
+// writePair writes the certificate and key, replacing any existing files
+// by rename so a service reloading them never reads a partial file.
+func writePair(certFile, keyFile string, der []byte, key *ecdsa.PrivateKey) error {
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+	if err := writeFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		return err
+	}
+	return writeFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o644)
+}
+
+func writeFile(name string, data []byte, perm os.FileMode) error {
+	tmp := name + ".tmp"
+	if err := os.WriteFile(tmp, data, perm); err != nil {
+		return err
+	}
+	return os.Rename(tmp, name)
+}
+
+func serialNumber() *big.Int {
+	n, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		log.Fatalf("generate serial number: %v", err)
+	}
+	return n
+}
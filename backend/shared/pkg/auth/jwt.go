@@ -2,6 +2,7 @@ package auth
 
 import (
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -12,9 +13,20 @@ var (
 	ErrExpiredToken = errors.New("token has expired")
 )
 
+// TokenTypeRefresh is the type claim of refresh tokens, which may only be
+// exchanged for new tokens and are never accepted as credentials.
+const TokenTypeRefresh = "refresh"
+
 type Claims struct {
 	UserID string `json:"sub"`
 	Email  string `json:"email"`
+	// Roles are the member's staff roles, and Scope the space separated
+	// scopes they grant. Both are empty for ordinary members.
+	Roles []string `json:"roles,omitempty"`
+	Scope string   `json:"scope,omitempty"`
+	// Type is TokenTypeRefresh for refresh tokens and empty for access
+	// tokens.
+	Type string `json:"type,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -38,13 +50,13 @@ func NewJWTService(secret string, accessExpiry, refreshExpiry time.Duration) *JW
 	}
 }
 
-func (s *JWTService) GenerateTokenPair(userID, email string) (*TokenPair, error) {
-	accessToken, expiresAt, err := s.generateToken(userID, email, s.accessExpiry)
+func (s *JWTService) GenerateTokenPair(userID, email string, roles []string) (*TokenPair, error) {
+	accessToken, expiresAt, err := s.generateToken(userID, email, roles, "", s.accessExpiry)
 	if err != nil {
 		return nil, err
 	}
 
-	refreshToken, _, err := s.generateToken(userID, email, s.refreshExpiry)
+	refreshToken, _, err := s.generateToken(userID, email, roles, TokenTypeRefresh, s.refreshExpiry)
 	if err != nil {
 		return nil, err
 	}
@@ -56,11 +68,14 @@ func (s *JWTService) GenerateTokenPair(userID, email string) (*TokenPair, error)
 	}, nil
 }
 
-func (s *JWTService) generateToken(userID, email string, expiry time.Duration) (string, int64, error) {
+func (s *JWTService) generateToken(userID, email string, roles []string, tokenType string, expiry time.Duration) (string, int64, error) {
 	expiresAt := time.Now().Add(expiry)
 	claims := Claims{
 		UserID: userID,
 		Email:  email,
+		Roles:  roles,
+		Scope:  strings.Join(ScopesFor(roles), " "),
+		Type:   tokenType,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"slices"
+	"strings"
+)
+
+// Roles staff members can be given. Members without a role only act on
+// themselves.
+const (
+	// RoleSupport can look members up and read their history.
+	RoleSupport = "support"
+	// RoleAdmin can also suspend and reinstate members, sign them out,
+	// grant roles and read the audit log.
+	RoleAdmin = "admin"
+)
+
+// Scopes carried in access tokens, each allowing a group of admin
+// endpoints.
+const (
+	ScopeMembersRead    = "members:read"
+	ScopeMembersWrite   = "members:write"
+	ScopeSessionsRevoke = "sessions:revoke"
+	ScopeAuditRead      = "audit:read"
+)
+
+var roleScopes = map[string][]string{
+	RoleSupport: {ScopeMembersRead},
+	RoleAdmin:   {ScopeMembersRead, ScopeMembersWrite, ScopeSessionsRevoke, ScopeAuditRead},
+}
+
+// ValidRole reports whether role is one of the known roles.
+func ValidRole(role string) bool {
+	_, ok := roleScopes[role]
+	return ok
+}
+
+// ScopesFor returns the scopes granted by roles, sorted and without
+// duplicates. Unknown roles grant nothing.
+func ScopesFor(roles []string) []string {
+	var scopes []string
+	for _, role := range roles {
+		scopes = append(scopes, roleScopes[role]...)
+	}
+	slices.Sort(scopes)
+	return slices.Compact(scopes)
+}
+
+// HasScope reports whether the token's space separated scope claim
+// includes scope.
+func (c *Claims) HasScope(scope string) bool {
+	return slices.Contains(strings.Fields(c.Scope), scope)
+}
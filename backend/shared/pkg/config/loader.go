@@ -0,0 +1,304 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Load fills the struct cfg points to from, in increasing priority:
+//
+//   - the field's default tag
+//   - the YAML file named by CONFIG_FILE, a flat mapping of variable
+//     names, such as "HTTP_ADDR: :8000", to values
+//   - the environment variable named by the field's env tag, or the file
+//     named by the variable with a _FILE suffix, for secrets mounted as
+//     files
+//
+// Fields are tagged like this:
+//
+//	JWTSecret string `env:"JWT_SECRET" default:"change-me" insecure:"true" secret:"true"`
+//
+// The supported tags are:
+//
+//   - env: the variable to read; fields without it are left alone
+//   - default: the value used when nothing else sets one
+//   - required:"true": the field must be set
+//   - oneof:"a,b": the allowed values
+//   - secret:"true": Print redacts the value
+//   - insecure:"true": the default is for development only, so it must
+//     be replaced when ENVIRONMENT is production
+//
+// Fields may be strings, booleans, integers, floats, durations, string
+// slices (comma-separated) and structs holding more fields. After loading,
+// Load calls cfg's Validate method, if it has one. It reports every problem
+// at once, joined into one error.
+func Load(cfg any) error {
+	v := reflect.ValueOf(cfg)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("config: Load needs a pointer to a struct, not %T", cfg)
+	}
+
+	fileValues, err := readFile(os.Getenv("CONFIG_FILE"))
+	if err != nil {
+		return err
+	}
+
+	l := &loader{file: fileValues}
+	l.loadStruct(v.Elem())
+
+	production := l.values["ENVIRONMENT"] == "production"
+	if !l.seen["ENVIRONMENT"] {
+		production = os.Getenv("ENVIRONMENT") == "production"
+	}
+	if production {
+		for _, name := range l.insecure {
+			l.errs = append(l.errs, fmt.Errorf("%s: must be set in production; the default is for development only", name))
+		}
+	}
+
+	if validator, ok := cfg.(interface{ Validate() error }); ok {
+		if err := validator.Validate(); err != nil {
+			l.errs = append(l.errs, err)
+		}
+	}
+	return errors.Join(l.errs...)
+}
+
+// MustLoad loads cfg and exits when it is invalid, listing every problem.
+// Started with --print-config, it prints the configuration with secrets
+// redacted and exits instead of returning.
+func MustLoad(cfg any) {
+	err := Load(cfg)
+
+	if slices.Contains(os.Args[1:], "--print-config") {
+		Print(os.Stdout, cfg)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "\ninvalid configuration:\n%v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid configuration:\n%v\n", err)
+		os.Exit(1)
+	}
+}
+
+// Print writes cfg as NAME=value lines, with secret values redacted.
+func Print(w io.Writer, cfg any) {
+	printStruct(w, reflect.Indirect(reflect.ValueOf(cfg)))
+}
+
+func printStruct(w io.Writer, v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field, value := t.Field(i), v.Field(i)
+		name := field.Tag.Get("env")
+		if name == "" {
+			if isNested(field) {
+				printStruct(w, value)
+			}
+			continue
+		}
+
+		text := format(value)
+		if field.Tag.Get("secret") == "true" && text != "" {
+			text = "[REDACTED]"
+		}
+		fmt.Fprintf(w, "%s=%s\n", name, text)
+	}
+}
+
+type loader struct {
+	file     map[string]string
+	values   map[string]string
+	seen     map[string]bool
+	insecure []string
+	errs     []error
+}
+
+func (l *loader) loadStruct(v reflect.Value) {
+	if l.values == nil {
+		l.values, l.seen = make(map[string]string), make(map[string]bool)
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := field.Tag.Get("env")
+		if name == "" {
+			if isNested(field) {
+				l.loadStruct(v.Field(i))
+			}
+			continue
+		}
+		if !field.IsExported() {
+			l.errs = append(l.errs, fmt.Errorf("%s: field %s is not exported", name, field.Name))
+			continue
+		}
+
+		raw, set, err := l.lookup(name)
+		if err != nil {
+			l.errs = append(l.errs, err)
+			continue
+		}
+		defaultValue, hasDefault := field.Tag.Lookup("default")
+		if !set {
+			if field.Tag.Get("required") == "true" {
+				l.errs = append(l.errs, fmt.Errorf("%s: is required", name))
+				continue
+			}
+			if !hasDefault {
+				continue
+			}
+			raw = defaultValue
+		}
+		l.values[name], l.seen[name] = raw, true
+
+		if err := parse(v.Field(i), raw); err != nil {
+			l.errs = append(l.errs, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+		if allowed := field.Tag.Get("oneof"); allowed != "" && !slices.Contains(strings.Split(allowed, ","), raw) {
+			l.errs = append(l.errs, fmt.Errorf("%s: %q is not one of %s", name, raw, allowed))
+		}
+		if field.Tag.Get("insecure") == "true" && hasDefault && raw == defaultValue {
+			l.insecure = append(l.insecure, name)
+		}
+	}
+}
+
+// lookup returns the value for name from the environment or the file, and
+// whether any source set it.
+func (l *loader) lookup(name string) (string, bool, error) {
+	value, inEnv := os.LookupEnv(name)
+	path, inFile := os.LookupEnv(name + "_FILE")
+
+	switch {
+	case inEnv && inFile:
+		return "", false, fmt.Errorf("%s: set either %s or %s_FILE, not both", name, name, name)
+	case inFile:
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", false, fmt.Errorf("%s_FILE: %w", name, err)
+		}
+		// Secret files usually end in a newline that is not part of the value
+		return strings.TrimRight(string(data), "\r\n"), true, nil
+	case inEnv && value != "":
+		return value, true, nil
+	}
+
+	value, ok := l.file[name]
+	return value, ok, nil
+}
+
+func isNested(field reflect.StructField) bool {
+	return field.Type.Kind() == reflect.Struct && field.Type != reflect.TypeOf(time.Time{})
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+func parse(v reflect.Value, raw string) error {
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("%q is not a duration", raw)
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("%q is not a boolean", raw)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%q is not an integer", raw)
+		}
+		v.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(raw, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%q is not a number", raw)
+		}
+		v.SetFloat(f)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported type %s", v.Type())
+		}
+		var items []string
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		v.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+func format(v reflect.Value) string {
+	if v.Type() == durationType {
+		return time.Duration(v.Int()).String()
+	}
+	if v.Kind() == reflect.Slice {
+		return strings.Join(v.Interface().([]string), ",")
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// readFile reads a flat YAML mapping of variable names to values. Lists
+// become comma-separated values.
+func readFile(path string) (map[string]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("config: %w", err)
+	}
+
+	var raw map[string]any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("config: %s: %w", path, err)
+	}
+
+	values := make(map[string]string, len(raw))
+	for key, value := range raw {
+		name := strings.ToUpper(key)
+		switch value := value.(type) {
+		case nil:
+			continue
+		case []any:
+			items := make([]string, len(value))
+			for i, item := range value {
+				items[i] = fmt.Sprint(item)
+			}
+			values[name] = strings.Join(items, ",")
+		case map[string]any:
+			return nil, fmt.Errorf("config: %s: %s must be a value, not a mapping", path, key)
+		default:
+			values[name] = fmt.Sprint(value)
+		}
+	}
+	return values, nil
+}
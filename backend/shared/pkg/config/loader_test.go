@@ -0,0 +1,89 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testConfig struct {
+	Window  time.Duration `env:"TEST_WINDOW" default:"30s"`
+	UseSSL  bool          `env:"TEST_USE_SSL" default:"false"`
+	Token   string        `env:"TEST_TOKEN" required:"true" secret:"true"`
+	Backend string        `env:"TEST_BACKEND" default:"local" oneof:"local,s3"`
+	Secret  string        `env:"TEST_SECRET" default:"dev-secret" insecure:"true"`
+}
+
+func TestLoadDefaults(t *testing.T) {
+	t.Setenv("TEST_TOKEN", "token")
+
+	var cfg testConfig
+	if err := Load(&cfg); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Window != 30*time.Second || cfg.UseSSL || cfg.Backend != "local" || cfg.Token != "token" {
+		t.Errorf("loaded %+v", cfg)
+	}
+}
+
+// Invalid values are reported rather than replaced by the default.
+func TestLoadReportsEveryInvalidValue(t *testing.T) {
+	t.Setenv("TEST_WINDOW", "abc")
+	t.Setenv("TEST_USE_SSL", "maybe")
+	t.Setenv("TEST_BACKEND", "ftp")
+
+	var cfg testConfig
+	err := Load(&cfg)
+	if err == nil {
+		t.Fatal("Load succeeded")
+	}
+	for _, want := range []string{
+		`TEST_WINDOW: "abc" is not a duration`,
+		`TEST_USE_SSL: "maybe" is not a boolean`,
+		`TEST_BACKEND: "ftp" is not one of local,s3`,
+		`TEST_TOKEN: is required`,
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not report %q", err, want)
+		}
+	}
+}
+
+func TestLoadRejectsDevelopmentDefaultsInProduction(t *testing.T) {
+	t.Setenv("ENVIRONMENT", "production")
+	t.Setenv("TEST_TOKEN", "token")
+
+	var cfg testConfig
+	err := Load(&cfg)
+	if err == nil || !strings.Contains(err.Error(), "TEST_SECRET: must be set in production") {
+		t.Fatalf("err = %v, want TEST_SECRET rejected", err)
+	}
+
+	t.Setenv("TEST_SECRET", "a-real-secret")
+	if err := Load(&cfg); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+}
+
+func TestLoadReadsSecretFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("TEST_TOKEN_FILE", path)
+
+	var cfg testConfig
+	if err := Load(&cfg); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Token != "from-file" {
+		t.Errorf("Token = %q, want from-file", cfg.Token)
+	}
+
+	t.Setenv("TEST_TOKEN", "from-env")
+	if err := Load(&cfg); err == nil {
+		t.Error("Load accepted both TEST_TOKEN and TEST_TOKEN_FILE")
+	}
+}
@@ -0,0 +1,102 @@
+// Package correlation carries request metadata from the gateway through
+// gRPC calls and into stored events, so everything one request caused can
+// be traced back to it.
+package correlation
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/eventstore"
+)
+
+// HeaderRequestID is the HTTP header clients may use to send their own
+// request ID; the gateway returns it on every response.
+const HeaderRequestID = "X-Request-ID"
+
+// gRPC metadata keys. The user ID is recorded for auditing only and must
+// not be used for authorization: any caller on the internal network can
+// set it.
+const (
+	mdRequestID   = "x-request-id"
+	mdCausationID = "x-causation-id"
+	mdUserID      = "x-user-id"
+)
+
+type contextKey struct{}
+
+// NewContext returns a context carrying the metadata.
+func NewContext(ctx context.Context, md eventstore.Metadata) context.Context {
+	return context.WithValue(ctx, contextKey{}, md)
+}
+
+// FromContext returns the metadata carried by ctx, or the zero value.
+func FromContext(ctx context.Context) eventstore.Metadata {
+	md, _ := ctx.Value(contextKey{}).(eventstore.Metadata)
+	return md
+}
+
+// UnaryClientInterceptor forwards the request ID, causation ID and user ID
+// from the context to the called service.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoingContext(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor is UnaryClientInterceptor for streaming calls.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoingContext(ctx), desc, cc, method, opts...)
+	}
+}
+
+func outgoingContext(ctx context.Context) context.Context {
+	md := FromContext(ctx)
+	var pairs []string
+	if md.CorrelationID != "" {
+		pairs = append(pairs, mdRequestID, md.CorrelationID)
+	}
+	if md.CausationID != "" {
+		pairs = append(pairs, mdCausationID, md.CausationID)
+	}
+	if md.UserID != "" {
+		pairs = append(pairs, mdUserID, md.UserID)
+	}
+	if len(pairs) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, pairs...)
+}
+
+// UnaryServerInterceptor puts the request ID, causation ID and user ID sent
+// by the caller into the handler's context. The request ID correlates
+// whatever the call changes. The causation ID is only set when the call was
+// made while handling a message, whose ID it then is; a call made for a
+// client request has no causing message or event, so it is left empty.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(incomingContext(ctx), req)
+	}
+}
+
+func incomingContext(ctx context.Context) context.Context {
+	incoming, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	md := FromContext(ctx)
+	if v := incoming.Get(mdRequestID); len(v) > 0 {
+		md.CorrelationID = v[0]
+	}
+	if v := incoming.Get(mdCausationID); len(v) > 0 {
+		md.CausationID = v[0]
+	}
+	if v := incoming.Get(mdUserID); len(v) > 0 {
+		md.UserID = v[0]
+	}
+	return NewContext(ctx, md)
+}
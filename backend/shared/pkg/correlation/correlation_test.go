@@ -0,0 +1,54 @@
+package correlation
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/eventstore"
+)
+
+// roundTrip sends md from a client to a server through the interceptors and
+// returns what the server's handler sees.
+func roundTrip(t *testing.T, md eventstore.Metadata) eventstore.Metadata {
+	t.Helper()
+	var sent metadata.MD
+	invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		sent, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+	if err := UnaryClientInterceptor()(NewContext(context.Background(), md), "/test.Service/Call", nil, nil, nil, invoker); err != nil {
+		t.Fatal(err)
+	}
+
+	var received eventstore.Metadata
+	handler := func(ctx context.Context, _ any) (any, error) {
+		received = FromContext(ctx)
+		return nil, nil
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), sent)
+	if _, err := UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Call"}, handler); err != nil {
+		t.Fatal(err)
+	}
+	return received
+}
+
+// A call made for a client request has a correlation ID but nothing that
+// caused it; the request ID must not be recorded as the cause.
+func TestRequestHasNoCausation(t *testing.T) {
+	got := roundTrip(t, eventstore.Metadata{CorrelationID: "request-1", UserID: "member-1"})
+	want := eventstore.Metadata{CorrelationID: "request-1", UserID: "member-1"}
+	if got != want {
+		t.Errorf("server saw %+v, want %+v", got, want)
+	}
+}
+
+// A call made while handling a message names the message as its cause.
+func TestMessageCausationIsForwarded(t *testing.T) {
+	got := roundTrip(t, eventstore.Metadata{CorrelationID: "request-1", CausationID: "member-1-42"})
+	if got.CorrelationID != "request-1" || got.CausationID != "member-1-42" {
+		t.Errorf("server saw %+v, want correlation request-1 caused by member-1-42", got)
+	}
+}
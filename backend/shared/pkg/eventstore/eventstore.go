@@ -20,6 +20,9 @@ type Metadata struct {
 	CorrelationID string `json:"correlation_id,omitempty"`
 	CausationID   string `json:"causation_id,omitempty"`
 	UserID        string `json:"user_id,omitempty"`
+	// TraceParent is the W3C trace context of the request, so that
+	// publishing the event later continues its trace.
+	TraceParent string `json:"traceparent,omitempty"`
 }
 
 type EventStore interface {
@@ -0,0 +1,88 @@
+package experiments
+
+import "math"
+
+// VariantResult is the outcome of one variant: the members exposed to it,
+// how many of them converted by making at least one match, and how many
+// matches they made in total.
+type VariantResult struct {
+	Variant   string
+	Members   int
+	Converted int
+	Matches   int
+}
+
+// ConversionRate is the share of exposed members that made a match.
+func (r VariantResult) ConversionRate() float64 {
+	if r.Members == 0 {
+		return 0
+	}
+	return float64(r.Converted) / float64(r.Members)
+}
+
+// MatchesPerMember is the mean number of matches per exposed member.
+func (r VariantResult) MatchesPerMember() float64 {
+	if r.Members == 0 {
+		return 0
+	}
+	return float64(r.Matches) / float64(r.Members)
+}
+
+// Comparison compares a variant's conversion rate with the control's.
+type Comparison struct {
+	// Lift is the relative change in conversion rate, e.g. 0.1 for 10%
+	// more members matching than in the control.
+	Lift float64
+	// PValue is the two-sided p-value of a two-proportion z-test; below
+	// 0.05 the difference is unlikely to be chance.
+	PValue float64
+}
+
+// Compare compares variant with control. Without members or conversions
+// in both there is nothing to compare, and the p-value is 1.
+func Compare(control, variant VariantResult) Comparison {
+	comparison := Comparison{PValue: 1}
+	if control.Members == 0 || variant.Members == 0 {
+		return comparison
+	}
+
+	p1, p2 := control.ConversionRate(), variant.ConversionRate()
+	if p1 > 0 {
+		comparison.Lift = (p2 - p1) / p1
+	}
+
+	pooled := float64(control.Converted+variant.Converted) / float64(control.Members+variant.Members)
+	se := math.Sqrt(pooled * (1 - pooled) * (1/float64(control.Members) + 1/float64(variant.Members)))
+	if se == 0 {
+		return comparison
+	}
+	z := (p2 - p1) / se
+	comparison.PValue = math.Erfc(math.Abs(z) / math.Sqrt2)
+	return comparison
+}
+
+// InVariantOrder orders results as the experiment lists its variants, so
+// the control comes first. Variants without exposures get an empty result
+// and results for variants no longer defined are kept at the end.
+func InVariantOrder(results []VariantResult, experiment Experiment) []VariantResult {
+	byVariant := make(map[string]VariantResult, len(results))
+	for _, result := range results {
+		byVariant[result.Variant] = result
+	}
+
+	ordered := make([]VariantResult, 0, len(results))
+	for _, variant := range experiment.Variants {
+		result, ok := byVariant[variant.Name]
+		if !ok {
+			result = VariantResult{Variant: variant.Name}
+		}
+		ordered = append(ordered, result)
+		delete(byVariant, variant.Name)
+	}
+	for _, result := range results {
+		if _, ok := byVariant[result.Variant]; ok {
+			ordered = append(ordered, result)
+		}
+	}
+	return ordered
+}
@@ -0,0 +1,124 @@
+package experiments
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/messaging"
+)
+
+// Topics the experiments package publishes on.
+const (
+	// ChangedTopic announces that an experiment was set or deleted.
+	ChangedTopic = "experiments.changed"
+	// ExposureTopic carries an Exposure each time a member is shown a
+	// variant.
+	ExposureTopic = "experiments.exposure"
+)
+
+// Exposure is the payload of ExposureTopic.
+type Exposure struct {
+	Experiment string `json:"experiment"`
+	Variant    string `json:"variant"`
+	MemberID   string `json:"member_id"`
+}
+
+// Source lists the current experiments.
+type Source interface {
+	ListExperiments(ctx context.Context) ([]Experiment, error)
+}
+
+// Client assigns variants from a local copy of the experiments, so an
+// assignment never waits on the network. Until the first refresh succeeds
+// no member takes part in any experiment.
+type Client struct {
+	source    Source
+	publisher messaging.Publisher
+
+	mu          sync.RWMutex
+	experiments map[string]Experiment
+}
+
+// NewClient creates a client that copies experiments from source and
+// reports exposures to publisher. Without a publisher, members are still
+// assigned but their exposures are not recorded.
+func NewClient(source Source, publisher messaging.Publisher) *Client {
+	return &Client{source: source, publisher: publisher, experiments: make(map[string]Experiment)}
+}
+
+// Refresh replaces the local copy with the experiments from the source.
+func (c *Client) Refresh(ctx context.Context) error {
+	experiments, err := c.source.ListExperiments(ctx)
+	if err != nil {
+		return err
+	}
+
+	byKey := make(map[string]Experiment, len(experiments))
+	for _, experiment := range experiments {
+		byKey[experiment.Key] = experiment
+	}
+	c.mu.Lock()
+	c.experiments = byKey
+	c.mu.Unlock()
+	return nil
+}
+
+// Run refreshes the experiments every interval until ctx is cancelled. It
+// keeps the copy current when a change notification is missed.
+func (c *Client) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := c.Refresh(ctx); err != nil && ctx.Err() == nil {
+			slog.WarnContext(ctx, "failed to refresh experiments", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Listen refreshes the experiments whenever a change is announced on
+// ChangedTopic.
+func (c *Client) Listen(ctx context.Context, subscriber messaging.BroadcastSubscriber) error {
+	return subscriber.SubscribeAll(ctx, ChangedTopic, func(ctx context.Context, _ messaging.Message) error {
+		return c.Refresh(ctx)
+	})
+}
+
+// Assign returns the variant of experiment key the member with userID is
+// in, and reports the exposure. Call it only when the member is about to
+// see the variant, so the analysis counts exactly the members it affected.
+func (c *Client) Assign(ctx context.Context, key, userID string) (string, bool) {
+	c.mu.RLock()
+	experiment, ok := c.experiments[key]
+	c.mu.RUnlock()
+	if !ok {
+		return "", false
+	}
+
+	variant, ok := experiment.Assign(userID)
+	if ok {
+		c.expose(ctx, Exposure{Experiment: key, Variant: variant, MemberID: userID})
+	}
+	return variant, ok
+}
+
+// expose publishes an exposure. A failure loses the exposure from the
+// analysis but must not change what the member sees.
+func (c *Client) expose(ctx context.Context, exposure Exposure) {
+	if c.publisher == nil {
+		return
+	}
+	payload, _ := json.Marshal(exposure)
+	err := c.publisher.Publish(ctx, ExposureTopic, messaging.Message{Type: ExposureTopic, Payload: payload})
+	if err != nil {
+		slog.WarnContext(ctx, "failed to report experiment exposure", "experiment", exposure.Experiment, "error", err)
+	}
+}
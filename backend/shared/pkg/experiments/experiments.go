@@ -0,0 +1,111 @@
+// Package experiments runs A/B experiments. Experiments are defined in
+// Postgres by the service that owns them and served over gRPC; every other
+// service keeps a local copy in a Client, assigns members to variants
+// itself and reports each exposure on the broker. The owning service
+// records exposures and matches so results can be analysed offline.
+package experiments
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"time"
+)
+
+// Experiment statuses. Only running experiments assign members.
+const (
+	StatusDraft   = "draft"
+	StatusRunning = "running"
+	StatusStopped = "stopped"
+)
+
+var keyPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.-]*$`)
+
+// Experiment splits members between variants. AllocationPercent of members
+// take part; each of them is assigned a variant in proportion to the
+// variants' weights. The first variant is the control.
+type Experiment struct {
+	Key               string    `json:"key"`
+	Description       string    `json:"description"`
+	Status            string    `json:"status"`
+	AllocationPercent int       `json:"allocation_percent"`
+	Variants          []Variant `json:"variants"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// Variant is one arm of an experiment.
+type Variant struct {
+	Name   string `json:"name"`
+	Weight int    `json:"weight"`
+}
+
+// Assign returns the variant of the member with userID, or false when the
+// experiment is not running or the member does not take part. A member
+// keeps their variant for as long as the variants are unchanged, and
+// raising the allocation only adds members.
+func (e Experiment) Assign(userID string) (string, bool) {
+	if e.Status != StatusRunning || userID == "" {
+		return "", false
+	}
+	if hash(e.Key+":"+userID)%100 >= uint32(e.AllocationPercent) {
+		return "", false
+	}
+
+	total := 0
+	for _, variant := range e.Variants {
+		total += variant.Weight
+	}
+	if total <= 0 {
+		return "", false
+	}
+
+	// A second hash picks the variant, so changing the allocation does
+	// not move members between variants
+	point := int(hash(e.Key+":variant:"+userID) % uint32(total))
+	for _, variant := range e.Variants {
+		if point < variant.Weight {
+			return variant.Name, true
+		}
+		point -= variant.Weight
+	}
+	return "", false
+}
+
+func hash(s string) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(s))
+	return h.Sum32()
+}
+
+// Validate reports every problem with the experiment's definition.
+func (e Experiment) Validate() error {
+	var errs []error
+	if !keyPattern.MatchString(e.Key) {
+		errs = append(errs, fmt.Errorf("key %q must be lowercase letters, digits, '_', '.' or '-'", e.Key))
+	}
+	switch e.Status {
+	case StatusDraft, StatusRunning, StatusStopped:
+	default:
+		errs = append(errs, fmt.Errorf("status %q must be %s, %s or %s", e.Status, StatusDraft, StatusRunning, StatusStopped))
+	}
+	if e.AllocationPercent < 0 || e.AllocationPercent > 100 {
+		errs = append(errs, errors.New("allocation_percent must be between 0 and 100"))
+	}
+	if len(e.Variants) < 2 {
+		errs = append(errs, errors.New("an experiment needs at least two variants"))
+	}
+	seen := make(map[string]bool, len(e.Variants))
+	for i, variant := range e.Variants {
+		if variant.Name == "" {
+			errs = append(errs, fmt.Errorf("variant %d needs a name", i+1))
+		} else if seen[variant.Name] {
+			errs = append(errs, fmt.Errorf("variant %q appears more than once", variant.Name))
+		}
+		seen[variant.Name] = true
+		if variant.Weight <= 0 {
+			errs = append(errs, fmt.Errorf("variant %d needs a positive weight", i+1))
+		}
+	}
+	return errors.Join(errs...)
+}
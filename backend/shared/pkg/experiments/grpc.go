@@ -0,0 +1,132 @@
+package experiments
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	experimentsv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/experiments/v1"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/messaging"
+)
+
+// Server serves experiments from a PostgresStore and announces every
+// change on ChangedTopic.
+type Server struct {
+	experimentsv1.UnimplementedExperimentServiceServer
+	store     *PostgresStore
+	publisher messaging.Publisher
+}
+
+// NewServer creates a server for store. Without a publisher, clients only
+// see changes on their next periodic refresh.
+func NewServer(store *PostgresStore, publisher messaging.Publisher) *Server {
+	return &Server{store: store, publisher: publisher}
+}
+
+func (s *Server) ListExperiments(ctx context.Context, _ *experimentsv1.ListExperimentsRequest) (*experimentsv1.ListExperimentsResponse, error) {
+	experiments, err := s.store.ListExperiments(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &experimentsv1.ListExperimentsResponse{Experiments: make([]*experimentsv1.Experiment, len(experiments))}
+	for i, experiment := range experiments {
+		resp.Experiments[i] = toProto(experiment)
+	}
+	return resp, nil
+}
+
+func (s *Server) SetExperiment(ctx context.Context, req *experimentsv1.SetExperimentRequest) (*experimentsv1.SetExperimentResponse, error) {
+	experiment := fromProto(req.Experiment)
+	if err := experiment.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	stored, err := s.store.SetExperiment(ctx, experiment)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.announce(ctx, stored.Key)
+	return &experimentsv1.SetExperimentResponse{Experiment: toProto(stored)}, nil
+}
+
+func (s *Server) DeleteExperiment(ctx context.Context, req *experimentsv1.DeleteExperimentRequest) (*experimentsv1.DeleteExperimentResponse, error) {
+	if err := s.store.DeleteExperiment(ctx, req.Key); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.announce(ctx, req.Key)
+	return &experimentsv1.DeleteExperimentResponse{}, nil
+}
+
+// announce tells clients to refresh. The change is already stored, so a
+// failure only delays it until their next periodic refresh.
+func (s *Server) announce(ctx context.Context, key string) {
+	if s.publisher == nil {
+		return
+	}
+	payload, _ := json.Marshal(map[string]string{"key": key})
+	err := s.publisher.Publish(ctx, ChangedTopic, messaging.Message{Type: ChangedTopic, Payload: payload})
+	if err != nil {
+		slog.WarnContext(ctx, "failed to announce experiment change", "experiment", key, "error", err)
+	}
+}
+
+// GRPCSource lists experiments from an ExperimentService, for services
+// that do not own the experiments.
+func GRPCSource(client experimentsv1.ExperimentServiceClient) Source {
+	return grpcSource{client: client}
+}
+
+type grpcSource struct {
+	client experimentsv1.ExperimentServiceClient
+}
+
+func (s grpcSource) ListExperiments(ctx context.Context) ([]Experiment, error) {
+	resp, err := s.client.ListExperiments(ctx, &experimentsv1.ListExperimentsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	experiments := make([]Experiment, len(resp.Experiments))
+	for i, experiment := range resp.Experiments {
+		experiments[i] = fromProto(experiment)
+	}
+	return experiments, nil
+}
+
+func toProto(experiment Experiment) *experimentsv1.Experiment {
+	variants := make([]*experimentsv1.Variant, len(experiment.Variants))
+	for i, variant := range experiment.Variants {
+		variants[i] = &experimentsv1.Variant{Name: variant.Name, Weight: int32(variant.Weight)}
+	}
+	return &experimentsv1.Experiment{
+		Key:               experiment.Key,
+		Description:       experiment.Description,
+		Status:            experiment.Status,
+		AllocationPercent: int32(experiment.AllocationPercent),
+		Variants:          variants,
+		UpdatedAt:         timestamppb.New(experiment.UpdatedAt),
+	}
+}
+
+func fromProto(experiment *experimentsv1.Experiment) Experiment {
+	variants := make([]Variant, len(experiment.GetVariants()))
+	for i, variant := range experiment.GetVariants() {
+		variants[i] = Variant{Name: variant.Name, Weight: int(variant.Weight)}
+	}
+	return Experiment{
+		Key:               experiment.GetKey(),
+		Description:       experiment.GetDescription(),
+		Status:            experiment.GetStatus(),
+		AllocationPercent: int(experiment.GetAllocationPercent()),
+		Variants:          variants,
+		UpdatedAt:         experiment.GetUpdatedAt().AsTime(),
+	}
+}
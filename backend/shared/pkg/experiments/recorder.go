@@ -0,0 +1,56 @@
+package experiments
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/messaging"
+)
+
+// MatchCreatedTopic announces a match between members.
+const MatchCreatedTopic = "matching.match_created"
+
+// matchPayload is the payload of matching.match_created.
+type matchPayload struct {
+	MatchID   string
+	MemberIDs []string
+}
+
+// Recorder stores exposures and matches for analysis. It shares the
+// messages between instances, so each is recorded once.
+type Recorder struct {
+	store *PostgresStore
+}
+
+// NewRecorder creates a recorder that writes to store.
+func NewRecorder(store *PostgresStore) *Recorder {
+	return &Recorder{store: store}
+}
+
+// Start subscribes to exposures and matches until ctx is cancelled.
+func (r *Recorder) Start(ctx context.Context, subscriber messaging.Subscriber) error {
+	if err := subscriber.Subscribe(ctx, ExposureTopic, r.handleExposure); err != nil {
+		return fmt.Errorf("subscribe to %s: %w", ExposureTopic, err)
+	}
+	if err := subscriber.Subscribe(ctx, MatchCreatedTopic, r.handleMatchCreated); err != nil {
+		return fmt.Errorf("subscribe to %s: %w", MatchCreatedTopic, err)
+	}
+	return nil
+}
+
+func (r *Recorder) handleExposure(ctx context.Context, message messaging.Message) error {
+	var exposure Exposure
+	if err := json.Unmarshal(message.Payload, &exposure); err != nil {
+		return fmt.Errorf("unmarshal exposure: %w", err)
+	}
+	return r.store.RecordExposure(ctx, exposure, message.PublishedAt)
+}
+
+func (r *Recorder) handleMatchCreated(ctx context.Context, message messaging.Message) error {
+	var payload matchPayload
+	if err := json.Unmarshal(message.Payload, &payload); err != nil {
+		return fmt.Errorf("unmarshal match: %w", err)
+	}
+	return r.store.RecordMatch(ctx, payload.MatchID, payload.MemberIDs, message.PublishedAt)
+}
@@ -0,0 +1,159 @@
+package experiments
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrNotFound is returned for an experiment that does not exist.
+var ErrNotFound = errors.New("experiment not found")
+
+// PostgresStore keeps experiment definitions in the experiments table and
+// the recorded exposures and matches they are analysed with.
+type PostgresStore struct {
+	db *sql.DB
+}
+
+// NewPostgresStore creates a store on db.
+func NewPostgresStore(db *sql.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// ListExperiments returns every experiment, ordered by key.
+func (s *PostgresStore) ListExperiments(ctx context.Context) ([]Experiment, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT key, description, status, allocation_percent, variants, updated_at
+		FROM experiments
+		ORDER BY key
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("query experiments: %w", err)
+	}
+	defer rows.Close()
+
+	var experiments []Experiment
+	for rows.Next() {
+		var (
+			experiment Experiment
+			variants   []byte
+		)
+		if err := rows.Scan(&experiment.Key, &experiment.Description, &experiment.Status,
+			&experiment.AllocationPercent, &variants, &experiment.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("scan experiment: %w", err)
+		}
+		if err := json.Unmarshal(variants, &experiment.Variants); err != nil {
+			return nil, fmt.Errorf("unmarshal variants of %s: %w", experiment.Key, err)
+		}
+		experiments = append(experiments, experiment)
+	}
+	return experiments, rows.Err()
+}
+
+// SetExperiment creates or replaces an experiment and returns it as
+// stored.
+func (s *PostgresStore) SetExperiment(ctx context.Context, experiment Experiment) (Experiment, error) {
+	variants, err := json.Marshal(experiment.Variants)
+	if err != nil {
+		return Experiment{}, fmt.Errorf("marshal variants: %w", err)
+	}
+
+	err = s.db.QueryRowContext(ctx, `
+		INSERT INTO experiments (key, description, status, allocation_percent, variants, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		ON CONFLICT (key) DO UPDATE SET
+			description = EXCLUDED.description,
+			status = EXCLUDED.status,
+			allocation_percent = EXCLUDED.allocation_percent,
+			variants = EXCLUDED.variants,
+			updated_at = EXCLUDED.updated_at
+		RETURNING updated_at
+	`, experiment.Key, experiment.Description, experiment.Status, experiment.AllocationPercent, variants).Scan(&experiment.UpdatedAt)
+	if err != nil {
+		return Experiment{}, fmt.Errorf("store experiment: %w", err)
+	}
+	return experiment, nil
+}
+
+// DeleteExperiment removes an experiment. Its recorded exposures are kept
+// so it can still be analysed.
+func (s *PostgresStore) DeleteExperiment(ctx context.Context, key string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM experiments WHERE key = $1`, key)
+	if err != nil {
+		return fmt.Errorf("delete experiment: %w", err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// RecordExposure stores the exposure unless the member was exposed to the
+// experiment before; only the first exposure counts.
+func (s *PostgresStore) RecordExposure(ctx context.Context, exposure Exposure, at time.Time) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO experiment_exposures (experiment, member_id, variant, exposed_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (experiment, member_id) DO NOTHING
+	`, exposure.Experiment, exposure.MemberID, exposure.Variant, at)
+	if err != nil {
+		return fmt.Errorf("record exposure: %w", err)
+	}
+	return nil
+}
+
+// RecordMatch stores a match for each of its members. Redelivered matches
+// are ignored.
+func (s *PostgresStore) RecordMatch(ctx context.Context, matchID string, memberIDs []string, at time.Time) error {
+	for _, memberID := range memberIDs {
+		_, err := s.db.ExecContext(ctx, `
+			INSERT INTO experiment_matches (match_id, member_id, matched_at)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (match_id, member_id) DO NOTHING
+		`, matchID, memberID, at)
+		if err != nil {
+			return fmt.Errorf("record match: %w", err)
+		}
+	}
+	return nil
+}
+
+// Results counts, per variant of experiment key, the exposed members and
+// the matches they made within window of their first exposure. Variants
+// are ordered by name.
+func (s *PostgresStore) Results(ctx context.Context, key string, window time.Duration) ([]VariantResult, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT e.variant,
+		       COUNT(*),
+		       COUNT(*) FILTER (WHERE m.matches > 0),
+		       COALESCE(SUM(m.matches), 0)
+		FROM experiment_exposures e
+		CROSS JOIN LATERAL (
+			SELECT COUNT(*) AS matches
+			FROM experiment_matches x
+			WHERE x.member_id = e.member_id
+			  AND x.matched_at >= e.exposed_at
+			  AND x.matched_at < e.exposed_at + $2 * INTERVAL '1 second'
+		) m
+		WHERE e.experiment = $1
+		GROUP BY e.variant
+		ORDER BY e.variant
+	`, key, window.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("query experiment results: %w", err)
+	}
+	defer rows.Close()
+
+	var results []VariantResult
+	for rows.Next() {
+		var result VariantResult
+		if err := rows.Scan(&result.Variant, &result.Members, &result.Converted, &result.Matches); err != nil {
+			return nil, fmt.Errorf("scan experiment result: %w", err)
+		}
+		results = append(results, result)
+	}
+	return results, rows.Err()
+}
@@ -0,0 +1,96 @@
+package featureflags
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/messaging"
+)
+
+// ChangedTopic announces that a flag was set or deleted.
+const ChangedTopic = "featureflags.changed"
+
+// Source lists the current flags.
+type Source interface {
+	ListFlags(ctx context.Context) ([]Flag, error)
+}
+
+// Client evaluates flags from a local copy, so checking a flag never waits
+// on the network. Until the first refresh succeeds every flag is off.
+type Client struct {
+	source Source
+
+	mu    sync.RWMutex
+	flags map[string]Flag
+}
+
+// NewClient creates a client that copies flags from source.
+func NewClient(source Source) *Client {
+	return &Client{source: source, flags: make(map[string]Flag)}
+}
+
+// Refresh replaces the local copy with the flags from the source.
+func (c *Client) Refresh(ctx context.Context) error {
+	flags, err := c.source.ListFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	byKey := make(map[string]Flag, len(flags))
+	for _, flag := range flags {
+		byKey[flag.Key] = flag
+	}
+	c.mu.Lock()
+	c.flags = byKey
+	c.mu.Unlock()
+	return nil
+}
+
+// Run refreshes the flags every interval until ctx is cancelled. It keeps
+// the copy current when a change notification is missed.
+func (c *Client) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := c.Refresh(ctx); err != nil && ctx.Err() == nil {
+			slog.WarnContext(ctx, "failed to refresh feature flags", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Listen refreshes the flags whenever a change is announced on
+// ChangedTopic, so changes apply within moments on every instance.
+func (c *Client) Listen(ctx context.Context, subscriber messaging.BroadcastSubscriber) error {
+	return subscriber.SubscribeAll(ctx, ChangedTopic, func(ctx context.Context, _ messaging.Message) error {
+		return c.Refresh(ctx)
+	})
+}
+
+// Enabled reports whether the flag called key is on for the member in fc.
+// Unknown flags are off.
+func (c *Client) Enabled(key string, fc Context) bool {
+	c.mu.RLock()
+	flag, ok := c.flags[key]
+	c.mu.RUnlock()
+	return ok && flag.Evaluate(fc)
+}
+
+// Evaluate returns whether each flag is on for the member in fc.
+func (c *Client) Evaluate(fc Context) map[string]bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	result := make(map[string]bool, len(c.flags))
+	for key, flag := range c.flags {
+		result[key] = flag.Evaluate(fc)
+	}
+	return result
+}
@@ -0,0 +1,153 @@
+// Package featureflags decides per member whether a feature is on. Flags
+// are stored in Postgres by the service that owns them and served over
+// gRPC; every other service keeps a local copy in a Client, refreshed when
+// a change is announced on the broker.
+package featureflags
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Rule operators.
+const (
+	OpIn         = "in"
+	OpNotIn      = "not_in"
+	OpVersionGTE = "version_gte"
+	OpVersionLT  = "version_lt"
+)
+
+// Attributes members are commonly targeted by. Rules may name others.
+const (
+	AttrCountry    = "country"
+	AttrPlatform   = "platform"
+	AttrAppVersion = "app_version"
+)
+
+var keyPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.-]*$`)
+
+// Flag gates a feature. It is on for a member when it is enabled, the
+// member matches every rule and the member's bucket falls within
+// RolloutPercent.
+type Flag struct {
+	Key            string    `json:"key"`
+	Description    string    `json:"description"`
+	Enabled        bool      `json:"enabled"`
+	RolloutPercent int       `json:"rollout_percent"`
+	Rules          []Rule    `json:"rules"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// Rule targets members by one attribute.
+type Rule struct {
+	Attribute string   `json:"attribute"`
+	Operator  string   `json:"operator"`
+	Values    []string `json:"values"`
+}
+
+// Context describes the member a flag is evaluated for.
+type Context struct {
+	UserID     string
+	Attributes map[string]string
+}
+
+// Evaluate reports whether the flag is on for the member in c. A member
+// stays in the same bucket for a flag, so raising the rollout percentage
+// only adds members.
+func (f Flag) Evaluate(c Context) bool {
+	if !f.Enabled {
+		return false
+	}
+	for _, rule := range f.Rules {
+		if !rule.matches(c.Attributes[rule.Attribute]) {
+			return false
+		}
+	}
+	return bucket(f.Key, c.UserID) < f.RolloutPercent
+}
+
+// bucket places a member in one of 100 buckets. The flag key is part of
+// the hash so each flag rolls out to a different set of members.
+func bucket(key, userID string) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key + ":" + userID))
+	return int(h.Sum32() % 100)
+}
+
+func (r Rule) matches(value string) bool {
+	switch r.Operator {
+	case OpIn:
+		return slices.Contains(r.Values, value)
+	case OpNotIn:
+		return !slices.Contains(r.Values, value)
+	case OpVersionGTE, OpVersionLT:
+		// Members whose version is unknown are not targeted
+		if value == "" || len(r.Values) == 0 {
+			return false
+		}
+		cmp := compareVersions(value, r.Values[0])
+		if r.Operator == OpVersionGTE {
+			return cmp >= 0
+		}
+		return cmp < 0
+	default:
+		return false
+	}
+}
+
+// compareVersions compares dotted versions such as "1.12.0" and "1.9"
+// numerically, treating missing parts as zero.
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < max(len(as), len(bs)); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// Validate reports every problem with the flag's definition.
+func (f Flag) Validate() error {
+	var errs []error
+	if !keyPattern.MatchString(f.Key) {
+		errs = append(errs, fmt.Errorf("key %q must be lowercase letters, digits, '_', '.' or '-'", f.Key))
+	}
+	if f.RolloutPercent < 0 || f.RolloutPercent > 100 {
+		errs = append(errs, errors.New("rollout_percent must be between 0 and 100"))
+	}
+	for i, rule := range f.Rules {
+		if rule.Attribute == "" {
+			errs = append(errs, fmt.Errorf("rule %d needs an attribute", i+1))
+		}
+		switch rule.Operator {
+		case OpIn, OpNotIn:
+			if len(rule.Values) == 0 {
+				errs = append(errs, fmt.Errorf("rule %d needs at least one value", i+1))
+			}
+		case OpVersionGTE, OpVersionLT:
+			if len(rule.Values) != 1 {
+				errs = append(errs, fmt.Errorf("rule %d needs exactly one version", i+1))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("rule %d has unknown operator %q", i+1, rule.Operator))
+		}
+	}
+	return errors.Join(errs...)
+}
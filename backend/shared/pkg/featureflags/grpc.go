@@ -0,0 +1,132 @@
+package featureflags
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	featureflagsv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/featureflags/v1"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/messaging"
+)
+
+// Server serves flags from a PostgresStore and announces every change on
+// ChangedTopic.
+type Server struct {
+	featureflagsv1.UnimplementedFeatureFlagServiceServer
+	store     *PostgresStore
+	publisher messaging.Publisher
+}
+
+// NewServer creates a server for store. Without a publisher, clients only
+// see changes on their next periodic refresh.
+func NewServer(store *PostgresStore, publisher messaging.Publisher) *Server {
+	return &Server{store: store, publisher: publisher}
+}
+
+func (s *Server) ListFlags(ctx context.Context, _ *featureflagsv1.ListFlagsRequest) (*featureflagsv1.ListFlagsResponse, error) {
+	flags, err := s.store.ListFlags(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &featureflagsv1.ListFlagsResponse{Flags: make([]*featureflagsv1.Flag, len(flags))}
+	for i, flag := range flags {
+		resp.Flags[i] = toProto(flag)
+	}
+	return resp, nil
+}
+
+func (s *Server) SetFlag(ctx context.Context, req *featureflagsv1.SetFlagRequest) (*featureflagsv1.SetFlagResponse, error) {
+	flag := fromProto(req.Flag)
+	if err := flag.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	stored, err := s.store.SetFlag(ctx, flag)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.announce(ctx, stored.Key)
+	return &featureflagsv1.SetFlagResponse{Flag: toProto(stored)}, nil
+}
+
+func (s *Server) DeleteFlag(ctx context.Context, req *featureflagsv1.DeleteFlagRequest) (*featureflagsv1.DeleteFlagResponse, error) {
+	if err := s.store.DeleteFlag(ctx, req.Key); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.announce(ctx, req.Key)
+	return &featureflagsv1.DeleteFlagResponse{}, nil
+}
+
+// announce tells clients to refresh. The change is already stored, so a
+// failure only delays it until their next periodic refresh.
+func (s *Server) announce(ctx context.Context, key string) {
+	if s.publisher == nil {
+		return
+	}
+	payload, _ := json.Marshal(map[string]string{"key": key})
+	err := s.publisher.Publish(ctx, ChangedTopic, messaging.Message{Type: ChangedTopic, Payload: payload})
+	if err != nil {
+		slog.WarnContext(ctx, "failed to announce feature flag change", "flag", key, "error", err)
+	}
+}
+
+// GRPCSource lists flags from a FeatureFlagService, for services that do
+// not own the flags.
+func GRPCSource(client featureflagsv1.FeatureFlagServiceClient) Source {
+	return grpcSource{client: client}
+}
+
+type grpcSource struct {
+	client featureflagsv1.FeatureFlagServiceClient
+}
+
+func (s grpcSource) ListFlags(ctx context.Context) ([]Flag, error) {
+	resp, err := s.client.ListFlags(ctx, &featureflagsv1.ListFlagsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	flags := make([]Flag, len(resp.Flags))
+	for i, flag := range resp.Flags {
+		flags[i] = fromProto(flag)
+	}
+	return flags, nil
+}
+
+func toProto(flag Flag) *featureflagsv1.Flag {
+	rules := make([]*featureflagsv1.Rule, len(flag.Rules))
+	for i, rule := range flag.Rules {
+		rules[i] = &featureflagsv1.Rule{Attribute: rule.Attribute, Operator: rule.Operator, Values: rule.Values}
+	}
+	return &featureflagsv1.Flag{
+		Key:            flag.Key,
+		Description:    flag.Description,
+		Enabled:        flag.Enabled,
+		RolloutPercent: int32(flag.RolloutPercent),
+		Rules:          rules,
+		UpdatedAt:      timestamppb.New(flag.UpdatedAt),
+	}
+}
+
+func fromProto(flag *featureflagsv1.Flag) Flag {
+	rules := make([]Rule, len(flag.GetRules()))
+	for i, rule := range flag.GetRules() {
+		rules[i] = Rule{Attribute: rule.Attribute, Operator: rule.Operator, Values: rule.Values}
+	}
+	return Flag{
+		Key:            flag.GetKey(),
+		Description:    flag.GetDescription(),
+		Enabled:        flag.GetEnabled(),
+		RolloutPercent: int(flag.GetRolloutPercent()),
+		Rules:          rules,
+		UpdatedAt:      flag.GetUpdatedAt().AsTime(),
+	}
+}
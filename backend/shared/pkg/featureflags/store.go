@@ -0,0 +1,90 @@
+package featureflags
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrNotFound is returned for a flag that does not exist.
+var ErrNotFound = errors.New("feature flag not found")
+
+// PostgresStore keeps flag definitions in the feature_flags table.
+type PostgresStore struct {
+	db *sql.DB
+}
+
+// NewPostgresStore creates a store on db.
+func NewPostgresStore(db *sql.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// ListFlags returns every flag, ordered by key.
+func (s *PostgresStore) ListFlags(ctx context.Context) ([]Flag, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT key, description, enabled, rollout_percent, rules, updated_at
+		FROM feature_flags
+		ORDER BY key
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("query feature flags: %w", err)
+	}
+	defer rows.Close()
+
+	var flags []Flag
+	for rows.Next() {
+		var (
+			flag  Flag
+			rules []byte
+		)
+		if err := rows.Scan(&flag.Key, &flag.Description, &flag.Enabled, &flag.RolloutPercent, &rules, &flag.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("scan feature flag: %w", err)
+		}
+		if err := json.Unmarshal(rules, &flag.Rules); err != nil {
+			return nil, fmt.Errorf("unmarshal rules of %s: %w", flag.Key, err)
+		}
+		flags = append(flags, flag)
+	}
+	return flags, rows.Err()
+}
+
+// SetFlag creates or replaces a flag and returns it as stored.
+func (s *PostgresStore) SetFlag(ctx context.Context, flag Flag) (Flag, error) {
+	if flag.Rules == nil {
+		flag.Rules = []Rule{}
+	}
+	rules, err := json.Marshal(flag.Rules)
+	if err != nil {
+		return Flag{}, fmt.Errorf("marshal rules: %w", err)
+	}
+
+	err = s.db.QueryRowContext(ctx, `
+		INSERT INTO feature_flags (key, description, enabled, rollout_percent, rules, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		ON CONFLICT (key) DO UPDATE SET
+			description = EXCLUDED.description,
+			enabled = EXCLUDED.enabled,
+			rollout_percent = EXCLUDED.rollout_percent,
+			rules = EXCLUDED.rules,
+			updated_at = EXCLUDED.updated_at
+		RETURNING updated_at
+	`, flag.Key, flag.Description, flag.Enabled, flag.RolloutPercent, rules).Scan(&flag.UpdatedAt)
+	if err != nil {
+		return Flag{}, fmt.Errorf("store feature flag: %w", err)
+	}
+	return flag, nil
+}
+
+// DeleteFlag removes a flag.
+func (s *PostgresStore) DeleteFlag(ctx context.Context, key string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM feature_flags WHERE key = $1`, key)
+	if err != nil {
+		return fmt.Errorf("delete feature flag: %w", err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
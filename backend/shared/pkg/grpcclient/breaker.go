@@ -0,0 +1,172 @@
+package grpcclient
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var circuitOpen = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "grpc_client_circuit_open",
+	Help: "Whether the circuit breaker for a downstream service is open (1) or closed (0).",
+}, []string{"service"})
+
+type breakerSettings struct {
+	failures int
+	openFor  time.Duration
+}
+
+var defaultBreaker = breakerSettings{failures: 5, openFor: 10 * time.Second}
+
+type breakerState int
+
+const (
+	closed breakerState = iota
+	open
+	halfOpen
+)
+
+// breaker stops calls to a service after consecutive failures, so callers
+// fail fast instead of queueing behind an outage. Once openFor has passed,
+// a single trial call decides whether it closes again.
+type breaker struct {
+	name     string
+	settings breakerSettings
+
+	mu       sync.Mutex
+	state    breakerState
+	failures int
+	openedAt time.Time
+}
+
+func newBreaker(name string, settings breakerSettings) *breaker {
+	circuitOpen.WithLabelValues(name).Set(0)
+	return &breaker{name: name, settings: settings}
+}
+
+func (b *breaker) unaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		wait, trial, ok := b.allow()
+		if !ok {
+			return openError(b.name, wait)
+		}
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		b.record(ctx, err, trial)
+		return err
+	}
+}
+
+// allow reports whether a call may go ahead and whether it is the trial
+// call, or, if it may not, how long until the breaker lets a trial call
+// through.
+func (b *breaker) allow() (wait time.Duration, trial, ok bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case open:
+		wait := b.settings.openFor - time.Since(b.openedAt)
+		if wait > 0 {
+			return wait, false, false
+		}
+		b.state = halfOpen
+		return 0, true, true
+	case halfOpen:
+		// A trial call is already in flight
+		return b.settings.openFor, false, false
+	default:
+		return 0, false, true
+	}
+}
+
+func (b *breaker) record(ctx context.Context, err error, trial bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if callerGaveUp(ctx, err, trial) {
+		// Says nothing about the service. A trial cut short this way hands
+		// the trial to the next call, as openedAt has already passed
+		if trial {
+			b.state = open
+		}
+		return
+	}
+
+	if !isFailure(err) {
+		b.state = closed
+		b.failures = 0
+		circuitOpen.WithLabelValues(b.name).Set(0)
+		return
+	}
+
+	b.failures++
+	if b.state == halfOpen || b.failures >= b.settings.failures {
+		b.state = open
+		b.openedAt = time.Now()
+		circuitOpen.WithLabelValues(b.name).Set(1)
+	}
+}
+
+// callerGaveUp reports whether the call failed because the caller
+// cancelled it. Running out of the caller's time counts as giving up only
+// for the trial call: otherwise a slow service is the usual reason, while
+// a trial is cut short by whatever deadline the one caller happened to set.
+func callerGaveUp(ctx context.Context, err error, trial bool) bool {
+	if err == nil {
+		return false
+	}
+	switch ctx.Err() {
+	case context.Canceled:
+		return true
+	case context.DeadlineExceeded:
+		return trial
+	default:
+		return false
+	}
+}
+
+// isFailure reports whether err says the service is unhealthy, rather than
+// that the request was wrong. A call that ran out of time does count.
+func isFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
+
+// openError is returned while the circuit is open. Its RetryInfo tells
+// callers when to try again.
+func openError(name string, wait time.Duration) error {
+	st := status.Newf(codes.Unavailable, "circuit breaker for %s is open", name)
+	// Round up to whole seconds, the unit of Retry-After
+	wait = time.Duration(math.Ceil(wait.Seconds())) * time.Second
+	if detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(wait)}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// RetryAfter returns how long the status of err asks callers to wait
+// before trying again, or zero if it does not say.
+func RetryAfter(err error) time.Duration {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			return info.GetRetryDelay().AsDuration()
+		}
+	}
+	return 0
+}
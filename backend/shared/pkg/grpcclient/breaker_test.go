@@ -0,0 +1,129 @@
+package grpcclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// callThrough makes a call through b that ends with err, or with the error
+// of ctx if ctx is already done.
+func callThrough(b *breaker, ctx context.Context, err error) error {
+	invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		return err
+	}
+	return b.unaryInterceptor()(ctx, "/test.Service/Call", nil, nil, nil, invoker)
+}
+
+// openBreaker returns a breaker that has just opened and lets a trial
+// call through immediately.
+func openBreaker(t *testing.T) *breaker {
+	t.Helper()
+	b := newBreaker(t.Name(), breakerSettings{failures: 2, openFor: time.Hour})
+	unavailable := status.Error(codes.Unavailable, "down")
+	for i := 0; i < 2; i++ {
+		callThrough(b, context.Background(), unavailable)
+	}
+	if err := callThrough(b, context.Background(), nil); RetryAfter(err) == 0 {
+		t.Fatalf("breaker did not open: %v", err)
+	}
+	b.openedAt = time.Now().Add(-time.Hour)
+	return b
+}
+
+func TestBreakerOpensAfterConsecutiveFailures(t *testing.T) {
+	b := newBreaker(t.Name(), breakerSettings{failures: 3, openFor: time.Hour})
+	unavailable := status.Error(codes.Unavailable, "down")
+
+	callThrough(b, context.Background(), unavailable)
+	callThrough(b, context.Background(), status.Error(codes.InvalidArgument, "bad request"))
+	callThrough(b, context.Background(), unavailable)
+	callThrough(b, context.Background(), unavailable)
+	if b.state != closed {
+		t.Fatal("a request error did not reset the failure count")
+	}
+
+	callThrough(b, context.Background(), unavailable)
+	err := callThrough(b, context.Background(), nil)
+	if status.Code(err) != codes.Unavailable || RetryAfter(err) != time.Hour {
+		t.Errorf("call on open breaker = %v, retry after %s; want UNAVAILABLE after 1h", err, RetryAfter(err))
+	}
+}
+
+func TestBreakerTrialDecides(t *testing.T) {
+	b := openBreaker(t)
+	callThrough(b, context.Background(), status.Error(codes.Unavailable, "still down"))
+	if b.state != open || time.Since(b.openedAt) > time.Minute {
+		t.Fatalf("failed trial left state %d, want open again", b.state)
+	}
+
+	b = openBreaker(t)
+	if err := callThrough(b, context.Background(), nil); err != nil {
+		t.Fatalf("trial call: %v", err)
+	}
+	if b.state != closed {
+		t.Errorf("successful trial left state %d, want closed", b.state)
+	}
+}
+
+// A trial the caller gave up on says nothing about the service: the
+// breaker must neither close nor wait another openFor, but give the next
+// call the trial.
+func TestBreakerTrialCancelledByCaller(t *testing.T) {
+	for name, ctx := range map[string]func() (context.Context, context.CancelFunc){
+		"cancelled": func() (context.Context, context.CancelFunc) {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			return ctx, cancel
+		},
+		"deadline": func() (context.Context, context.CancelFunc) {
+			return context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			b := openBreaker(t)
+			ctx, cancel := ctx()
+			defer cancel()
+
+			if err := callThrough(b, ctx, nil); err == nil {
+				t.Fatal("cancelled trial succeeded")
+			}
+			if b.state != open {
+				t.Fatalf("state after cancelled trial = %d, want open", b.state)
+			}
+
+			_, trial, ok := b.allow()
+			if !ok || !trial {
+				t.Errorf("next call allowed=%v trial=%v, want the trial", ok, trial)
+			}
+		})
+	}
+}
+
+// Outside a trial, a caller cancelling does not count for or against the
+// service, while running out of time counts as a failure.
+func TestBreakerClosedCallerCancellation(t *testing.T) {
+	b := newBreaker(t.Name(), breakerSettings{failures: 2, openFor: time.Hour})
+	callThrough(b, context.Background(), status.Error(codes.Unavailable, "down"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	callThrough(b, ctx, nil)
+	if b.failures != 1 {
+		t.Fatalf("failures after cancelled call = %d, want 1", b.failures)
+	}
+
+	ctx, cancel = context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	callThrough(b, ctx, nil)
+	if b.state != open {
+		t.Errorf("state after a timed out call = %d, want open", b.state)
+	}
+}
@@ -0,0 +1,178 @@
+// Package grpcclient creates connections to other services with the
+// resilience every caller needs: retries of idempotent methods, a circuit
+// breaker per downstream service, optional hedging, round-robin load
+// balancing over DNS-resolved replicas and keepalives.
+package grpcclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/correlation"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/interceptors"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/metrics"
+)
+
+// Keepalive pings idle connections so a replica that went away is noticed
+// before a request is sent to it. Servers must allow pings this often; see
+// ServerKeepalive.
+var keepaliveParams = keepalive.ClientParameters{
+	Time:                30 * time.Second,
+	Timeout:             10 * time.Second,
+	PermitWithoutStream: true,
+}
+
+// ServerKeepalive returns the server option that accepts the pings of
+// clients created by New. Without it servers close their connections for
+// pinging too often.
+func ServerKeepalive() grpc.ServerOption {
+	return grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+		MinTime:             keepaliveParams.Time / 2,
+		PermitWithoutStream: true,
+	})
+}
+
+type options struct {
+	retried     []string
+	hedged      []string
+	hedgeDelay  time.Duration
+	breaker     breakerSettings
+	credentials credentials.TransportCredentials
+	dialOptions []grpc.DialOption
+}
+
+// Option configures a connection created by New.
+type Option func(*options)
+
+// WithRetry retries the given methods, by full name, when the service is
+// unavailable. Only list methods that are safe to run twice.
+func WithRetry(methods ...string) Option {
+	return func(o *options) { o.retried = append(o.retried, methods...) }
+}
+
+// WithHedging sends a second copy of a call to one of the methods when the
+// first has not answered within delay, and uses whichever answers first.
+// It trades load for tail latency, so only list cheap, idempotent reads.
+func WithHedging(delay time.Duration, methods ...string) Option {
+	return func(o *options) {
+		o.hedgeDelay = delay
+		o.hedged = append(o.hedged, methods...)
+	}
+}
+
+// WithBreaker changes when the circuit breaker opens: after failures
+// consecutive failed calls, for openFor.
+func WithBreaker(failures int, openFor time.Duration) Option {
+	return func(o *options) { o.breaker = breakerSettings{failures: failures, openFor: openFor} }
+}
+
+// WithTransportCredentials secures the connection, for example with
+// mtls.ClientCredentials. Connections are plaintext without it.
+func WithTransportCredentials(creds credentials.TransportCredentials) Option {
+	return func(o *options) { o.credentials = creds }
+}
+
+// WithDialOptions adds options to the connection.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(o *options) { o.dialOptions = append(o.dialOptions, opts...) }
+}
+
+// New creates a connection to the service called name at target. A target
+// without a scheme is resolved through DNS, so every address behind it,
+// such as the pods of a headless Kubernetes service, receives calls.
+func New(name, target string, opts ...Option) (*grpc.ClientConn, error) {
+	o := options{breaker: defaultBreaker, credentials: insecure.NewCredentials()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	serviceConfig, err := buildServiceConfig(o.retried)
+	if err != nil {
+		return nil, fmt.Errorf("build service config for %s: %w", name, err)
+	}
+	if !strings.Contains(target, ":///") {
+		target = "dns:///" + target
+	}
+
+	dialOptions := []grpc.DialOption{
+		grpc.WithTransportCredentials(o.credentials),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		grpc.WithKeepaliveParams(keepaliveParams),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		// Metrics see one call however often it is retried or hedged
+		grpc.WithChainUnaryInterceptor(
+			metrics.UnaryClientInterceptor(),
+			correlation.UnaryClientInterceptor(),
+			interceptors.UnaryClientMemberToken(),
+			newBreaker(name, o.breaker).unaryInterceptor(),
+			hedgeInterceptor(o.hedgeDelay, o.hedged),
+		),
+		grpc.WithChainStreamInterceptor(correlation.StreamClientInterceptor(), interceptors.StreamClientMemberToken()),
+	}
+	return grpc.NewClient(target, append(dialOptions, o.dialOptions...)...)
+}
+
+// serviceConfig is the subset of the gRPC service config used by New.
+type serviceConfig struct {
+	LoadBalancingConfig []map[string]struct{} `json:"loadBalancingConfig"`
+	MethodConfig        []methodConfig        `json:"methodConfig,omitempty"`
+}
+
+type methodConfig struct {
+	Name        []methodName `json:"name"`
+	RetryPolicy retryPolicy  `json:"retryPolicy"`
+}
+
+type methodName struct {
+	Service string `json:"service"`
+	Method  string `json:"method"`
+}
+
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+func buildServiceConfig(retried []string) (string, error) {
+	config := serviceConfig{
+		LoadBalancingConfig: []map[string]struct{}{{"round_robin": {}}},
+	}
+
+	if len(retried) > 0 {
+		names := make([]methodName, len(retried))
+		for i, fullMethod := range retried {
+			service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+			if !ok {
+				return "", fmt.Errorf("%q is not a full method name", fullMethod)
+			}
+			names[i] = methodName{Service: service, Method: method}
+		}
+		config.MethodConfig = []methodConfig{{
+			Name: names,
+			RetryPolicy: retryPolicy{
+				MaxAttempts:          3,
+				InitialBackoff:       "0.1s",
+				MaxBackoff:           "1s",
+				BackoffMultiplier:    2,
+				RetryableStatusCodes: []string{"UNAVAILABLE"},
+			},
+		}}
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,67 @@
+package grpcclient
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// hedgeInterceptor sends a second attempt of the listed methods when the
+// first has not completed within delay. grpc-go does not implement the
+// hedgingPolicy of the service config, so it is done here. With round-robin
+// balancing the second attempt goes to a different replica.
+func hedgeInterceptor(delay time.Duration, methods []string) grpc.UnaryClientInterceptor {
+	hedged := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		hedged[method] = true
+	}
+
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		msg, ok := reply.(proto.Message)
+		if delay <= 0 || !hedged[method] || !ok {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		// The loser is cancelled once a winner is known
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		type result struct {
+			reply proto.Message
+			err   error
+		}
+		// Buffered so the losing attempt never blocks after we return
+		results := make(chan result, 2)
+		attempt := func() {
+			// Each attempt fills its own message, so the loser cannot
+			// write into the reply the caller reads
+			r := proto.Clone(msg)
+			results <- result{reply: r, err: invoker(ctx, method, req, r, cc, opts...)}
+		}
+
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		go attempt()
+		pending := 1
+
+		for {
+			select {
+			case <-timer.C:
+				go attempt()
+				pending++
+			case res := <-results:
+				pending--
+				if res.err == nil {
+					proto.Reset(msg)
+					proto.Merge(msg, res.reply)
+					return nil
+				}
+				if pending == 0 {
+					return res.err
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,189 @@
+// Package health serves liveness and readiness endpoints backed by checks
+// of a service's dependencies, and keeps the standard grpc.health.v1
+// status in line with readiness.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// checkTimeout bounds each dependency check.
+const checkTimeout = 2 * time.Second
+
+// CheckFunc reports whether a dependency is usable.
+type CheckFunc func(ctx context.Context) error
+
+type check struct {
+	name     string
+	fn       CheckFunc
+	critical bool
+}
+
+// Health tracks a service's dependencies and whether it is shutting down.
+type Health struct {
+	checks       []check
+	shuttingDown atomic.Bool
+	grpc         *health.Server
+}
+
+// New creates a Health with no checks.
+func New() *Health {
+	return &Health{}
+}
+
+// Add registers a dependency the service cannot serve without. The service
+// is not ready while the check fails.
+func (h *Health) Add(name string, fn CheckFunc) {
+	h.checks = append(h.checks, check{name: name, fn: fn, critical: true})
+}
+
+// AddNonCritical registers a dependency the service degrades without. Its
+// result is reported by /readyz but does not affect readiness.
+func (h *Health) AddNonCritical(name string, fn CheckFunc) {
+	h.checks = append(h.checks, check{name: name, fn: fn})
+}
+
+// Register serves /livez and /readyz on mux. /health remains as an alias
+// of /livez for existing clients.
+func (h *Health) Register(mux *http.ServeMux) {
+	mux.Handle("/livez", h.LiveHandler())
+	mux.Handle("/health", h.LiveHandler())
+	mux.Handle("/readyz", h.ReadyHandler())
+}
+
+// LiveHandler reports that the process is up. It checks no dependencies,
+// so an outage elsewhere does not get the service restarted.
+func (h *Health) LiveHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeStatus(w, http.StatusOK, map[string]any{"status": "ok"})
+	})
+}
+
+// ReadyHandler reports whether the service can take traffic, with the
+// result of each check. It responds 503 while a critical check fails or
+// the service is shutting down.
+func (h *Health) ReadyHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ready, results := h.Ready(r.Context())
+		code, status := http.StatusOK, "ok"
+		if h.shuttingDown.Load() {
+			code, status = http.StatusServiceUnavailable, "shutting down"
+		} else if !ready {
+			code, status = http.StatusServiceUnavailable, "unavailable"
+		}
+		writeStatus(w, code, map[string]any{"status": status, "checks": results})
+	})
+}
+
+// RegisterGRPC registers the grpc.health.v1 service on s. Its overall
+// status follows readiness once SyncGRPC runs.
+func (h *Health) RegisterGRPC(s *grpc.Server) {
+	h.grpc = health.NewServer()
+	healthpb.RegisterHealthServer(s, h.grpc)
+}
+
+// SyncGRPC updates the gRPC health status from the checks every interval
+// until ctx is cancelled.
+func (h *Health) SyncGRPC(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		ready, _ := h.Ready(ctx)
+		h.setGRPCStatus(ready)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown marks the service as no longer ready, so load balancers stop
+// sending it traffic while in-flight requests finish.
+func (h *Health) Shutdown() {
+	h.shuttingDown.Store(true)
+	if h.grpc != nil {
+		h.grpc.Shutdown()
+	}
+}
+
+// Ready runs the checks concurrently and reports whether the service can
+// take traffic, along with the result of each check.
+func (h *Health) Ready(ctx context.Context) (bool, map[string]string) {
+	results := make(map[string]string, len(h.checks))
+	if h.shuttingDown.Load() {
+		return false, results
+	}
+
+	var (
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+		ready = true
+	)
+	for _, c := range h.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+			defer cancel()
+			err := c.fn(checkCtx)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				results[c.name] = err.Error()
+				if c.critical {
+					ready = false
+				}
+			} else {
+				results[c.name] = "ok"
+			}
+		}()
+	}
+	wg.Wait()
+	return ready, results
+}
+
+func (h *Health) setGRPCStatus(ready bool) {
+	if h.grpc == nil || h.shuttingDown.Load() {
+		return
+	}
+	status := healthpb.HealthCheckResponse_SERVING
+	if !ready {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	h.grpc.SetServingStatus("", status)
+}
+
+func writeStatus(w http.ResponseWriter, code int, body map[string]any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// GRPC checks a downstream service through its grpc.health.v1 endpoint.
+// An empty service name asks for the server's overall status.
+func GRPC(conn grpc.ClientConnInterface, service string) CheckFunc {
+	client := healthpb.NewHealthClient(conn)
+	return func(ctx context.Context) error {
+		resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			return err
+		}
+		if resp.Status != healthpb.HealthCheckResponse_SERVING {
+			return fmt.Errorf("status %s", resp.Status)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,342 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/correlation"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/mtls"
+)
+
+// ErrUnauthenticated is returned by an Authenticator that cannot identify
+// the calling service.
+var ErrUnauthenticated = errors.New("caller is not authenticated")
+
+// Authenticator returns the name of the service making a call.
+type Authenticator func(ctx context.Context) (string, error)
+
+// Policy says who may call a method.
+type Policy struct {
+	// Public methods may be called without authentication.
+	Public bool
+	// Callers lists the services allowed to call the method.
+	Callers []string
+	// Subject names the request field holding the ID of the member the
+	// call acts on, which must be the signed-in member.
+	Subject string
+	// Roles lists the roles that may make the call; the signed-in member
+	// needs one of them.
+	Roles []string
+	// Operators lists the callers trusted to make a call with a Subject or
+	// Roles without a signed-in member, such as the admin credentials
+	// operators use. Other callers are denied unless they send a verified
+	// member.
+	Operators []string
+}
+
+// RoleSource returns the roles of a member.
+type RoleSource func(ctx context.Context, memberID string) ([]string, error)
+
+// AuthorizeOption configures Authorize.
+type AuthorizeOption func(*authorizeOptions)
+
+type authorizeOptions struct {
+	roles   RoleSource
+	members MemberAuthenticator
+}
+
+// WithRoles looks up members' roles for policies that list Roles. Without
+// it such calls are denied.
+func WithRoles(source RoleSource) AuthorizeOption {
+	return func(o *authorizeOptions) { o.roles = source }
+}
+
+// WithMembers identifies the signed-in member calls are made for. Without
+// it no call has a member, so policies with a Subject or Roles only admit
+// their Operators.
+func WithMembers(authenticate MemberAuthenticator) AuthorizeOption {
+	return func(o *authorizeOptions) { o.members = authenticate }
+}
+
+// Policies maps full method names, such as
+// "/member.v1.MemberService/GetMember", to their policy.
+type Policies map[string]Policy
+
+type (
+	callerKey struct{}
+	memberKey struct{}
+)
+
+// CallerFromContext returns the authenticated service that made the call,
+// or an empty string for public methods.
+func CallerFromContext(ctx context.Context) string {
+	caller, _ := ctx.Value(callerKey{}).(string)
+	return caller
+}
+
+// MemberFromContext returns the verified signed-in member the call is made
+// for, or an empty string when there is none.
+func MemberFromContext(ctx context.Context) string {
+	member, _ := ctx.Value(memberKey{}).(string)
+	return member
+}
+
+// Authorize authenticates the calling service and the member the call is
+// made for, and applies the method's policy. Methods without a policy
+// cannot be called at all, so a new RPC is closed until someone decides who
+// may use it. It must run after correlation.UnaryServerInterceptor: the
+// verified member, or no one, replaces the user ID the caller sent, which
+// is recorded on events.
+func Authorize(authenticate Authenticator, policies Policies, opts ...AuthorizeOption) grpc.UnaryServerInterceptor {
+	a := newAuthorizer(authenticate, policies, opts)
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.authorize(ctx, info.FullMethod, req)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// AuthorizeStream is Authorize for streaming calls. The policy is applied
+// before the first message is received, so a Subject cannot be checked:
+// methods with one may only be streamed by their Operators.
+func AuthorizeStream(authenticate Authenticator, policies Policies, opts ...AuthorizeOption) grpc.StreamServerInterceptor {
+	a := newAuthorizer(authenticate, policies, opts)
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authorize(ss.Context(), info.FullMethod, nil)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authorizedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authorizedStream is a server stream with the context of the authorized
+// call.
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+type authorizer struct {
+	authenticate Authenticator
+	policies     Policies
+	authorizeOptions
+}
+
+func newAuthorizer(authenticate Authenticator, policies Policies, opts []AuthorizeOption) *authorizer {
+	a := &authorizer{authenticate: authenticate, policies: policies}
+	for _, opt := range opts {
+		opt(&a.authorizeOptions)
+	}
+	return a
+}
+
+// authorize applies the policy of method to a call with req, which is nil
+// for streams, and returns the context the handler runs with.
+func (a *authorizer) authorize(ctx context.Context, method string, req any) (context.Context, error) {
+	policy, ok := a.policies[method]
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "%s has no authorization policy", method)
+	}
+	if policy.Public {
+		return withMember(ctx, ""), nil
+	}
+
+	caller, err := a.authenticate(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if !slices.Contains(policy.Callers, caller) {
+		return nil, status.Errorf(codes.PermissionDenied, "%s may not call %s", caller, method)
+	}
+
+	var member string
+	if a.members != nil {
+		if member, err = a.members(ctx); err != nil {
+			return nil, status.Error(codes.Unauthenticated, "the member's access token is not valid")
+		}
+	}
+
+	if policy.Subject != "" || len(policy.Roles) > 0 {
+		switch {
+		case member != "":
+			if policy.Subject != "" && StringField(req, policy.Subject) != member {
+				return nil, status.Errorf(codes.PermissionDenied, "%s may only be called for the signed-in member", method)
+			}
+			if len(policy.Roles) > 0 {
+				if err := a.checkRoles(ctx, member, policy.Roles); err != nil {
+					return nil, err
+				}
+			}
+		case !slices.Contains(policy.Operators, caller):
+			return nil, status.Errorf(codes.PermissionDenied, "%s must be called for a signed-in member", method)
+		}
+	}
+
+	return context.WithValue(withMember(ctx, member), callerKey{}, caller), nil
+}
+
+// withMember records the verified member as the user the call is made for,
+// in place of the user ID the caller sent. Without one the user ID is
+// cleared, so operators and services cannot have events recorded in a
+// member's name.
+func withMember(ctx context.Context, member string) context.Context {
+	md := correlation.FromContext(ctx)
+	md.UserID = member
+	ctx = correlation.NewContext(ctx, md)
+	if member == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, memberKey{}, member)
+}
+
+// checkRoles returns PermissionDenied unless the member has one of the
+// allowed roles.
+func (o authorizeOptions) checkRoles(ctx context.Context, memberID string, allowed []string) error {
+	if o.roles == nil {
+		return status.Error(codes.PermissionDenied, "member roles are not available")
+	}
+	roles, err := o.roles(ctx, memberID)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "look up member roles: %v", err)
+	}
+	for _, role := range roles {
+		if slices.Contains(allowed, role) {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "the signed-in member does not have a role allowed to make this call")
+}
+
+// StringField returns the string field of the request message with the
+// given name, or an empty string when it has none.
+func StringField(req any, field string) string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+	m := msg.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(field))
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+		return ""
+	}
+	return m.Get(fd).String()
+}
+
+// TokenAuthenticator identifies callers by the bearer token in the
+// "authorization" metadata. Tokens maps each service name to its token.
+func TokenAuthenticator(tokens map[string]string) Authenticator {
+	return func(ctx context.Context) (string, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get("authorization")
+		if len(values) == 0 {
+			return "", ErrUnauthenticated
+		}
+		token, ok := strings.CutPrefix(values[0], "Bearer ")
+		if !ok {
+			return "", ErrUnauthenticated
+		}
+
+		// Compare against every token so timing does not reveal a match
+		caller := ""
+		for name, expected := range tokens {
+			if subtle.ConstantTimeCompare([]byte(token), []byte(expected)) == 1 {
+				caller = name
+			}
+		}
+		if caller == "" {
+			return "", ErrUnauthenticated
+		}
+		return caller, nil
+	}
+}
+
+// SPIFFEAuthenticator identifies callers by the SPIFFE ID in their client
+// certificate. Only the IDs spiffe://<trustDomain>/<service> of the given
+// services are accepted, and the service is the caller's name; any other
+// ID, however similar, is rejected.
+func SPIFFEAuthenticator(trustDomain string, services ...string) Authenticator {
+	ids := make(map[string]string, len(services))
+	for _, service := range services {
+		ids["spiffe://"+trustDomain+"/"+service] = service
+	}
+	return func(ctx context.Context) (string, error) {
+		id, ok := mtls.SPIFFEID(ctx)
+		if !ok {
+			return "", ErrUnauthenticated
+		}
+		service, ok := ids[id.String()]
+		if !ok {
+			return "", ErrUnauthenticated
+		}
+		return service, nil
+	}
+}
+
+// FirstOf identifies callers with the first of authenticators that
+// succeeds, so services can move from tokens to certificates one at a time.
+func FirstOf(authenticators ...Authenticator) Authenticator {
+	return func(ctx context.Context) (string, error) {
+		for _, authenticate := range authenticators {
+			if caller, err := authenticate(ctx); err == nil {
+				return caller, nil
+			}
+		}
+		return "", ErrUnauthenticated
+	}
+}
+
+// ParseTokens parses service tokens in the form
+// "gateway=token1,moderation=token2".
+func ParseTokens(s string) (map[string]string, error) {
+	tokens := make(map[string]string)
+	for i, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, token, ok := strings.Cut(pair, "=")
+		if !ok || name == "" || token == "" {
+			// The entry itself is not quoted, since it may hold a token
+			return nil, fmt.Errorf("service token %d is not in the form name=token", i+1)
+		}
+		tokens[name] = token
+	}
+	return tokens, nil
+}
+
+// ServiceToken returns call credentials that present token to services
+// using TokenAuthenticator.
+func ServiceToken(token string) credentials.PerRPCCredentials {
+	return serviceToken(token)
+}
+
+type serviceToken string
+
+func (t serviceToken) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+// RequireTransportSecurity allows tokens on plaintext connections, which
+// the services use inside the cluster unless mTLS is configured.
+func (serviceToken) RequireTransportSecurity() bool {
+	return false
+}
@@ -0,0 +1,285 @@
+package interceptors
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	memberv1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/member/v1"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/auth"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/correlation"
+)
+
+const (
+	jwtSecret = "test-secret-that-is-long-enough-0123"
+	staffID   = "11111111-1111-1111-1111-111111111111"
+	memberID  = "22222222-2222-2222-2222-222222222222"
+)
+
+var testPolicies = Policies{
+	"/test.Service/Staff":  {Callers: []string{"gateway", "admin"}, Roles: []string{auth.RoleAdmin}, Operators: []string{"admin"}},
+	"/test.Service/Member": {Callers: []string{"gateway"}, Subject: "member_id"},
+	"/test.Service/Public": {Public: true},
+}
+
+func TestAuthorize(t *testing.T) {
+	staffToken := accessToken(t, staffID, jwtSecret)
+	memberToken := accessToken(t, memberID, jwtSecret)
+	forged := accessToken(t, staffID, "another-secret-that-is-long-enough-01")
+	pair, err := auth.NewJWTService(jwtSecret, time.Hour, time.Hour).GenerateTokenPair(staffID, "staff@example.com", []string{auth.RoleAdmin})
+	if err != nil {
+		t.Fatal(err)
+	}
+	refresh := pair.RefreshToken
+
+	tests := []struct {
+		name   string
+		method string
+		md     metadata.MD
+		req    any
+		want   codes.Code
+	}{
+		{"public without credentials", "/test.Service/Public", nil, nil, codes.OK},
+		{"no policy", "/test.Service/Unknown", pairs("authorization", "Bearer gateway-token"), nil, codes.PermissionDenied},
+		{"unknown service token", "/test.Service/Staff", pairs("authorization", "Bearer wrong"), nil, codes.Unauthenticated},
+
+		{"staff member with role", "/test.Service/Staff", pairs("authorization", "Bearer gateway-token", "x-member-token", staffToken), nil, codes.OK},
+		{"member without role", "/test.Service/Staff", pairs("authorization", "Bearer gateway-token", "x-member-token", memberToken), nil, codes.PermissionDenied},
+		{"gateway without member", "/test.Service/Staff", pairs("authorization", "Bearer gateway-token"), nil, codes.PermissionDenied},
+		{"gateway with spoofed user ID", "/test.Service/Staff", pairs("authorization", "Bearer gateway-token", "x-user-id", staffID), nil, codes.PermissionDenied},
+		{"token signed with another secret", "/test.Service/Staff", pairs("authorization", "Bearer gateway-token", "x-member-token", forged), nil, codes.Unauthenticated},
+		{"refresh token", "/test.Service/Staff", pairs("authorization", "Bearer gateway-token", "x-member-token", refresh), nil, codes.Unauthenticated},
+		{"operator without member", "/test.Service/Staff", pairs("authorization", "Bearer admin-token"), nil, codes.OK},
+
+		{"own member", "/test.Service/Member", pairs("authorization", "Bearer gateway-token", "x-member-token", memberToken), getMember(memberID), codes.OK},
+		{"other member", "/test.Service/Member", pairs("authorization", "Bearer gateway-token", "x-member-token", memberToken), getMember(staffID), codes.PermissionDenied},
+		{"subject without member", "/test.Service/Member", pairs("authorization", "Bearer gateway-token", "x-user-id", memberID), getMember(memberID), codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := call(tt.method, tt.md, tt.req)
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("code = %s, want %s (%v)", got, tt.want, err)
+			}
+		})
+	}
+}
+
+func TestAuthorizeRecordsVerifiedMember(t *testing.T) {
+	md := pairs("authorization", "Bearer gateway-token", "x-member-token", accessToken(t, staffID, jwtSecret), "x-user-id", memberID)
+	ctx, err := call("/test.Service/Staff", md, nil)
+	if err != nil {
+		t.Fatalf("call: %v", err)
+	}
+	if got := MemberFromContext(ctx); got != staffID {
+		t.Errorf("MemberFromContext = %q, want %q", got, staffID)
+	}
+	if got := correlation.FromContext(ctx).UserID; got != staffID {
+		t.Errorf("correlation user ID = %q, want the verified member %q", got, staffID)
+	}
+}
+
+// Streams have no request to take a Subject from, so only operators may
+// call such methods.
+func TestAuthorizeStream(t *testing.T) {
+	authorize := AuthorizeStream(
+		TokenAuthenticator(map[string]string{"gateway": "gateway-token", "admin": "admin-token"}),
+		Policies{
+			"/test.Service/Watch": {Public: true},
+			"/test.Service/Tail":  {Callers: []string{"gateway", "admin"}, Subject: "member_id", Operators: []string{"admin"}},
+		},
+		WithMembers(JWTMemberAuthenticator(jwtSecret)),
+	)
+	memberToken := accessToken(t, memberID, jwtSecret)
+
+	tests := []struct {
+		name   string
+		method string
+		md     metadata.MD
+		want   codes.Code
+		caller string
+	}{
+		{"public", "/test.Service/Watch", nil, codes.OK, ""},
+		{"no policy", "/test.Service/Unknown", pairs("authorization", "Bearer admin-token"), codes.PermissionDenied, ""},
+		{"without credentials", "/test.Service/Tail", nil, codes.Unauthenticated, ""},
+		{"member", "/test.Service/Tail", pairs("authorization", "Bearer gateway-token", "x-member-token", memberToken), codes.PermissionDenied, ""},
+		{"operator", "/test.Service/Tail", pairs("authorization", "Bearer admin-token"), codes.OK, "admin"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &testStream{ctx: metadata.NewIncomingContext(context.Background(), tt.md)}
+			var caller string
+			err := authorize(nil, stream, &grpc.StreamServerInfo{FullMethod: tt.method}, func(_ any, ss grpc.ServerStream) error {
+				caller = CallerFromContext(ss.Context())
+				return nil
+			})
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("code = %s, want %s (%v)", got, tt.want, err)
+			}
+			if caller != tt.caller {
+				t.Errorf("caller = %q, want %q", caller, tt.caller)
+			}
+		})
+	}
+}
+
+// Only a verified member may be recorded as the actor; the user ID an
+// operator or a public call sends is dropped.
+func TestAuthorizeClearsUnverifiedUserID(t *testing.T) {
+	for name, method := range map[string]string{
+		"operator": "/test.Service/Staff",
+		"public":   "/test.Service/Public",
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, err := call(method, pairs("authorization", "Bearer admin-token", "x-user-id", memberID), nil)
+			if err != nil {
+				t.Fatalf("call: %v", err)
+			}
+			if got := correlation.FromContext(ctx).UserID; got != "" {
+				t.Errorf("correlation user ID = %q, want none", got)
+			}
+			if got := MemberFromContext(ctx); got != "" {
+				t.Errorf("MemberFromContext = %q, want none", got)
+			}
+		})
+	}
+}
+
+func TestJWTMemberRoles(t *testing.T) {
+	pair, err := auth.NewJWTService(jwtSecret, time.Hour, time.Hour).GenerateTokenPair(staffID, "staff@example.com", []string{auth.RoleSupport})
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged, err := auth.NewJWTService("another-secret-that-is-long-enough-01", time.Hour, time.Hour).GenerateTokenPair(memberID, "member@example.com", []string{auth.RoleAdmin})
+	if err != nil {
+		t.Fatal(err)
+	}
+	roles := JWTMemberRoles(jwtSecret)
+
+	tests := []struct {
+		name   string
+		md     metadata.MD
+		member string
+		want   []string
+	}{
+		{"roles in the token", pairs("x-member-token", pair.AccessToken), staffID, []string{auth.RoleSupport}},
+		{"token of another member", pairs("x-member-token", pair.AccessToken), memberID, nil},
+		{"token signed with another secret", pairs("x-member-token", forged.AccessToken), memberID, nil},
+		{"no token", nil, staffID, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := roles(metadata.NewIncomingContext(context.Background(), tt.md), tt.member)
+			if err != nil || !slices.Equal(got, tt.want) {
+				t.Errorf("roles = %v, %v; want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSPIFFEAuthenticator(t *testing.T) {
+	authenticate := SPIFFEAuthenticator("zoekdeware.local", "gateway", "admin")
+
+	tests := []struct {
+		id   string
+		want string
+	}{
+		{"spiffe://zoekdeware.local/gateway", "gateway"},
+		{"spiffe://zoekdeware.local/admin", "admin"},
+		{"spiffe://zoekdeware.local/tenant/admin", ""},
+		{"spiffe://zoekdeware.local/gateway/", ""},
+		{"spiffe://evil.example/admin", ""},
+		{"spiffe://zoekdeware.local/member", ""},
+		{"spiffe://zoekdeware.local/admin?x=1", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.id != "" {
+				id, err := url.Parse(tt.id)
+				if err != nil {
+					t.Fatal(err)
+				}
+				ctx = peer.NewContext(ctx, &peer.Peer{AuthInfo: credentials.TLSInfo{SPIFFEID: id}})
+			}
+
+			caller, err := authenticate(ctx)
+			if tt.want == "" {
+				if err == nil {
+					t.Errorf("accepted as %q", caller)
+				}
+				return
+			}
+			if err != nil || caller != tt.want {
+				t.Errorf("caller = %q, %v; want %q", caller, err, tt.want)
+			}
+		})
+	}
+}
+
+// call runs a request through the correlation and Authorize interceptors
+// and returns the context the handler saw.
+func call(method string, md metadata.MD, req any) (context.Context, error) {
+	authorize := Authorize(
+		TokenAuthenticator(map[string]string{"gateway": "gateway-token", "admin": "admin-token"}),
+		testPolicies,
+		WithMembers(JWTMemberAuthenticator(jwtSecret)),
+		WithRoles(func(_ context.Context, id string) ([]string, error) {
+			if id == staffID {
+				return []string{auth.RoleAdmin}, nil
+			}
+			return nil, nil
+		}),
+	)
+
+	var handled context.Context
+	handler := func(ctx context.Context, req any) (any, error) {
+		handled = ctx
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	_, err := correlation.UnaryServerInterceptor()(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+		return authorize(ctx, req, info, handler)
+	})
+	return handled, err
+}
+
+// testStream is a server stream that only has a context.
+type testStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testStream) Context() context.Context {
+	return s.ctx
+}
+
+func pairs(kv ...string) metadata.MD {
+	return metadata.Pairs(kv...)
+}
+
+func accessToken(t *testing.T, memberID, secret string) string {
+	t.Helper()
+	pair, err := auth.NewJWTService(secret, time.Hour, time.Hour).GenerateTokenPair(memberID, "staff@example.com", nil)
+	if err != nil {
+		t.Fatalf("generate token: %v", err)
+	}
+	return pair.AccessToken
+}
+
+// getMember returns a request acting on the member.
+func getMember(id string) any {
+	return &memberv1.GetMemberRequest{MemberId: id}
+}
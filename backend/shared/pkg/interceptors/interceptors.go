@@ -0,0 +1,61 @@
+// Package interceptors provides the gRPC server interceptors every service
+// chains in front of its handlers: panic recovery, deadline enforcement,
+// service authentication with per-method authorization, and request
+// validation from proto annotations.
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/logging"
+)
+
+// Recovery turns a panic in a handler into an Internal error instead of
+// letting it crash the process. The panic and its stack are logged.
+func Recovery() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logging.FromContext(ctx).Error("panic in grpc handler",
+					"method", info.FullMethod,
+					"panic", fmt.Sprint(r),
+					"stack", string(debug.Stack()),
+				)
+				err = status.Error(codes.Internal, "internal error")
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// Deadline bounds how long a call may run. Calls without a deadline get
+// defaultTimeout, and deadlines further away than maxTimeout are brought
+// forward. Calls whose deadline has already passed are not started.
+func Deadline(defaultTimeout, maxTimeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+
+		timeout := time.Duration(0)
+		if deadline, ok := ctx.Deadline(); !ok {
+			timeout = defaultTimeout
+		} else if time.Until(deadline) > maxTimeout {
+			timeout = maxTimeout
+		}
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,92 @@
+package interceptors
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/auth"
+)
+
+// mdMemberToken is the gRPC metadata key carrying the access token of the
+// member a call is made for. Unlike the user ID in the correlation
+// metadata, it is verified by the called service, so it may be used for
+// authorization.
+const mdMemberToken = "x-member-token"
+
+// MemberAuthenticator returns the signed-in member a call is made for, from
+// a credential the called service verifies itself. It returns an empty
+// string when the call carries none, and ErrUnauthenticated when the
+// credential is invalid.
+type MemberAuthenticator func(ctx context.Context) (string, error)
+
+// JWTMemberAuthenticator verifies the access token forwarded in the
+// "x-member-token" metadata with the secret the gateway signs tokens with.
+// Refresh tokens are rejected.
+func JWTMemberAuthenticator(secret string) MemberAuthenticator {
+	tokens := auth.NewJWTService(secret, 0, 0)
+	return func(ctx context.Context) (string, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get(mdMemberToken)
+		if len(values) == 0 {
+			return "", nil
+		}
+		claims, err := tokens.ValidateToken(values[0])
+		if err != nil || claims.UserID == "" || claims.Type == auth.TokenTypeRefresh {
+			return "", ErrUnauthenticated
+		}
+		return claims.UserID, nil
+	}
+}
+
+// JWTMemberRoles returns the staff roles in the access token forwarded with
+// the call, for services that keep no roles of their own. The roles are
+// those the member had when the token was issued; changing them signs the
+// member out, and the gateway rejects tokens issued before.
+func JWTMemberRoles(secret string) RoleSource {
+	tokens := auth.NewJWTService(secret, 0, 0)
+	return func(ctx context.Context, memberID string) ([]string, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get(mdMemberToken)
+		if len(values) == 0 {
+			return nil, nil
+		}
+		claims, err := tokens.ValidateToken(values[0])
+		if err != nil || claims.UserID != memberID || claims.Type == auth.TokenTypeRefresh {
+			return nil, nil
+		}
+		return claims.Roles, nil
+	}
+}
+
+type memberTokenKey struct{}
+
+// NewMemberTokenContext returns a context carrying the access token of the
+// signed-in member, which calls made with it forward to other services.
+func NewMemberTokenContext(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, memberTokenKey{}, token)
+}
+
+// UnaryClientMemberToken forwards the member's access token from the
+// context to the called service.
+func UnaryClientMemberToken() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(withMemberToken(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientMemberToken is UnaryClientMemberToken for streaming calls.
+func StreamClientMemberToken() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(withMemberToken(ctx), desc, cc, method, opts...)
+	}
+}
+
+func withMemberToken(ctx context.Context) context.Context {
+	token, _ := ctx.Value(memberTokenKey{}).(string)
+	if token == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, mdMemberToken, token)
+}
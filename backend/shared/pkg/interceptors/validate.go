@@ -0,0 +1,129 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	validatev1 "github.com/mattuttis/inetcontrol/zoekdeware/api/proto/validate/v1"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// Validate rejects requests that break the (validate.v1.field) rules
+// declared in their proto definition with InvalidArgument. The status
+// carries a BadRequest detail listing every violation.
+func Validate() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if msg, ok := req.(proto.Message); ok {
+			if violations := validateMessage(msg.ProtoReflect(), ""); len(violations) > 0 {
+				return nil, invalidArgument(violations)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+func validateMessage(m protoreflect.Message, prefix string) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := prefix + string(fd.Name())
+
+		if rules, ok := proto.GetExtension(fd.Options(), validatev1.E_Field).(*validatev1.FieldRules); ok && rules != nil {
+			for _, description := range checkField(m, fd, rules) {
+				violations = append(violations, &errdetails.BadRequest_FieldViolation{
+					Field:       path,
+					Description: description,
+				})
+			}
+		}
+
+		if fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() && m.Has(fd) {
+			violations = append(violations, validateMessage(m.Get(fd).Message(), path+".")...)
+		}
+	}
+	return violations
+}
+
+// checkField returns a description of each rule the field breaks.
+func checkField(m protoreflect.Message, fd protoreflect.FieldDescriptor, rules *validatev1.FieldRules) []string {
+	// Proto3 scalars are "set" when they are not the zero value
+	if !m.Has(fd) {
+		if rules.Required {
+			return []string{"is required"}
+		}
+		return nil
+	}
+
+	var problems []string
+	value := m.Get(fd)
+	if fd.IsList() {
+		list := value.List()
+		if rules.MaxLen > 0 && list.Len() > int(rules.MaxLen) {
+			problems = append(problems, fmt.Sprintf("must have at most %d elements", rules.MaxLen))
+		}
+		if fd.Kind() == protoreflect.StringKind {
+			for i := 0; i < list.Len(); i++ {
+				for _, problem := range checkString(list.Get(i).String(), rules, false) {
+					problems = append(problems, fmt.Sprintf("element %d %s", i, problem))
+				}
+			}
+		}
+		return problems
+	}
+
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		problems = checkString(value.String(), rules, true)
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+		n := value.Int()
+		if rules.Gte != nil && n < *rules.Gte {
+			problems = append(problems, fmt.Sprintf("must be at least %d", *rules.Gte))
+		}
+		if rules.Lte != nil && n > *rules.Lte {
+			problems = append(problems, fmt.Sprintf("must be at most %d", *rules.Lte))
+		}
+	}
+	return problems
+}
+
+func checkString(s string, rules *validatev1.FieldRules, checkMax bool) []string {
+	var problems []string
+	length := utf8.RuneCountInString(s)
+	if rules.MinLen > 0 && length < int(rules.MinLen) {
+		problems = append(problems, fmt.Sprintf("must be at least %d characters", rules.MinLen))
+	}
+	// On repeated fields max_len limits the number of elements instead
+	if checkMax && rules.MaxLen > 0 && length > int(rules.MaxLen) {
+		problems = append(problems, fmt.Sprintf("must be at most %d characters", rules.MaxLen))
+	}
+	if rules.Uuid && !uuidPattern.MatchString(s) {
+		problems = append(problems, "must be a UUID")
+	}
+	return problems
+}
+
+func invalidArgument(violations []*errdetails.BadRequest_FieldViolation) error {
+	messages := make([]string, len(violations))
+	for i, v := range violations {
+		messages[i] = v.Field + " " + v.Description
+	}
+
+	st := status.New(codes.InvalidArgument, strings.Join(messages, "; "))
+	if detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
@@ -0,0 +1,47 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor logs every unary RPC with its status code and
+// duration. It must run after correlation.UnaryServerInterceptor so the
+// request and user IDs are in the context.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		code := status.Code(err)
+		attrs := []any{
+			"method", info.FullMethod,
+			"code", code.String(),
+			"duration_ms", time.Since(start).Milliseconds(),
+		}
+		if err != nil {
+			attrs = append(attrs, "error", err.Error())
+		}
+		FromContext(ctx).Log(ctx, levelFor(code), "grpc request", attrs...)
+		return resp, err
+	}
+}
+
+// levelFor logs failures caused by the server above those caused by the
+// caller.
+func levelFor(code codes.Code) slog.Level {
+	switch code {
+	case codes.OK, codes.Canceled, codes.InvalidArgument, codes.NotFound, codes.AlreadyExists,
+		codes.PermissionDenied, codes.Unauthenticated, codes.FailedPrecondition, codes.OutOfRange:
+		return slog.LevelInfo
+	case codes.Unknown, codes.Internal, codes.DataLoss, codes.Unimplemented:
+		return slog.LevelError
+	default:
+		return slog.LevelWarn
+	}
+}
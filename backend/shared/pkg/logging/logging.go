@@ -0,0 +1,86 @@
+// Package logging sets up structured JSON logging with log/slog. Records
+// are redacted before they are written: password and token attributes are
+// dropped and email addresses are masked wherever they appear.
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/correlation"
+)
+
+// Redacted replaces the value of sensitive attributes.
+const Redacted = "[REDACTED]"
+
+// sensitiveKeys are attribute keys whose values are never logged.
+var sensitiveKeys = map[string]bool{
+	"password":      true,
+	"password_hash": true,
+	"token":         true,
+	"access_token":  true,
+	"refresh_token": true,
+	"authorization": true,
+	"secret":        true,
+}
+
+var emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+// Setup makes a JSON logger for the service the default for both slog and
+// the standard log package. LOG_LEVEL sets the minimum level: debug, info
+// (the default), warn or error.
+func Setup(service string) *slog.Logger {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(os.Getenv("LOG_LEVEL"))); err != nil {
+		level = slog.LevelInfo
+	}
+
+	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level:       level,
+		ReplaceAttr: redact,
+	})
+	logger := slog.New(handler).With("service", service)
+	slog.SetDefault(logger)
+	return logger
+}
+
+// FromContext returns the default logger enriched with the request ID,
+// user ID and trace ID carried by ctx.
+func FromContext(ctx context.Context) *slog.Logger {
+	logger := slog.Default()
+	md := correlation.FromContext(ctx)
+	if md.CorrelationID != "" {
+		logger = logger.With("request_id", md.CorrelationID)
+	}
+	if md.UserID != "" {
+		logger = logger.With("user_id", md.UserID)
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		logger = logger.With("trace_id", sc.TraceID().String())
+	}
+	return logger
+}
+
+// RedactEmails masks every email address in s, keeping the first letter
+// and the domain.
+func RedactEmails(s string) string {
+	return emailPattern.ReplaceAllStringFunc(s, func(email string) string {
+		local, domain, _ := strings.Cut(email, "@")
+		return local[:1] + "***@" + domain
+	})
+}
+
+func redact(_ []string, a slog.Attr) slog.Attr {
+	if sensitiveKeys[strings.ToLower(a.Key)] {
+		return slog.String(a.Key, Redacted)
+	}
+	if a.Value.Kind() == slog.KindString {
+		return slog.String(a.Key, RedactEmails(a.Value.String()))
+	}
+	return a
+}
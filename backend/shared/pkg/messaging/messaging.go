@@ -17,6 +17,8 @@ type Message struct {
 type MessageMetadata struct {
 	CorrelationID string `json:"correlation_id,omitempty"`
 	Source        string `json:"source,omitempty"`
+	// Trace carries the W3C trace context of the publisher.
+	Trace map[string]string `json:"trace,omitempty"`
 }
 
 type Publisher interface {
@@ -35,3 +37,9 @@ type MessageBroker interface {
 	Publisher
 	Subscriber
 }
+
+// BroadcastSubscriber delivers every message to each instance of a service
+// rather than sharing them between instances.
+type BroadcastSubscriber interface {
+	SubscribeAll(ctx context.Context, topic string, handler MessageHandler) error
+}
@@ -0,0 +1,158 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/correlation"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/eventstore"
+	"github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/telemetry"
+)
+
+var tracer = otel.Tracer("github.com/mattuttis/inetcontrol/zoekdeware/backend/shared/pkg/messaging")
+
+// NATSBroker implements MessageBroker on top of core NATS subjects.
+// Subscriptions join a queue group named after the source service, so each
+// message is handled by exactly one replica of that service.
+type NATSBroker struct {
+	conn   *nats.Conn
+	source string
+}
+
+// NewNATSBroker connects to the NATS server at url. Source identifies the
+// publishing service and names the queue group used for subscriptions.
+func NewNATSBroker(url, source string) (*NATSBroker, error) {
+	conn, err := nats.Connect(url,
+		nats.Name(source),
+		nats.MaxReconnects(-1),
+		nats.ReconnectWait(2*time.Second),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("connect to nats: %w", err)
+	}
+
+	return &NATSBroker{conn: conn, source: source}, nil
+}
+
+// Publish sends the message on the given topic, filling in the ID, source,
+// publish time and correlation ID from ctx when they are not set.
+func (b *NATSBroker) Publish(ctx context.Context, topic string, message Message) error {
+	if message.ID == "" {
+		message.ID = uuid.New().String()
+	}
+	if message.Metadata.CorrelationID == "" {
+		message.Metadata.CorrelationID = correlation.FromContext(ctx).CorrelationID
+	}
+	if message.Metadata.Source == "" {
+		message.Metadata.Source = b.source
+	}
+	if message.PublishedAt.IsZero() {
+		message.PublishedAt = time.Now().UTC()
+	}
+
+	ctx, span := tracer.Start(ctx, topic+" publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKey.String("nats"),
+			semconv.MessagingDestinationName(topic),
+			semconv.MessagingMessageID(message.ID),
+		),
+	)
+	err := b.publish(ctx, topic, message)
+	telemetry.End(span, err)
+	return err
+}
+
+func (b *NATSBroker) publish(ctx context.Context, topic string, message Message) error {
+	message.Metadata.Trace = map[string]string{}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(message.Metadata.Trace))
+
+	data, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("marshal message: %w", err)
+	}
+
+	if err := b.conn.Publish(topic, data); err != nil {
+		return fmt.Errorf("publish to %s: %w", topic, err)
+	}
+	return nil
+}
+
+// Subscribe registers handler for messages on topic until ctx is cancelled
+// or the broker is closed. The handler's context carries the message's
+// correlation ID, with the message as the cause of whatever it changes.
+// Instances of the same service share the messages between them.
+func (b *NATSBroker) Subscribe(ctx context.Context, topic string, handler MessageHandler) error {
+	return b.subscribe(ctx, topic, b.source, handler)
+}
+
+// SubscribeAll registers handler like Subscribe, but every instance of the
+// service receives each message. It suits notifications each instance acts
+// on, such as invalidating a local cache.
+func (b *NATSBroker) SubscribeAll(ctx context.Context, topic string, handler MessageHandler) error {
+	return b.subscribe(ctx, topic, "", handler)
+}
+
+// subscribe shares messages between subscribers in queue, or delivers each
+// message to every subscriber when queue is empty.
+func (b *NATSBroker) subscribe(ctx context.Context, topic, queue string, handler MessageHandler) error {
+	sub, err := b.conn.QueueSubscribe(topic, queue, func(msg *nats.Msg) {
+		var message Message
+		if err := json.Unmarshal(msg.Data, &message); err != nil {
+			log.Printf("messaging: discarding malformed message on %s: %v", topic, err)
+			return
+		}
+		handlerCtx := correlation.NewContext(ctx, eventstore.Metadata{
+			CorrelationID: message.Metadata.CorrelationID,
+			CausationID:   message.ID,
+		})
+		handlerCtx = otel.GetTextMapPropagator().Extract(handlerCtx, propagation.MapCarrier(message.Metadata.Trace))
+		handlerCtx, span := tracer.Start(handlerCtx, topic+" process",
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithAttributes(
+				semconv.MessagingSystemKey.String("nats"),
+				semconv.MessagingDestinationName(topic),
+				semconv.MessagingMessageID(message.ID),
+			),
+		)
+		err := handler(handlerCtx, message)
+		telemetry.End(span, err)
+		if err != nil {
+			log.Printf("messaging: handler for %s failed on message %s: %v", topic, message.ID, err)
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("subscribe to %s: %w", topic, err)
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = sub.Unsubscribe()
+	}()
+
+	return nil
+}
+
+// Check reports whether the broker is connected, for health checks. NATS
+// reconnects by itself, so a failure is expected to clear.
+func (b *NATSBroker) Check(context.Context) error {
+	if status := b.conn.Status(); status != nats.CONNECTED {
+		return fmt.Errorf("nats connection is %s", status)
+	}
+	return nil
+}
+
+// Close drains outstanding subscriptions and closes the connection.
+func (b *NATSBroker) Close() error {
+	return b.conn.Drain()
+}
@@ -0,0 +1,105 @@
+// Package metrics exposes Prometheus metrics for a service: gRPC client and
+// server request counts and latencies, plus whatever collectors the service
+// registers on the default registry.
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	serverHandled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_handled_total",
+		Help: "RPCs completed on the server, by method and status code.",
+	}, []string{"grpc_service", "grpc_method", "grpc_code"})
+
+	serverHandling = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_server_handling_seconds",
+		Help:    "Time taken by the server to handle RPCs.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"grpc_service", "grpc_method"})
+
+	clientHandled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_client_handled_total",
+		Help: "RPCs completed by the client, by method and status code.",
+	}, []string{"grpc_service", "grpc_method", "grpc_code"})
+
+	clientHandling = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_client_handling_seconds",
+		Help:    "Time until the client received the RPC's response.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"grpc_service", "grpc_method"})
+)
+
+// Handler serves the metrics on the default registry in the Prometheus
+// text format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// UnaryServerInterceptor records the outcome and duration of unary RPCs.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		observe(serverHandled, serverHandling, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor records the outcome and duration of streaming
+// RPCs.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		observe(serverHandled, serverHandling, info.FullMethod, start, err)
+		return err
+	}
+}
+
+// UnaryClientInterceptor records the outcome and duration of unary calls
+// to other services.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		observe(clientHandled, clientHandling, method, start, err)
+		return err
+	}
+}
+
+// InitializeServer creates the series of every method registered on s, so
+// they are exported as zero before the first call.
+func InitializeServer(s *grpc.Server) {
+	for service, info := range s.GetServiceInfo() {
+		for _, method := range info.Methods {
+			serverHandled.WithLabelValues(service, method.Name, "OK")
+			serverHandling.WithLabelValues(service, method.Name)
+		}
+	}
+}
+
+func observe(handled *prometheus.CounterVec, handling *prometheus.HistogramVec, fullMethod string, start time.Time, err error) {
+	service, method := splitMethod(fullMethod)
+	handled.WithLabelValues(service, method, status.Code(err).String()).Inc()
+	handling.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+}
+
+// splitMethod splits "/package.Service/Method" into its service and method.
+func splitMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
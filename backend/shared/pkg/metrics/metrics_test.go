@@ -0,0 +1,99 @@
+package metrics
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestHandlerExposesGRPCMetrics(t *testing.T) {
+	s := grpc.NewServer()
+	healthpb.RegisterHealthServer(s, health.NewServer())
+	InitializeServer(s)
+
+	unavailable := func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+		return status.Error(codes.Unavailable, "backend down")
+	}
+	_ = UnaryClientInterceptor()(context.Background(), "/grpc.health.v1.Health/Check", nil, nil, nil, unavailable)
+
+	families, body := scrape(t, Handler())
+	for _, name := range []string{
+		"grpc_server_handled_total",
+		"grpc_server_handling_seconds",
+		"grpc_client_handled_total",
+		"grpc_client_handling_seconds",
+		"go_goroutines",
+	} {
+		if !families[name] {
+			t.Errorf("metric %s not exposed", name)
+		}
+	}
+
+	for _, series := range []string{
+		`grpc_server_handled_total{grpc_code="OK",grpc_method="Check",grpc_service="grpc.health.v1.Health"} 0`,
+		`grpc_client_handled_total{grpc_code="Unavailable",grpc_method="Check",grpc_service="grpc.health.v1.Health"} 1`,
+	} {
+		if !strings.Contains(body, series) {
+			t.Errorf("series %s not exposed", series)
+		}
+	}
+}
+
+func TestSplitMethod(t *testing.T) {
+	tests := []struct {
+		fullMethod      string
+		service, method string
+	}{
+		{"/member.v1.MemberService/GetMember", "member.v1.MemberService", "GetMember"},
+		{"GetMember", "unknown", "GetMember"},
+	}
+	for _, tt := range tests {
+		service, method := splitMethod(tt.fullMethod)
+		if service != tt.service || method != tt.method {
+			t.Errorf("splitMethod(%q) = %q, %q, want %q, %q", tt.fullMethod, service, method, tt.service, tt.method)
+		}
+	}
+}
+
+// scrape fetches /metrics from h over HTTP and returns the metric families
+// it exposes, with the raw body.
+func scrape(t *testing.T, h http.Handler) (map[string]bool, string) {
+	t.Helper()
+
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/metrics")
+	if err != nil {
+		t.Fatalf("scrape: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("scrape returned status %d", resp.StatusCode)
+	}
+
+	families := make(map[string]bool)
+	var body strings.Builder
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		body.WriteString(line + "\n")
+		// Every family is introduced by "# TYPE <name> <type>"
+		if fields := strings.Fields(line); len(fields) == 4 && fields[1] == "TYPE" {
+			families[fields[2]] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("read scrape: %v", err)
+	}
+	return families, body.String()
+}
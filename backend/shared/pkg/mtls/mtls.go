@@ -0,0 +1,236 @@
+// Package mtls builds the transport credentials services use to talk to
+// each other: plaintext when no certificates are configured, otherwise TLS
+// with client certificates checked against a CA. Certificates are read from
+// files and reloaded when the files change, so they can be rotated without
+// a restart.
+package mtls
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/peer"
+)
+
+// reloadInterval is how often the files are checked for changes. Checks
+// happen during handshakes, so an idle service does not poll. Tests
+// shorten it.
+var reloadInterval = 10 * time.Second
+
+// Config names the PEM files holding a service's certificate and key and
+// the CA that signed its peers' certificates. Its tags let services load it
+// with config.Load as part of their own configuration.
+type Config struct {
+	CertFile string `env:"GRPC_TLS_CERT_FILE"`
+	KeyFile  string `env:"GRPC_TLS_KEY_FILE"`
+	CAFile   string `env:"GRPC_TLS_CA_FILE"`
+}
+
+// ServerCredentials returns credentials for a gRPC server. Without a
+// certificate the server accepts plaintext. With a CA it requires clients
+// to present a certificate signed by that CA.
+func ServerCredentials(c Config) (credentials.TransportCredentials, error) {
+	if c.CertFile == "" {
+		return insecure.NewCredentials(), nil
+	}
+	if c.KeyFile == "" {
+		return nil, errors.New("a key file is required with the certificate file")
+	}
+	files, err := newFiles(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS13,
+		// A fresh config per handshake picks up rotated files
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool := files.current()
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS13,
+				Certificates: []tls.Certificate{*cert},
+				// gRPC only adds h2 to the outer config
+				NextProtos: []string{"h2"},
+			}
+			if pool != nil {
+				cfg.ClientAuth = tls.RequireAndVerifyClientCert
+				cfg.ClientCAs = pool
+			}
+			return cfg, nil
+		},
+	}), nil
+}
+
+// ClientCredentials returns credentials for connecting to other services.
+// Without any files configured the connection is plaintext. Otherwise the
+// CA is required: servers are verified against it and never against the
+// system roots, which would accept any publicly issued certificate. The
+// certificate, when given, is presented to servers that ask for one.
+func ClientCredentials(c Config) (credentials.TransportCredentials, error) {
+	if c.CertFile == "" && c.CAFile == "" {
+		return insecure.NewCredentials(), nil
+	}
+	if c.CAFile == "" {
+		return nil, errors.New("a CA file is required with the certificate file")
+	}
+	if c.CertFile != "" && c.KeyFile == "" {
+		return nil, errors.New("a key file is required with the certificate file")
+	}
+	files, err := newFiles(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS13,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := files.current()
+			if cert == nil {
+				return &tls.Certificate{}, nil
+			}
+			return cert, nil
+		},
+		// The standard verification cannot use a CA that changes after the
+		// config is built, so VerifyConnection does it instead
+		InsecureSkipVerify: true,
+		VerifyConnection: func(state tls.ConnectionState) error {
+			_, pool := files.current()
+			return verifyServer(state, pool)
+		},
+	}), nil
+}
+
+func verifyServer(state tls.ConnectionState, roots *x509.CertPool) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("server presented no certificate")
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range state.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		DNSName:       state.ServerName,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	return err
+}
+
+// SPIFFEID returns the SPIFFE ID in the client certificate of the call in
+// ctx, if the client presented one.
+func SPIFFEID(ctx context.Context) (*url.URL, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || info.SPIFFEID == nil {
+		return nil, false
+	}
+	return info.SPIFFEID, true
+}
+
+// files holds the certificate and CA loaded from a Config and reloads them
+// when a file's modification time changes.
+type files struct {
+	config Config
+
+	mu       sync.Mutex
+	cert     *tls.Certificate
+	pool     *x509.CertPool
+	modTimes [3]time.Time
+	checked  time.Time
+}
+
+func newFiles(c Config) (*files, error) {
+	f := &files{config: c}
+	if err := f.load(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// current returns the certificate and CA pool, either of which is nil when
+// not configured. A rotation that fails to load keeps the previous ones.
+func (f *files) current() (*tls.Certificate, *x509.CertPool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if time.Since(f.checked) >= reloadInterval {
+		f.checked = time.Now()
+		if f.modified() {
+			if err := f.load(); err != nil {
+				slog.Warn("failed to reload TLS certificates, keeping the previous ones", "error", err)
+			} else {
+				slog.Info("reloaded TLS certificates")
+			}
+		}
+	}
+	return f.cert, f.pool
+}
+
+func (f *files) paths() [3]string {
+	return [3]string{f.config.CertFile, f.config.KeyFile, f.config.CAFile}
+}
+
+func (f *files) modified() bool {
+	for i, path := range f.paths() {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err == nil && !info.ModTime().Equal(f.modTimes[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *files) load() error {
+	var modTimes [3]time.Time
+	for i, path := range f.paths() {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		modTimes[i] = info.ModTime()
+	}
+
+	var cert *tls.Certificate
+	if f.config.CertFile != "" {
+		loaded, err := tls.LoadX509KeyPair(f.config.CertFile, f.config.KeyFile)
+		if err != nil {
+			return fmt.Errorf("load certificate: %w", err)
+		}
+		cert = &loaded
+	}
+
+	var pool *x509.CertPool
+	if f.config.CAFile != "" {
+		pem, err := os.ReadFile(f.config.CAFile)
+		if err != nil {
+			return fmt.Errorf("read CA: %w", err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates in %s", f.config.CAFile)
+		}
+	}
+
+	f.cert, f.pool, f.modTimes = cert, pool, modTimes
+	return nil
+}
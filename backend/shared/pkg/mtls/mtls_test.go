@@ -0,0 +1,291 @@
+package mtls
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/peer"
+)
+
+// testCA signs certificates for the tests.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue writes a certificate for localhost with the SPIFFE ID of service,
+// and its key, to name.pem and name-key.pem in dir.
+func (ca *testCA) issue(t *testing.T, dir, name, service string, serial int64) (certFile, keyFile string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: service},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     []string{"localhost"},
+		URIs:         []*url.URL{{Scheme: "spiffe", Host: "zoekdeware.local", Path: "/" + service}},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certFile = filepath.Join(dir, name+".pem")
+	keyFile = filepath.Join(dir, name+"-key.pem")
+	writeFile(t, certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	writeFile(t, keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}))
+	return certFile, keyFile
+}
+
+func (ca *testCA) write(t *testing.T, dir, name string) string {
+	t.Helper()
+	path := filepath.Join(dir, name+".pem")
+	writeFile(t, path, ca.pem)
+	return path
+}
+
+// writeFile writes data to path and moves its modification time forward,
+// so the change is seen even on file systems with coarse timestamps.
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	var modTime time.Time
+	if info, err := os.Stat(path); err == nil {
+		modTime = info.ModTime().Add(time.Second)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if !modTime.IsZero() {
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// serve starts a health server with creds and returns its address and a
+// channel receiving the SPIFFE ID of each call.
+func serve(t *testing.T, creds credentials.TransportCredentials) (string, <-chan string) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := make(chan string, 10)
+	server := grpc.NewServer(grpc.Creds(creds), grpc.UnaryInterceptor(
+		func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			id := ""
+			if u, ok := SPIFFEID(ctx); ok {
+				id = u.String()
+			}
+			ids <- id
+			return handler(ctx, req)
+		}))
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+	return "localhost:" + port(t, lis.Addr()), ids
+}
+
+func port(t *testing.T, addr net.Addr) string {
+	t.Helper()
+	_, p, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// check makes a health check over a new connection and returns the
+// server's certificate.
+func check(t *testing.T, addr string, creds credentials.TransportCredentials) (*x509.Certificate, error) {
+	t.Helper()
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var p peer.Peer
+	if _, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{}, grpc.Peer(&p)); err != nil {
+		return nil, err
+	}
+	return p.AuthInfo.(credentials.TLSInfo).State.PeerCertificates[0], nil
+}
+
+func mustCredentials(t *testing.T, build func(Config) (credentials.TransportCredentials, error), c Config) credentials.TransportCredentials {
+	t.Helper()
+	creds, err := build(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return creds
+}
+
+func TestHandshakeIdentifiesClient(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	caFile := ca.write(t, dir, "ca")
+	serverCert, serverKey := ca.issue(t, dir, "member", "member", 2)
+	clientCert, clientKey := ca.issue(t, dir, "gateway", "gateway", 3)
+
+	addr, ids := serve(t, mustCredentials(t, ServerCredentials, Config{CertFile: serverCert, KeyFile: serverKey, CAFile: caFile}))
+	client := mustCredentials(t, ClientCredentials, Config{CertFile: clientCert, KeyFile: clientKey, CAFile: caFile})
+
+	if _, err := check(t, addr, client); err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	if id := <-ids; id != "spiffe://zoekdeware.local/gateway" {
+		t.Errorf("SPIFFE ID = %q, want spiffe://zoekdeware.local/gateway", id)
+	}
+}
+
+func TestServerRejectsClientsWithoutTrustedCertificate(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	caFile := ca.write(t, dir, "ca")
+	serverCert, serverKey := ca.issue(t, dir, "member", "member", 2)
+	addr, _ := serve(t, mustCredentials(t, ServerCredentials, Config{CertFile: serverCert, KeyFile: serverKey, CAFile: caFile}))
+
+	otherCert, otherKey := newTestCA(t).issue(t, dir, "other", "gateway", 3)
+	for name, c := range map[string]Config{
+		"no certificate":            {CAFile: caFile},
+		"certificate of another CA": {CertFile: otherCert, KeyFile: otherKey, CAFile: caFile},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := check(t, addr, mustCredentials(t, ClientCredentials, c)); err == nil {
+				t.Error("Check succeeded")
+			}
+		})
+	}
+}
+
+func TestClientRejectsServerOfAnotherCA(t *testing.T) {
+	dir := t.TempDir()
+	serverCert, serverKey := newTestCA(t).issue(t, dir, "member", "member", 2)
+	addr, _ := serve(t, mustCredentials(t, ServerCredentials, Config{CertFile: serverCert, KeyFile: serverKey}))
+
+	caFile := newTestCA(t).write(t, dir, "ca")
+	if _, err := check(t, addr, mustCredentials(t, ClientCredentials, Config{CAFile: caFile})); err == nil {
+		t.Error("Check succeeded against a server the CA did not sign")
+	}
+}
+
+// Without a CA the client would trust any server with a publicly issued
+// certificate.
+func TestClientRequiresCA(t *testing.T) {
+	dir := t.TempDir()
+	cert, key := newTestCA(t).issue(t, dir, "gateway", "gateway", 2)
+	if _, err := ClientCredentials(Config{CertFile: cert, KeyFile: key}); err == nil {
+		t.Error("ClientCredentials accepted a certificate without a CA")
+	}
+}
+
+func TestRotatedCertificatesAreUsedForNewConnections(t *testing.T) {
+	defer func(interval time.Duration) { reloadInterval = interval }(reloadInterval)
+	reloadInterval = 0
+
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	caFile := ca.write(t, dir, "ca")
+	serverCert, serverKey := ca.issue(t, dir, "member", "member", 2)
+	clientCert, clientKey := ca.issue(t, dir, "gateway", "gateway", 3)
+
+	addr, ids := serve(t, mustCredentials(t, ServerCredentials, Config{CertFile: serverCert, KeyFile: serverKey, CAFile: caFile}))
+	client := mustCredentials(t, ClientCredentials, Config{CertFile: clientCert, KeyFile: clientKey, CAFile: caFile})
+
+	got, err := check(t, addr, client)
+	if err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	<-ids
+	if got.SerialNumber.Int64() != 2 {
+		t.Fatalf("server certificate serial = %d, want 2", got.SerialNumber)
+	}
+
+	ca.issue(t, dir, "member", "member", 4)
+	got, err = check(t, addr, client)
+	if err != nil {
+		t.Fatalf("Check after rotation: %v", err)
+	}
+	<-ids
+	if got.SerialNumber.Int64() != 4 {
+		t.Errorf("server certificate serial after rotation = %d, want 4", got.SerialNumber)
+	}
+}
+
+func TestFailedReloadKeepsPreviousCertificate(t *testing.T) {
+	defer func(interval time.Duration) { reloadInterval = interval }(reloadInterval)
+	reloadInterval = 0
+
+	dir := t.TempDir()
+	certFile, keyFile := newTestCA(t).issue(t, dir, "member", "member", 2)
+	f, err := newFiles(Config{CertFile: certFile, KeyFile: keyFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writeFile(t, certFile, []byte("not a certificate"))
+	cert, _ := f.current()
+	if cert == nil {
+		t.Fatal("certificate dropped after a failed reload")
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil || leaf.SerialNumber.Int64() != 2 {
+		t.Errorf("certificate after a failed reload = %v, %v; want serial 2", leaf, err)
+	}
+}
@@ -0,0 +1,99 @@
+// Package telemetry sets up OpenTelemetry tracing for a service.
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Exporters selectable with OTEL_TRACES_EXPORTER.
+const (
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+	ExporterNone   = "none"
+)
+
+// Setup installs the global tracer provider and W3C trace context
+// propagation. OTEL_TRACES_EXPORTER picks the exporter: "otlp" sends spans
+// to the collector at OTEL_EXPORTER_OTLP_ENDPOINT over gRPC, "stdout"
+// prints them, and "none", the default, records nothing. The returned
+// function flushes pending spans and must be called on shutdown.
+func Setup(ctx context.Context, serviceName string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch kind := os.Getenv("OTEL_TRACES_EXPORTER"); kind {
+	case "", ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		// Endpoint, headers and TLS come from the standard
+		// OTEL_EXPORTER_OTLP_* variables
+		exporter, err = otlptracegrpc.New(ctx)
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	default:
+		return nil, fmt.Errorf("unknown OTEL_TRACES_EXPORTER %q", kind)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("create resource: %w", err)
+	}
+
+	// The sampler follows OTEL_TRACES_SAMPLER, sampling everything by default
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// TraceParent returns the W3C traceparent of the span in ctx, or an empty
+// string when there is none. It lets work that outlives the request, such
+// as publishing stored events, continue the request's trace.
+func TraceParent(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	return carrier.Get("traceparent")
+}
+
+// ContextWithTraceParent returns a context whose remote parent span is the
+// one identified by traceparent.
+func ContextWithTraceParent(ctx context.Context, traceParent string) context.Context {
+	if traceParent == "" {
+		return ctx
+	}
+	return propagation.TraceContext{}.Extract(ctx, propagation.MapCarrier{"traceparent": traceParent})
+}
+
+// End records err on the span, if any, and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
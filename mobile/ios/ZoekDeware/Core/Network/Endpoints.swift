@@ -4,12 +4,14 @@ enum AuthEndpoint: Endpoint {
     case register(email: String, password: String)
     case login(email: String, password: String)
     case refresh(token: String)
+    case verifyEmail(token: String)
 
     var path: String {
         switch self {
         case .register: return "auth/register"
         case .login: return "auth/login"
         case .refresh: return "auth/refresh"
+        case .verifyEmail: return "auth/verify-email"
         }
     }
 
@@ -25,6 +27,8 @@ enum AuthEndpoint: Endpoint {
             return ["email": email, "password": password]
         case .refresh(let token):
             return ["refresh_token": token]
+        case .verifyEmail(let token):
+            return ["token": token]
         }
     }
 }
@@ -50,6 +54,27 @@ enum ProfileEndpoint: Endpoint {
     }
 }
 
+enum OnboardingEndpoint: Endpoint {
+    case get
+    case resendVerificationEmail
+
+    var path: String {
+        switch self {
+        case .get: return "onboarding"
+        case .resendVerificationEmail: return "profile/email/verification"
+        }
+    }
+
+    var method: HTTPMethod {
+        switch self {
+        case .get: return .GET
+        case .resendVerificationEmail: return .POST
+        }
+    }
+
+    var body: Encodable? { nil }
+}
+
 enum DiscoverEndpoint: Endpoint {
     case getProfiles(limit: Int)
     case swipe(swipedId: String, direction: SwipeDirection)
@@ -109,3 +134,67 @@ enum ChatEndpoint: Endpoint {
         }
     }
 }
+
+enum NotificationEndpoint: Endpoint {
+    case registerDevice(token: String)
+    case unregisterDevice(token: String)
+
+    var path: String {
+        switch self {
+        case .registerDevice: return "devices"
+        case .unregisterDevice(let token): return "devices/\(token)"
+        }
+    }
+
+    var method: HTTPMethod {
+        switch self {
+        case .registerDevice: return .POST
+        case .unregisterDevice: return .DELETE
+        }
+    }
+
+    var body: Encodable? {
+        switch self {
+        case .registerDevice(let token):
+            return ["token": token, "platform": "ios"]
+        case .unregisterDevice:
+            return nil
+        }
+    }
+}
+
+enum SafetyEndpoint: Endpoint {
+    case block(memberId: String)
+    case unblock(memberId: String)
+    case blocks
+    case report(memberId: String, ReportMemberRequest)
+
+    var path: String {
+        switch self {
+        case .block(let memberId), .unblock(let memberId): return "members/\(memberId)/block"
+        case .blocks: return "blocks"
+        case .report(let memberId, _): return "members/\(memberId)/report"
+        }
+    }
+
+    var method: HTTPMethod {
+        switch self {
+        case .block, .report: return .POST
+        case .unblock: return .DELETE
+        case .blocks: return .GET
+        }
+    }
+
+    var body: Encodable? {
+        switch self {
+        case .report(_, let request): return request
+        default: return nil
+        }
+    }
+}
+
+struct ReportMemberRequest: Encodable {
+    let category: String
+    let description: String?
+    let block: Bool
+}
@@ -33,6 +33,24 @@ enum Gender: String, Codable, CaseIterable {
     }
 }
 
+/// Onboarding progress; the app shows the screen for `nextStep` until the
+/// member is discoverable.
+struct Onboarding: Codable {
+    let nextStep: OnboardingStep
+    let completedSteps: [OnboardingStep]
+    let completeness: Int
+    let emailVerified: Bool
+}
+
+enum OnboardingStep: String, Codable {
+    case profile
+    case photos
+    case prompts
+    case preferences
+    case verifyEmail = "verify_email"
+    case complete
+}
+
 struct UpdateProfileRequest: Codable {
     var displayName: String?
     var bio: String?
@@ -4,7 +4,7 @@ set -e
 TAG=${1:-latest}
 SCRIPT_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)"
 
-SERVICES="gateway member"
+SERVICES="gateway member media notification moderation"
 
 for service in $SERVICES; do
     echo "========================================"
@@ -6,7 +6,7 @@ TAG=${2:-latest}
 
 if [ -z "$SERVICE" ]; then
     echo "Usage: $0 <service-name> [tag]"
-    echo "Available services: gateway, member, matching, messaging, notification, media, location"
+    echo "Available services: gateway, member, matching, messaging, notification, media, location, moderation"
     exit 1
 fi
 
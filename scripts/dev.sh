@@ -9,7 +9,7 @@ cd "$ROOT_DIR/infrastructure"
 case "${1:-up}" in
     up)
         echo "Starting development environment..."
-        docker-compose up -d postgres redis nats
+        docker-compose up -d postgres redis nats minio mailpit fake-apns
         echo "Waiting for services to be ready..."
         sleep 5
         echo "Infrastructure ready!"
@@ -17,6 +17,9 @@ case "${1:-up}" in
         echo "PostgreSQL: localhost:5432"
         echo "Redis: localhost:6379"
         echo "NATS: localhost:4222"
+        echo "MinIO: localhost:9000 (console: localhost:9001)"
+        echo "Mailpit: localhost:1025 (inbox: localhost:8025)"
+        echo "Fake APNs: localhost:8093"
         ;;
     down)
         echo "Stopping development environment..."
@@ -21,7 +21,18 @@ if ! command -v protoc-gen-go-grpc &> /dev/null; then
     go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@latest
 fi
 
-SERVICES="member matching messaging notification media location"
+if ! command -v protoc-gen-grpc-gateway &> /dev/null; then
+    echo "Installing protoc-gen-grpc-gateway..."
+    go install github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-grpc-gateway@v2.22.0
+fi
+
+if ! command -v protoc-gen-openapiv2 &> /dev/null; then
+    echo "Installing protoc-gen-openapiv2..."
+    go install github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2@v2.22.0
+fi
+
+# validate holds the field annotations the services import
+SERVICES="validate featureflags experiments member matching messaging notification media location moderation"
 
 for service in $SERVICES; do
     PROTO_DIR="$API_DIR/$service/v1"
@@ -34,4 +45,19 @@ for service in $SERVICES; do
     fi
 done
 
+# Services with google.api.http rules also get REST handlers for the gateway
+# and an OpenAPI document. google/api and protoc-gen-openapiv2 hold copies
+# of the annotation protos.
+REST_SERVICES="member"
+
+for service in $REST_SERVICES; do
+    echo "Generating REST gateway for $service..."
+    protoc --proto_path="$API_DIR" \
+        --grpc-gateway_out="$API_DIR" --grpc-gateway_opt=paths=source_relative \
+        --openapiv2_out="$ROOT_DIR/api/openapi" \
+        --openapiv2_opt=allow_merge=true,merge_file_name="$service",output_format=yaml \
+        --openapiv2_opt=json_names_for_fields=false,disable_default_errors=true \
+        "$API_DIR/$service/v1"/*.proto
+done
+
 echo "Proto generation complete!"